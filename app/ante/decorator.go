@@ -42,6 +42,7 @@ type AnteDecorators struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter cosmos.GasMeter, sig signing.SignatureV2, params authmodule.Params) error
 	MaxTxGasWanted         uint64
+	MinPriorityFee         uint64
 	TxFeeChecker           anteutils.TxFeeChecker
 }
 
@@ -92,10 +93,20 @@ func newEVMAnteHandler(app *baseapp.BaseApp, options AnteDecorators) cosmos.Ante
 		evmante.NewEthMempoolFeeDecorator(options.EvmKeeper),
 		// Check eth effective gas price against the global MinGasPrice
 		evmante.NewEthMinGasPriceDecorator(options.FeeKeeper, options.EvmKeeper),
+		// Check eth priority fee against the global MinPriorityFee and the node's min-priority-fee config
+		evmante.NewEthMinPriorityFeeDecorator(options.FeeKeeper, options.EvmKeeper, options.MinPriorityFee),
 		evmante.NewEthValidateBasicDecorator(options.EvmKeeper),
 		evmante.NewAspectRuntimeContextDecorator(app, options.EvmKeeper),
 		evmante.NewEthSigVerificationDecorator(app, options.EvmKeeper),
+		// the memo and timeout height of eth txs are not covered by the eth signature, they
+		// must be signed by the sender, expired txs are rejected on recheck too so they're
+		// evicted from the mempool.
+		evmante.NewEthTxMetadataVerificationDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		evmante.NewEthAccountVerificationDecorator(options.AccountKeeper, options.EvmKeeper),
+		// Check eth txs against the gas policies of their senders
+		evmante.NewEthGasPolicyDecorator(options.EvmKeeper),
 		evmante.NewCanTransferDecorator(options.EvmKeeper),
 		// evmante.NewEthVestingTransactionDecorator(options.AccountKeeper, options.BankKeeper, options.EvmKeeper),
 		evmante.NewEthGasConsumeDecorator(options.BankKeeper, options.DistributionKeeper, options.EvmKeeper, nil, options.MaxTxGasWanted),
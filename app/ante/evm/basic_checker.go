@@ -3,6 +3,7 @@ package evm
 import (
 	"math"
 	"math/big"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -183,7 +184,16 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 			gasWanted += txData.GetGas()
 		}
 
-		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx())
+		if maxSize := evmParams.MaxTxDataSize; maxSize > 0 && uint64(len(txData.GetData())) > maxSize {
+			return ctx, errorsmod.Wrapf(evmmodule.ErrTxDataTooLarge, "tx data size %d exceeds the max %d", len(txData.GetData()), maxSize)
+		}
+
+		aspectAccessGas, err := egcd.evmKeeper.AspectAccessGas(ctx, evmParams, ethCfg, txData.GetTo(), txData.GetAccessList())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to retrieve the aspect access gas")
+		}
+
+		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx(), evmParams.CalldataGasPerToken, aspectAccessGas)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
@@ -225,8 +235,12 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 	// from the tx gas pool. The latter only has the value so far since the
 	// EthSetupContextDecorator, so it will never exceed the block gas limit.
 	if gasWanted > blockGasLimit {
-		return ctx, errorsmod.Wrapf(
+		return ctx, evmmodule.WrapWithData(
 			errortypes.ErrOutOfGas,
+			evmmodule.ErrorData{
+				evmmodule.ErrorDataProvided: strconv.FormatUint(gasWanted, 10),
+				evmmodule.ErrorDataLimit:    strconv.FormatUint(blockGasLimit, 10),
+			},
 			"tx gas (%d) exceeds block gas limit (%d)",
 			gasWanted,
 			blockGasLimit,
@@ -293,8 +307,12 @@ func (ctd CanTransferDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, sim
 				)
 			}
 			if coreMsg.GasFeeCap.Cmp(baseFee) < 0 {
-				return ctx, errorsmod.Wrapf(
+				return ctx, evmmodule.WrapWithData(
 					errortypes.ErrInsufficientFee,
+					evmmodule.ErrorData{
+						evmmodule.ErrorDataProvided: coreMsg.GasFeeCap.String(),
+						evmmodule.ErrorDataRequired: baseFee.String(),
+					},
 					"max fee per gas less than block base fee (%s < %s)",
 					coreMsg.GasFeeCap, baseFee,
 				)
@@ -315,13 +333,23 @@ func (ctd CanTransferDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, sim
 		// check that caller has enough balance to cover asset transfer for **topmost** call
 		// NOTE: here the gas consumed is from the context with the infinite gas meter
 		if coreMsg.Value.Sign() > 0 && !evm.Context.CanTransfer(stateDB, coreMsg.From, coreMsg.Value) {
-			return ctx, errorsmod.Wrapf(
+			return ctx, evmmodule.WrapWithData(
 				errortypes.ErrInsufficientFunds,
+				evmmodule.ErrorData{
+					evmmodule.ErrorDataRequired: coreMsg.Value.String(),
+					evmmodule.ErrorDataAddress:  coreMsg.From.Hex(),
+				},
 				"failed to transfer %s from address %s using the EVM block context transfer function",
 				coreMsg.Value,
 				coreMsg.From,
 			)
 		}
+
+		// the funds sent to a blocked module account are unrecoverable, the transfers made by
+		// the inner calls are reverted by the EVM, see the Transfer of the keeper
+		if coreMsg.To != nil && coreMsg.Value.Sign() > 0 && ctd.evmKeeper.IsBlockedAddr(*coreMsg.To) {
+			return ctx, errorsmod.Wrapf(evmmodule.ErrBlockedAddress, "%s is a module account", coreMsg.To.Hex())
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -116,9 +116,11 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx
 
 	protoTx := wrapperTx.GetProtoTx()
 	body := protoTx.Body
-	if body.Memo != "" || body.TimeoutHeight != uint64(0) || len(body.NonCriticalExtensionOptions) > 0 {
+	// Memo and TimeoutHeight are allowed for the deposit-tagging of exchanges, they must be
+	// signed by the sender, see EthTxMetadataVerificationDecorator.
+	if len(body.NonCriticalExtensionOptions) > 0 {
 		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest,
-			"for eth tx body Memo TimeoutHeight NonCriticalExtensionOptions should be empty")
+			"for eth tx body NonCriticalExtensionOptions should be empty")
 	}
 
 	if len(body.ExtensionOptions) != 1 {
@@ -139,6 +141,11 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx
 		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx Signatures should be empty")
 	}
 
+	// return error if the calls and creates are paused in an emergency
+	if err := vbd.evmKeeper.CheckPaused(ctx); err != nil {
+		return ctx, err
+	}
+
 	txFee := cosmos.Coins{}
 	txGasLimit := uint64(0)
 
@@ -5,6 +5,7 @@ import (
 
 	artela "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -68,7 +69,10 @@ func NewDynamicFeeChecker(k interfaces.DynamicFeeEVMKeeper) anteutils.TxFeeCheck
 		baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
 
 		if feeCap.LT(baseFeeInt) {
-			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas prices too low, got: %s%s required: %s%s. Please retry using a higher gas price or a higher fee", feeCap, denom, baseFeeInt, denom)
+			return nil, 0, evmmodule.WrapWithData(errortypes.ErrInsufficientFee, evmmodule.ErrorData{
+				evmmodule.ErrorDataProvided: feeCap.String(),
+				evmmodule.ErrorDataRequired: baseFeeInt.String(),
+			}, "gas prices too low, got: %s%s required: %s%s. Please retry using a higher gas price or a higher fee", feeCap, denom, baseFeeInt, denom)
 		}
 
 		// calculate the effective gas price using the EIP-1559 logic.
@@ -115,7 +119,10 @@ func checkTxFeeWithValidatorMinGasPrices(ctx cosmos.Context, tx cosmos.FeeTx) (c
 		}
 
 		if !feeCoins.IsAnyGTE(requiredFees) {
-			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			return nil, 0, evmmodule.WrapWithData(errortypes.ErrInsufficientFee, evmmodule.ErrorData{
+				evmmodule.ErrorDataProvided: feeCoins.String(),
+				evmmodule.ErrorDataRequired: requiredFees.String(),
+			}, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 		}
 	}
 
@@ -0,0 +1,43 @@
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/app/interfaces"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// EthGasPolicyDecorator checks the ethereum txs against the gas policies of their senders,
+// the txs over the max gas price, the daily gas budget or calling a target not allowed are
+// rejected. It runs in both CheckTx and DeliverTx, so the gas limits of the txs delivered
+// count against the daily gas budgets.
+type EthGasPolicyDecorator struct {
+	evmKeeper interfaces.EVMKeeper
+}
+
+// NewEthGasPolicyDecorator creates a new EthGasPolicyDecorator
+func NewEthGasPolicyDecorator(ek interfaces.EVMKeeper) EthGasPolicyDecorator {
+	return EthGasPolicyDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle checks each ethereum tx against the gas policy of its sender.
+func (gpd EthGasPolicyDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (cosmos.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*txs.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*txs.MsgEthereumTx)(nil))
+		}
+
+		from := common.BytesToAddress(msgEthTx.GetFrom())
+		if err := gpd.evmKeeper.CheckGasPolicy(ctx, from, msgEthTx.AsTransaction()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
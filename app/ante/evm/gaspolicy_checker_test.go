@@ -0,0 +1,118 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	evmante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func gasPolicyTx(t *testing.T, from common.Address, to *common.Address, gasPrice int64, gas uint64) cosmos.Tx {
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		GasPrice: big.NewInt(gasPrice), Gas: gas, To: to,
+	})))
+	return buildEthTx(t, msg, from, "", 0, nil)
+}
+
+func TestEthGasPolicyDecorator(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		other    = common.HexToAddress("0x1000000000000000000000000000000000000002")
+		target   = common.HexToAddress("0x1000000000000000000000000000000000000003")
+		disliked = common.HexToAddress("0x1000000000000000000000000000000000000004")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	senderAddr := cosmos.AccAddress(sender.Bytes()).String()
+	_, err := k.SetGasPolicy(ctx, &txs.MsgSetGasPolicy{
+		Sender: senderAddr,
+		Policy: support.GasPolicy{
+			Address:        senderAddr,
+			MaxGasPrice:    "10",
+			DailyGasBudget: 50_000,
+			AllowedTargets: []string{target.Hex()},
+		},
+	})
+	require.NoError(t, err)
+
+	dec := evmante.NewEthGasPolicyDecorator(k)
+	next := func(ctx cosmos.Context, _ cosmos.Tx, _ bool) (cosmos.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name    string
+		tx      cosmos.Tx
+		expPass bool
+	}{
+		{"sender without policy", gasPolicyTx(t, other, &disliked, 100, 100_000), true},
+		{"allowed tx", gasPolicyTx(t, sender, &target, 10, 21_000), true},
+		{"gas price over the max", gasPolicyTx(t, sender, &target, 11, 21_000), false},
+		{"target not allowed", gasPolicyTx(t, sender, &disliked, 10, 21_000), false},
+		{"contract creation", gasPolicyTx(t, sender, nil, 10, 21_000), false},
+		// 21_000 of the budget is spent by the allowed tx
+		{"gas limit within the daily budget", gasPolicyTx(t, sender, &target, 10, 29_000), true},
+		{"gas limit over the daily budget", gasPolicyTx(t, sender, &target, 10, 1), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := dec.AnteHandle(ctx, tc.tx, false, next)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrGasPolicyViolated)
+			}
+		})
+	}
+	require.Equal(t, uint64(50_000), k.GetDailyGasUsed(ctx, sender))
+
+	// the budget is renewed the next day
+	nextDay := ctx.WithBlockTime(ctx.BlockTime().AddDate(0, 0, 1))
+	_, err = dec.AnteHandle(nextDay, gasPolicyTx(t, sender, &target, 10, 21_000), false, next)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21_000), k.GetDailyGasUsed(nextDay, sender))
+}
+
+func TestGasPolicyValidate(t *testing.T) {
+	addr := cosmos.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+	targets := make([]string, support.MaxGasPolicyTargets+1)
+	for i := range targets {
+		targets[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Hex()
+	}
+
+	testCases := []struct {
+		name    string
+		policy  support.GasPolicy
+		expPass bool
+	}{
+		{"valid", support.GasPolicy{Address: addr, Admin: addr, MaxGasPrice: "0", DailyGasBudget: 1, AllowedTargets: targets[:1]}, true},
+		{"invalid address", support.GasPolicy{Address: "artela1invalid"}, false},
+		{"invalid admin", support.GasPolicy{Address: addr, Admin: "artela1invalid"}, false},
+		{"negative max gas price", support.GasPolicy{Address: addr, MaxGasPrice: "-1"}, false},
+		{"invalid max gas price", support.GasPolicy{Address: addr, MaxGasPrice: "1.5"}, false},
+		{"max allowed targets", support.GasPolicy{Address: addr, AllowedTargets: targets[:support.MaxGasPolicyTargets]}, true},
+		{"too many allowed targets", support.GasPolicy{Address: addr, AllowedTargets: targets}, false},
+		{"invalid allowed target", support.GasPolicy{Address: addr, AllowedTargets: []string{"0x1234"}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/artela-network/artela/app/interfaces"
 	evmtypes "github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -32,6 +33,17 @@ type EthMempoolFeeDecorator struct {
 	evmKeeper interfaces.EVMKeeper
 }
 
+// EthMinPriorityFeeDecorator will check if the priority fee per gas of the eth txs, the tip
+// paid on top of the base fee, is at least the MinPriorityFee param. On (Re)CheckTx the local
+// validator's min priority fee (defined in validator config) is checked too, it's the
+// mempool filter of the eth txs once the base fee is enabled, when the min-gas-prices are
+// skipped by EthMempoolFeeDecorator.
+type EthMinPriorityFeeDecorator struct {
+	feesKeeper          interfaces.FeeKeeper
+	evmKeeper           interfaces.EVMKeeper
+	localMinPriorityFee *big.Int
+}
+
 // NewEthMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
 // Ethereum transactions.
 func NewEthMinGasPriceDecorator(fk interfaces.FeeKeeper, ek interfaces.EVMKeeper) EthMinGasPriceDecorator {
@@ -46,6 +58,16 @@ func NewEthMempoolFeeDecorator(ek interfaces.EVMKeeper) EthMempoolFeeDecorator {
 	}
 }
 
+// NewEthMinPriorityFeeDecorator creates a new EthMinPriorityFeeDecorator instance used only
+// for Ethereum transactions, localMinPriorityFee is the min priority fee of the validator config.
+func NewEthMinPriorityFeeDecorator(fk interfaces.FeeKeeper, ek interfaces.EVMKeeper, localMinPriorityFee uint64) EthMinPriorityFeeDecorator {
+	return EthMinPriorityFeeDecorator{
+		feesKeeper:          fk,
+		evmKeeper:           ek,
+		localMinPriorityFee: new(big.Int).SetUint64(localMinPriorityFee),
+	}
+}
+
 // AnteHandle ensures that the effective fee from the transaction is greater than the
 // minimum global fee, which is defined by the  MinGasPrice (parameter) * GasLimit (tx argument).
 func (empd EthMinGasPriceDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (newCtx cosmos.Context, err error) {
@@ -97,8 +119,12 @@ func (empd EthMinGasPriceDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 		fee := cosmos.NewDecFromBigInt(feeAmt)
 
 		if fee.LT(requiredFee) {
-			return ctx, errorsmod.Wrapf(
+			return ctx, evmmodule.WrapWithData(
 				errortypes.ErrInsufficientFee,
+				evmmodule.ErrorData{
+					evmmodule.ErrorDataProvided: fee.TruncateInt().String(),
+					evmmodule.ErrorDataRequired: requiredFee.TruncateInt().String(),
+				},
 				"provided fee < minimum global fee (%d < %d). Please increase the priority tip (for EIP-1559 txs) or the gas prices (for access list or legacy txs)", //nolint:lll
 				fee.TruncateInt().Int64(), requiredFee.TruncateInt().Int64(),
 			)
@@ -139,8 +165,12 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, s
 		requiredFee := minGasPrice.Mul(gasLimit)
 
 		if fee.LT(requiredFee) {
-			return ctx, errorsmod.Wrapf(
+			return ctx, evmmodule.WrapWithData(
 				errortypes.ErrInsufficientFee,
+				evmmodule.ErrorData{
+					evmmodule.ErrorDataProvided: fee.TruncateInt().String(),
+					evmmodule.ErrorDataRequired: requiredFee.Ceil().TruncateInt().String(),
+				},
 				"insufficient fee; got: %s required: %s",
 				fee, requiredFee,
 			)
@@ -149,3 +179,57 @@ func (mfd EthMempoolFeeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, s
 
 	return next(ctx, tx, simulate)
 }
+
+// AnteHandle ensures that the effective priority fee per gas of the transaction is at least
+// the global min priority fee, and the local one if it's CheckTx. The effective priority fee
+// is min(gasTipCap, gasFeeCap - baseFee), or the gas price if the base fee is not enabled.
+func (mpfd EthMinPriorityFeeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (newCtx cosmos.Context, err error) {
+	minPriorityFee := mpfd.feesKeeper.GetParams(ctx).MinPriorityFee.BigInt()
+	source := "global"
+	if ctx.IsCheckTx() && !simulate && mpfd.localMinPriorityFee.Cmp(minPriorityFee) > 0 {
+		minPriorityFee = mpfd.localMinPriorityFee
+		source = "validator"
+	}
+
+	// short-circuit if min priority fee is 0
+	if minPriorityFee.Sign() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	evmParams := mpfd.evmKeeper.GetParams(ctx)
+	chainCfg := evmParams.GetChainConfig()
+	ethCfg := chainCfg.EthereumConfig(mpfd.evmKeeper.ChainID())
+	baseFee := mpfd.evmKeeper.GetBaseFee(ctx, ethCfg)
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data %s", ethMsg.Hash)
+		}
+
+		priorityFee := txData.GetGasTipCap()
+		if baseFee != nil {
+			effectivePrice := evmtypes.EffectiveGasPrice(baseFee, txData.GetGasFeeCap(), txData.GetGasTipCap())
+			priorityFee = new(big.Int).Sub(effectivePrice, baseFee)
+		}
+
+		if priorityFee.Cmp(minPriorityFee) < 0 {
+			return ctx, evmmodule.WrapWithData(
+				errortypes.ErrInsufficientFee,
+				evmmodule.ErrorData{
+					evmmodule.ErrorDataProvided: priorityFee.String(),
+					evmmodule.ErrorDataRequired: minPriorityFee.String(),
+				},
+				"priority fee per gas < %s minimum priority fee (%s < %s). Please increase the priority tip (for EIP-1559 txs) or the gas price (for access list or legacy txs)", //nolint:lll
+				source, priorityFee, minPriorityFee,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
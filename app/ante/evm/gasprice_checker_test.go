@@ -0,0 +1,100 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	evmante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+func priorityFeeTx(t *testing.T, from common.Address, txData ethtypes.TxData) cosmos.Tx {
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethtypes.NewTx(txData)))
+	return buildEthTx(t, msg, from, "", 0, nil)
+}
+
+func TestEthMinPriorityFeeDecorator(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		target = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	params := artela.FeeKeeper.GetParams(ctx)
+	params.MinPriorityFee = sdkmath.NewInt(10)
+	require.NoError(t, artela.FeeKeeper.SetParams(ctx, params))
+
+	chainCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.ChainID())
+	baseFee := k.GetBaseFee(ctx, chainCfg)
+	require.NotNil(t, baseFee)
+	dynamicFeeTx := func(tipCap, feeCap *big.Int) cosmos.Tx {
+		return priorityFeeTx(t, sender, &ethtypes.DynamicFeeTx{
+			ChainID: k.ChainID(), GasTipCap: tipCap, GasFeeCap: feeCap, Gas: 21_000, To: &target,
+		})
+	}
+	legacyTx := func(gasPrice *big.Int) cosmos.Tx {
+		return priorityFeeTx(t, sender, &ethtypes.LegacyTx{GasPrice: gasPrice, Gas: 21_000, To: &target})
+	}
+	plus := func(n int64) *big.Int { return new(big.Int).Add(baseFee, big.NewInt(n)) }
+
+	// the validator config raises the min priority fee to 20 on CheckTx
+	dec := evmante.NewEthMinPriorityFeeDecorator(artela.FeeKeeper, k, 20)
+	next := func(ctx cosmos.Context, _ cosmos.Tx, _ bool) (cosmos.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name       string
+		tx         cosmos.Tx
+		expDeliver bool
+		expCheck   bool
+	}{
+		{"tip above both minimums", dynamicFeeTx(big.NewInt(20), plus(20)), true, true},
+		{"tip between the global and the validator minimum", dynamicFeeTx(big.NewInt(10), plus(100)), true, false},
+		{"tip below the global minimum", dynamicFeeTx(big.NewInt(9), plus(100)), false, false},
+		{"tip capped by the fee cap", dynamicFeeTx(big.NewInt(100), plus(9)), false, false},
+		{"legacy gas price above the base fee", legacyTx(plus(10)), true, false},
+		{"legacy gas price below the min priority fee", legacyTx(plus(9)), false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := dec.AnteHandle(ctx.WithIsCheckTx(false), tc.tx, false, next)
+			if tc.expDeliver {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+			}
+
+			_, err = dec.AnteHandle(ctx.WithIsCheckTx(true), tc.tx, false, next)
+			if tc.expCheck {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+			}
+
+			// the simulations are checked against the global minimum only
+			_, err = dec.AnteHandle(ctx.WithIsCheckTx(true), tc.tx, true, next)
+			if tc.expDeliver {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrInsufficientFee)
+			}
+		})
+	}
+
+	// the check is skipped without a min priority fee
+	params.MinPriorityFee = sdkmath.ZeroInt()
+	require.NoError(t, artela.FeeKeeper.SetParams(ctx, params))
+	_, err := evmante.NewEthMinPriorityFeeDecorator(artela.FeeKeeper, k, 0).AnteHandle(ctx, dynamicFeeTx(big.NewInt(0), baseFee), false, next)
+	require.NoError(t, err)
+}
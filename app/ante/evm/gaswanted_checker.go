@@ -2,9 +2,11 @@ package evm
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/artela-network/artela/app/interfaces"
 	"github.com/artela-network/artela/ethereum/types"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -47,8 +49,12 @@ func (gwd GasWantedDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simul
 	// return error if the tx gas is greater than the block limit (max gas)
 	blockGasLimit := types.BlockGasLimit(ctx)
 	if gasWanted > blockGasLimit {
-		return ctx, errorsmod.Wrapf(
+		return ctx, evmmodule.WrapWithData(
 			errortypes.ErrOutOfGas,
+			evmmodule.ErrorData{
+				evmmodule.ErrorDataProvided: strconv.FormatUint(gasWanted, 10),
+				evmmodule.ErrorDataLimit:    strconv.FormatUint(blockGasLimit, 10),
+			},
 			"tx gas (%d) exceeds block gas limit (%d)",
 			gasWanted,
 			blockGasLimit,
@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // EthSigVerificationDecorator validates an ethereum signatures
@@ -48,3 +49,49 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx cosmos.Context, tx cosmos
 
 	return next(ctx, tx, simulate)
 }
+
+// EthTxMetadataVerificationDecorator validates that the memo and the timeout height of the cosmos
+// tx wrapping an eth tx are signed by the sender of the eth tx. They're not covered by the eth
+// signature, so without it anyone could rewrap a pending eth tx with a different memo.
+type EthTxMetadataVerificationDecorator struct{}
+
+// NewEthTxMetadataVerificationDecorator creates a new EthTxMetadataVerificationDecorator
+func NewEthTxMetadataVerificationDecorator() EthTxMetadataVerificationDecorator {
+	return EthTxMetadataVerificationDecorator{}
+}
+
+// AnteHandle verifies the metadata signature of the ExtensionOptionsEthereumTx against the
+// sender set up by EthSigVerificationDecorator, the txs without memo and timeout height pass.
+func (tmvd EthTxMetadataVerificationDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (cosmos.Context, error) {
+	wrapperTx, ok := tx.(interfaces.ProtoTxProvider)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid tx type %T, didn't implement interface protoTxProvider", tx)
+	}
+
+	body := wrapperTx.GetProtoTx().Body
+	if body.Memo == "" && body.TimeoutHeight == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "memo and timeout height are only allowed for a single eth tx")
+	}
+	msgEthTx, ok := msgs[0].(*txs.MsgEthereumTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msgs[0], (*txs.MsgEthereumTx)(nil))
+	}
+	if len(body.ExtensionOptions) != 1 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
+	}
+
+	var option txs.ExtensionOptionsEthereumTx
+	if err := option.Unmarshal(body.ExtensionOptions[0].Value); err != nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "invalid ExtensionOptionsEthereumTx")
+	}
+	if err := msgEthTx.VerifyTxMetadata(common.HexToAddress(msgEthTx.From), body.Memo, body.TimeoutHeight, option.MetadataSignature); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,132 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	evmante "github.com/artela-network/artela/app/ante/evm"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+func signedEthMsg(t *testing.T) (*txs.MsgEthereumTx, common.Address, func(hash common.Hash) []byte) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &to, Value: big.NewInt(1),
+	}), ethtypes.LatestSignerForChainID(big.NewInt(11820)), key)
+	require.NoError(t, err)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	sign := func(hash common.Hash) []byte {
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, err)
+		return sig
+	}
+	return msg, sender, sign
+}
+
+func buildEthTx(t *testing.T, msg *txs.MsgEthereumTx, sender common.Address, memo string, timeoutHeight uint64, sig []byte) cosmos.Tx {
+	builder := app.MakeConfig(app.ModuleBasics).TxConfig.NewTxBuilder()
+	builder.SetMemo(memo)
+	builder.SetTimeoutHeight(timeoutHeight)
+	tx, err := msg.BuildTxWithMetadata(builder, "uart", sig)
+	require.NoError(t, err)
+
+	// the sender is set up by EthSigVerificationDecorator
+	msg.From = sender.Hex()
+	return tx
+}
+
+func TestEthTxMetadataVerification(t *testing.T) {
+	const memo = "deposit-1234"
+
+	testCases := []struct {
+		name    string
+		build   func(msg *txs.MsgEthereumTx, sender common.Address, sign func(common.Hash) []byte) cosmos.Tx
+		expPass bool
+	}{
+		{
+			"no memo and timeout height",
+			func(msg *txs.MsgEthereumTx, sender common.Address, _ func(common.Hash) []byte) cosmos.Tx {
+				return buildEthTx(t, msg, sender, "", 0, nil)
+			},
+			true,
+		},
+		{
+			"memo and timeout height signed by the sender",
+			func(msg *txs.MsgEthereumTx, sender common.Address, sign func(common.Hash) []byte) cosmos.Tx {
+				sig := sign(txs.TxMetadataHash(msg.AsTransaction().Hash(), memo, 100))
+				return buildEthTx(t, msg, sender, memo, 100, sig)
+			},
+			true,
+		},
+		{
+			"memo without signature",
+			func(msg *txs.MsgEthereumTx, sender common.Address, _ func(common.Hash) []byte) cosmos.Tx {
+				return buildEthTx(t, msg, sender, memo, 0, nil)
+			},
+			false,
+		},
+		{
+			"memo rewrapped by a relayer",
+			func(msg *txs.MsgEthereumTx, sender common.Address, sign func(common.Hash) []byte) cosmos.Tx {
+				sig := sign(txs.TxMetadataHash(msg.AsTransaction().Hash(), memo, 0))
+				return buildEthTx(t, msg, sender, "deposit-5678", 0, sig)
+			},
+			false,
+		},
+		{
+			"timeout height changed by a relayer",
+			func(msg *txs.MsgEthereumTx, sender common.Address, sign func(common.Hash) []byte) cosmos.Tx {
+				sig := sign(txs.TxMetadataHash(msg.AsTransaction().Hash(), memo, 100))
+				return buildEthTx(t, msg, sender, memo, 200, sig)
+			},
+			false,
+		},
+		{
+			"memo signed by another key",
+			func(msg *txs.MsgEthereumTx, sender common.Address, _ func(common.Hash) []byte) cosmos.Tx {
+				other, err := crypto.GenerateKey()
+				require.NoError(t, err)
+				sig, err := crypto.Sign(txs.TxMetadataHash(msg.AsTransaction().Hash(), memo, 0).Bytes(), other)
+				require.NoError(t, err)
+				return buildEthTx(t, msg, sender, memo, 0, sig)
+			},
+			false,
+		},
+	}
+
+	nextCalled := false
+	next := func(ctx cosmos.Context, _ cosmos.Tx, _ bool) (cosmos.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextCalled = false
+			msg, sender, sign := signedEthMsg(t)
+			tx := tc.build(msg, sender, sign)
+
+			_, err := evmante.NewEthTxMetadataVerificationDecorator().AnteHandle(cosmos.Context{}, tx, false, next)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.True(t, nextCalled)
+			} else {
+				require.Error(t, err)
+				require.False(t, nextCalled)
+			}
+		})
+	}
+}
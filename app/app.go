@@ -5,6 +5,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -122,6 +124,7 @@ import (
 	appparams "github.com/artela-network/artela/app/params"
 	"github.com/artela-network/artela/app/post"
 	"github.com/artela-network/artela/docs"
+	"github.com/artela-network/artela/ethereum/replica"
 	srvflags "github.com/artela-network/artela/ethereum/server/flags"
 	artela "github.com/artela-network/artela/ethereum/types"
 	aspecttypes "github.com/artela-network/aspect-core/types"
@@ -198,7 +201,8 @@ var (
 		govmodule.ModuleName:            {authmodule.Burner},
 		transfermodule.ModuleName:       {authmodule.Minter, authmodule.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
-		evmmoduletypes.ModuleName: {authmodule.Minter, authmodule.Burner},
+		evmmoduletypes.ModuleName:        {authmodule.Minter, authmodule.Burner},
+		evmmoduletypes.FaucetAccountName: nil,
 	}
 )
 
@@ -233,6 +237,17 @@ type Artela struct {
 
 	invCheckPeriod uint
 
+	// historyEpochLength is the epoch length of the header retention policy
+	historyEpochLength uint64
+
+	// proposalListener is notified of the processed block proposals
+	proposalListener atomic.Value
+	// checkTxListener is notified of the new txs passing the CheckTx
+	checkTxListener atomic.Value
+	// checkTxErrors keeps the info of the errors rejecting the txs in the ante handler of
+	// the CheckTx by the tx bytes, until it's served in the data of the CheckTx response
+	checkTxErrors sync.Map
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -549,8 +564,14 @@ func NewArtela(
 	app.EvmKeeper = evmmodulekeeper.NewKeeper(
 		appCodec, keys[evmmoduletypes.StoreKey], tkeys[evmmoduletypes.TransientKey], authmodule.NewModuleAddress(govmodule.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeKeeper,
-		"1", app.GetSubspace(evmmoduletypes.ModuleName), bApp, logger,
+		cast.ToString(appOpts.Get(srvflags.EVMTracer)), app.GetSubspace(evmmoduletypes.ModuleName), bApp, logger,
 	)
+	app.EvmKeeper.SetVMOptions(evmmodulekeeper.VMOptions{
+		SimulationNoBaseFee:     cast.ToBool(appOpts.Get(srvflags.EVMSimulationNoBaseFee)),
+		EnablePreimageRecording: cast.ToBool(appOpts.Get(srvflags.EVMEnablePreimageRecording)),
+		RPCGasCap:               cast.ToUint64(appOpts.Get(srvflags.EVMRPCGasCap)),
+	})
+	app.EvmKeeper.SetBlockedAddrs(app.BlockedModuleAccountAddrs())
 	evmModule := evmmodule.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmmoduletypes.ModuleName))
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
@@ -741,13 +762,22 @@ func NewArtela(
 	app.MountMemoryStores(memKeys)
 
 	// initialize BaseApp
+	app.historyEpochLength = historyEpochLength(appOpts)
+	if app.historyEpochLength > 0 {
+		logger.Info("history retention enabled", "epoch-length", app.historyEpochLength,
+			"retention-epochs", cast.ToUint64(appOpts.Get(srvflags.EVMHistoryRetentionEpochs)))
+	}
+
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	minPriorityFee := cast.ToUint64(appOpts.Get(srvflags.EVMMinPriorityFee))
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, minPriorityFee)
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	// init Aspect
 	app.setPostHandler()
+	app.SetPrepareProposal(app.prepareProposal)
+	app.SetProcessProposal(app.processProposal)
 
 	// // aspect add ProposalHandler
 	// aspectProposalHandler := handle.NewArtelaProposalHandler(bApp.GetMemPool(), bApp)
@@ -768,7 +798,7 @@ func NewArtela(
 }
 
 // TODO mark
-func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted, minPriorityFee uint64) {
 	options := ante.AnteDecorators{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -781,6 +811,7 @@ func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
+		MinPriorityFee:         minPriorityFee,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
 
 		// TODO StakingKeeper:          app.StakingKeeper,
@@ -791,7 +822,21 @@ func (app *Artela) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		panic(err)
 	}
 
-	app.SetAnteHandler(ante.NewAnteHandler(app.BaseApp, options))
+	app.SetAnteHandler(app.recordCheckTxErrors(ante.NewAnteHandler(app.BaseApp, options)))
+}
+
+// recordCheckTxErrors wraps the ante handler to keep the codespace, code and data of the
+// errors rejecting the txs in the CheckTx, the SDK only serves the log of the ante errors.
+func (app *Artela) recordCheckTxErrors(anteHandler cosmos.AnteHandler) cosmos.AnteHandler {
+	return func(ctx cosmos.Context, tx cosmos.Tx, sim bool) (cosmos.Context, error) {
+		newCtx, err := anteHandler(ctx, tx, sim)
+		if err != nil && ctx.IsCheckTx() && !sim {
+			if info, ok := evmmoduletypes.ErrorInfoOf(err); ok {
+				app.checkTxErrors.Store(string(ctx.TxBytes()), info)
+			}
+		}
+		return newCtx, err
+	}
 }
 
 func (app *Artela) setPostHandler() {
@@ -933,6 +978,81 @@ func (app *Artela) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	app.EvmKeeper.SetClientContext(clientCtx)
 }
 
+// SetStateFeed records the state changes of the committed blocks with the recorder, the
+// writes to all the KV stores of the app are recorded.
+func (app *Artela) SetStateFeed(recorder *replica.Recorder) {
+	keys := make([]storetypes.StoreKey, 0, len(app.keys))
+	for _, key := range app.keys {
+		keys = append(keys, key)
+	}
+	recorder.SetStoreKeys(keys)
+	app.SetStreamingService(recorder)
+}
+
+// SetPreimageDB sets the node local db where the EVM records the SHA3 preimages.
+func (app *Artela) SetPreimageDB(db dbm.DB) {
+	app.EvmKeeper.SetPreimageDB(db)
+}
+
+// SetAccessStatsDB sets the node local db where the EVM records the storage access counters.
+func (app *Artela) SetAccessStatsDB(db dbm.DB) {
+	app.EvmKeeper.SetAccessStatsDB(db)
+}
+
+// SetContractIndexDB sets the node local db where the EVM indexes the contract creations.
+func (app *Artela) SetContractIndexDB(db dbm.DB) {
+	app.EvmKeeper.SetContractIndexDB(db)
+}
+
+// SetWitnessDB sets the node local db where the EVM records the execution witnesses of the blocks.
+func (app *Artela) SetWitnessDB(db dbm.DB) {
+	app.EvmKeeper.SetWitnessDB(db)
+}
+
+// SetProposalListener sets the listener notified of the processed block proposals, the
+// listener must not block nor modify the proposal.
+func (app *Artela) SetProposalListener(listener func(proposal *abci.RequestProcessProposal)) {
+	app.proposalListener.Store(listener)
+}
+
+// processProposal accepts the proposals like the default handler of the no-op mempool,
+// unless their ethereum txs exceed the gas quota of their senders, and notifies the proposal
+// listener of the accepted proposals.
+func (app *Artela) processProposal(ctx cosmos.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	if !app.verifySenderGasQuota(ctx, req.Txs) {
+		app.Logger().Info("rejected proposal exceeding the sender gas quota", "height", req.Height)
+		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+	}
+	if listener, ok := app.proposalListener.Load().(func(proposal *abci.RequestProcessProposal)); ok {
+		listener(&req)
+	}
+	return baseapp.NoOpProcessProposal()(ctx, req)
+}
+
+// SetCheckTxListener sets the listener notified of the new txs passing the CheckTx, the
+// listener must not block nor modify the tx.
+func (app *Artela) SetCheckTxListener(listener func(tx []byte)) {
+	app.checkTxListener.Store(listener)
+}
+
+// CheckTx implements the ABCI CheckTx method, it notifies the check tx listener of the new
+// txs accepted for the mempool, the rechecks are not notified. The codespace, code and data
+// of the errors rejecting the txs are JSON encoded in the data of the response.
+func (app *Artela) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+	if info, ok := app.checkTxErrors.LoadAndDelete(string(req.Tx)); ok && !res.IsOK() {
+		if bz, err := json.Marshal(info); err == nil {
+			res.Data = bz
+		}
+	}
+	if res.IsOK() && req.Type == abci.CheckTxType_New {
+		if listener, ok := app.checkTxListener.Load().(func(tx []byte)); ok {
+			listener(req.Tx)
+		}
+	}
+	return res
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *Artela) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
@@ -0,0 +1,53 @@
+package app_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/states"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestCheckTxErrorData(t *testing.T) {
+	artela := app.Setup(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// fund the sender and commit a block proposed by the validator, the CheckTx state
+	// inherits its proposer
+	ctx := app.NewTestContext(t, artela)
+	stateDB := states.New(ctx, artela.EvmKeeper, states.NewEmptyTxConfig(common.Hash{}))
+	stateDB.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1e18))
+	require.NoError(t, stateDB.Commit())
+
+	header := ctx.BlockHeader()
+	artela.BeginBlock(abci.RequestBeginBlock{Header: header})
+	artela.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	artela.Commit()
+
+	// the gas price of the tx is below the base fee
+	res := artela.CheckTx(abci.RequestCheckTx{Tx: encodeEthTx(t, artela, key, 0, 21000), Type: abci.CheckTxType_New})
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "max fee per gas less than block base fee")
+	require.NotContains(t, res.Log, "{")
+
+	var info evmtypes.ErrorInfo
+	require.NoError(t, json.Unmarshal(res.Data, &info))
+	require.Equal(t, res.Codespace, info.Codespace)
+	require.Equal(t, res.Code, info.Code)
+	require.Equal(t, "1", info.Data[evmtypes.ErrorDataProvided])
+	require.NotEmpty(t, info.Data[evmtypes.ErrorDataRequired])
+
+	// the errors without data don't carry any
+	res = artela.CheckTx(abci.RequestCheckTx{Tx: []byte("invalid"), Type: abci.CheckTxType_New})
+	require.False(t, res.IsOK())
+	require.Empty(t, res.Data)
+}
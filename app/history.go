@@ -0,0 +1,56 @@
+package app
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/spf13/cast"
+
+	srvflags "github.com/artela-network/artela/ethereum/server/flags"
+)
+
+// historyEpochLength returns the epoch length of the history retention policy,
+// 0 if the policy is disabled or the node is an archive node.
+func historyEpochLength(appOpts servertypes.AppOptions) uint64 {
+	if cast.ToString(appOpts.Get(server.FlagPruning)) == pruningtypes.PruningOptionNothing {
+		return 0
+	}
+
+	if cast.ToUint64(appOpts.Get(srvflags.EVMHistoryRetentionEpochs)) == 0 {
+		return 0
+	}
+
+	return cast.ToUint64(appOpts.Get(srvflags.EVMHistoryEpochLength))
+}
+
+// HistoryRetainBlocks returns the min-retain-blocks the node should run with,
+// taking the history retention policy into account. The configured
+// min-retain-blocks is kept if it retains more blocks than the policy.
+func HistoryRetainBlocks(appOpts servertypes.AppOptions, minRetainBlocks uint64) uint64 {
+	epochLength := historyEpochLength(appOpts)
+	if epochLength == 0 {
+		return minRetainBlocks
+	}
+
+	retain := cast.ToUint64(appOpts.Get(srvflags.EVMHistoryRetentionEpochs)) * epochLength
+	if minRetainBlocks == 0 || retain > minRetainBlocks {
+		return retain
+	}
+	return minRetainBlocks
+}
+
+// Commit overrides the default BaseApp's ABCI commit, it aligns the retain
+// height down to the start of an epoch, so that the block headers and results
+// are compacted by CometBFT once per epoch instead of once per block. The EVM
+// indexer prunes the txs and receipts of the blocks below the earliest block
+// left in the block store, see EVMIndexerService.SetHistoryPruning.
+func (app *Artela) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+
+	if app.historyEpochLength > 0 && res.RetainHeight > 0 {
+		res.RetainHeight -= res.RetainHeight % int64(app.historyEpochLength) // #nosec G701
+	}
+
+	return res
+}
@@ -7,6 +7,7 @@ import (
 
 	ethereum "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/artela-network/artela/x/evm/exported"
 	"github.com/artela-network/artela/x/evm/states"
 
 	"github.com/artela-network/artela-evm/vm"
@@ -20,20 +21,21 @@ import (
 	feemodule "github.com/artela-network/artela/x/fee/types"
 )
 
-// EVMKeeper defines the expected keeper interface used on the AnteHandler
+// EVMKeeper defines the expected keeper interface used on the AnteHandler, it extends the
+// public interface of the EVM keeper with the internals of the tx processing.
 type EVMKeeper interface { // nolint: revive
 	states.Keeper
+	exported.EVMKeeper
 	DynamicFeeEVMKeeper
 
 	NewEVM(ctx cosmos.Context, msg *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx cosmos.Context, fees cosmos.Coins, from common.Address) error
-	GetBalance(ctx cosmos.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx cosmos.Context)
 	GetTxIndexTransient(ctx cosmos.Context) uint64
-	GetParams(ctx cosmos.Context) evmtypes.Params
-	GetChainConfig(ctx cosmos.Context) *params.ChainConfig
-	VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error)
-	EVMConfigFromCtx(ctx cosmos.Context) (*states.EVMConfig, error)
+	CheckPaused(ctx cosmos.Context) error
+	IsBlockedAddr(addr common.Address) bool
+	CheckGasPolicy(ctx cosmos.Context, from common.Address, tx *ethereum.Transaction) error
+	AspectAccessGas(ctx cosmos.Context, evmParams evmtypes.Params, cfg *params.ChainConfig, to *common.Address, accessList ethereum.AccessList) (uint64, error)
 	GetBlockContext() *artvmtype.EthBlockContext
 	GetAspectRuntimeContext() *artvmtype.AspectRuntimeContext
 	MakeSigner(ctx cosmos.Context, tx *ethereum.Transaction, config *params.ChainConfig, blockNumber *big.Int, blockTime uint64) ethereum.Signer
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// senderGasQuota tracks the gas the ethereum txs of each sender take in a block proposal,
+// against the sender gas quota of the evm params.
+type senderGasQuota struct {
+	chainID *big.Int
+	limit   uint64
+	used    map[common.Address]uint64
+}
+
+// newSenderGasQuota returns the quota of the proposal in the given context, nil if the
+// quota is disabled.
+func (app *Artela) newSenderGasQuota(ctx cosmos.Context) *senderGasQuota {
+	limit, ok := app.EvmKeeper.GetParams(ctx).SenderGasLimit(artela.BlockGasLimit(ctx))
+	if !ok {
+		return nil
+	}
+	return &senderGasQuota{
+		chainID: app.EvmKeeper.ChainID(),
+		limit:   limit,
+		used:    make(map[common.Address]uint64),
+	}
+}
+
+// fits reports if the ethereum txs of the given tx fit in the quota of their senders, the
+// gas of the txs is counted only if they fit, otherwise the quota of the exceeding sender
+// is exhausted. The txs whose sender can't be recovered from the signature (e.g. the txs
+// verified by aspects) are not counted.
+func (q *senderGasQuota) fits(tx cosmos.Tx) bool {
+	wanted := make(map[common.Address]uint64)
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*txs.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		// the sender of the txs is set from the signature in the ante handler
+		recovered := txs.MsgEthereumTx{Data: ethMsg.Data}
+		sender, err := recovered.GetSender(q.chainID)
+		if err != nil {
+			continue
+		}
+
+		gas := wanted[sender] + ethMsg.GetGas()
+		if gas < wanted[sender] || q.used[sender]+gas < q.used[sender] || q.used[sender]+gas > q.limit {
+			// exhaust the quota, the next txs of the sender would have a nonce gap
+			q.used[sender] = q.limit
+			return false
+		}
+		wanted[sender] = gas
+	}
+
+	for sender, gas := range wanted {
+		q.used[sender] += gas
+	}
+	return true
+}
+
+// prepareProposal proposes the txs like the default handler of the no-op mempool, but
+// leaves out the ethereum txs exceeding the gas quota of their senders.
+func (app *Artela) prepareProposal(ctx cosmos.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	quota := app.newSenderGasQuota(ctx)
+	if quota == nil {
+		return abci.ResponsePrepareProposal{Txs: req.Txs}
+	}
+
+	proposal := make([][]byte, 0, len(req.Txs))
+	for _, bz := range req.Txs {
+		tx, err := app.txConfig.TxDecoder()(bz)
+		if err == nil && !quota.fits(tx) {
+			continue
+		}
+		proposal = append(proposal, bz)
+	}
+	return abci.ResponsePrepareProposal{Txs: proposal}
+}
+
+// verifySenderGasQuota reports if the ethereum txs of the proposal fit in the gas quota of
+// their senders.
+func (app *Artela) verifySenderGasQuota(ctx cosmos.Context, proposal [][]byte) bool {
+	quota := app.newSenderGasQuota(ctx)
+	if quota == nil {
+		return true
+	}
+
+	for _, bz := range proposal {
+		tx, err := app.txConfig.TxDecoder()(bz)
+		if err == nil && !quota.fits(tx) {
+			return false
+		}
+	}
+	return true
+}
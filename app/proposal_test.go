@@ -0,0 +1,78 @@
+package app_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// encodeEthTx returns the encoded cosmos tx of a transfer signed by the key.
+func encodeEthTx(t *testing.T, artela *app.Artela, key *ecdsa.PrivateKey, nonce, gas uint64) []byte {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	ethTx, err := ethtypes.SignTx(ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce: nonce, GasPrice: big.NewInt(1), Gas: gas, To: &to, Value: big.NewInt(1),
+	}), ethtypes.LatestSignerForChainID(artela.EvmKeeper.ChainID()), key)
+	require.NoError(t, err)
+
+	msg := &txs.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	txConfig := app.MakeConfig(app.ModuleBasics).TxConfig
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), "uart")
+	require.NoError(t, err)
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestProposalSenderGasQuota(t *testing.T) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+
+	// a sender may take 10% of the 2M block gas limit of the test consensus params
+	params := artela.EvmKeeper.GetParams(ctx)
+	params.SenderGasQuota = 1000
+	require.NoError(t, artela.EvmKeeper.SetParams(ctx, params))
+
+	greedy, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var (
+		greedyTxs = [][]byte{
+			encodeEthTx(t, artela, greedy, 0, 80_000),
+			encodeEthTx(t, artela, greedy, 1, 80_000),
+			encodeEthTx(t, artela, greedy, 2, 80_000),
+			encodeEthTx(t, artela, greedy, 3, 21_000),
+		}
+		otherTx = encodeEthTx(t, artela, other, 0, 80_000)
+	)
+	proposal := append(append([][]byte{}, greedyTxs...), otherTx)
+	height := ctx.BlockHeight()
+
+	// the proposal over the quota of the greedy sender is rejected
+	res := artela.ProcessProposal(abci.RequestProcessProposal{Txs: proposal, Height: height})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// the txs of the greedy sender after the excess are dropped, so their nonces have no gap
+	prepared := artela.PrepareProposal(abci.RequestPrepareProposal{Txs: proposal, Height: height, MaxTxBytes: 1 << 20})
+	require.Equal(t, [][]byte{greedyTxs[0], greedyTxs[1], otherTx}, prepared.Txs)
+
+	res = artela.ProcessProposal(abci.RequestProcessProposal{Txs: prepared.Txs, Height: height})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	// the quota is disabled by default
+	params.SenderGasQuota = 0
+	require.NoError(t, artela.EvmKeeper.SetParams(ctx, params))
+	res = artela.ProcessProposal(abci.RequestProcessProposal{Txs: proposal, Height: height})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChainID is the chain id of the apps created by Setup.
+const TestChainID = "artela_11820-1"
+
+// Setup returns an app initialized from the default genesis with a single validator, with the
+// genesis block committed.
+func Setup(t testing.TB) *Artela {
+	t.Helper()
+
+	app := NewArtela(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0,
+		MakeConfig(ModuleBasics), simtestutil.EmptyAppOptions{}, baseapp.SetChainID(TestChainID))
+
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	senderKey := secp256k1.GenPrivKey()
+	account := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), NewDefaultGenesisState(app.AppCodec()),
+		valSet, []authtypes.GenesisAccount{account})
+	require.NoError(t, err)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         TestChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	return app
+}
+
+// NewTestContext returns an uncached deliver context of the next block of the app, proposed
+// by the genesis validator.
+func NewTestContext(t testing.TB, app *Artela) sdk.Context {
+	t.Helper()
+
+	header := tmproto.Header{ChainID: TestChainID, Height: app.LastBlockHeight() + 1}
+	ctx := app.BaseApp.NewUncachedContext(false, header)
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	header.ProposerAddress = consAddr
+	return ctx.WithBlockHeader(header)
+}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+var _ rpctypes.TraceSnapshots = traceSnapshots{}
+
+// traceSnapshots runs the debug traces of the evm keeper in-process, each trace gets a
+// dedicated read-only snapshot of the state at the height from the versioned readers of the
+// multistore, which is dropped once the trace is done.
+type traceSnapshots struct {
+	app *Artela
+}
+
+// TraceSnapshots returns the runner of the debug traces against the state snapshots.
+func (app *Artela) TraceSnapshots() rpctypes.TraceSnapshots {
+	return traceSnapshots{app: app}
+}
+
+// TraceTx implements rpctypes.TraceSnapshots.
+func (s traceSnapshots) TraceTx(ctx context.Context, height int64, req *txs.QueryTraceTxRequest) (*txs.QueryTraceTxResponse, error) {
+	sdkCtx, err := s.snapshotContext(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return s.app.EvmKeeper.TraceTx(sdkCtx, req)
+}
+
+// TraceBlock implements rpctypes.TraceSnapshots.
+func (s traceSnapshots) TraceBlock(ctx context.Context, height int64, req *txs.QueryTraceBlockRequest) (*txs.QueryTraceBlockResponse, error) {
+	sdkCtx, err := s.snapshotContext(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	return s.app.EvmKeeper.TraceBlock(sdkCtx, req)
+}
+
+// snapshotContext returns the query context over a snapshot of the state at the height, it's
+// cancelled along with ctx.
+func (s traceSnapshots) snapshotContext(ctx context.Context, height int64) (context.Context, error) {
+	queryCtx, err := s.app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	return cosmos.WrapSDKContext(queryCtx.WithContext(ctx)), nil
+}
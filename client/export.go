@@ -5,12 +5,10 @@ import (
 	"fmt"
 	"strings"
 
-	ethsecp256k12 "github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
 	"github.com/artela-network/artela/ethereum/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
-	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
@@ -51,26 +49,11 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 			}
 
 			// Exports private key from keybase using password
-			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
+			ethPrivKey, err := keyringEthPrivKey(clientCtx.Keyring, args[0], decryptPassword)
 			if err != nil {
 				return err
 			}
 
-			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			if algo != ethsecp256k12.KeyType {
-				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k12.KeyType)
-			}
-
-			// Converts key to Artela secp256k1 implementation
-			ethPrivKey, ok := privKey.(*ethsecp256k12.PrivKey)
-			if !ok {
-				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k12.PrivKey{})
-			}
-
 			key, err := ethPrivKey.ToECDSA()
 			if err != nil {
 				return err
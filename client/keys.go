@@ -65,6 +65,8 @@ The pass backend requires GnuPG: https://gnupg.org/
 		addCmd,
 		keys.ExportKeyCommand(),
 		keys.ImportKeyCommand(),
+		ExportEthKeystoreCommand(),
+		ImportEthKeystoreCommand(),
 		keys.ListKeysCmd(),
 		keys.ShowKeysCmd(),
 		keys.DeleteKeyCommand(),
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
+	"github.com/artela-network/artela/ethereum/crypto/hd"
+)
+
+const (
+	// flagOutput is the file the keystore json is written to
+	flagOutput = "output"
+	// flagLightKDF encrypts the keystore with the light scrypt parameters
+	flagLightKDF = "light-kdf"
+)
+
+// ImportEthKeystoreCommand imports a private key from a keystore v3 json file of geth.
+func ImportEthKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth-keystore <name> <keyfile>",
+		Short: "Import an Ethereum private key from a geth keystore file",
+		Long: `Import an Ethereum private key from a keystore v3 json file of geth into the local keybase.
+The passphrase of the keystore is prompted for, it also encrypts the key in the keybase.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			passphrase, err := input.GetPassword("Enter passphrase to decrypt the keystore:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			privKey, err := ethsecp256k1.DecryptKeystore(keyJSON, passphrase)
+			if err != nil {
+				return err
+			}
+
+			armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+			return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
+		},
+	}
+}
+
+// ExportEthKeystoreCommand exports the key with the given name as a keystore v3 json of geth.
+func ExportEthKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-eth-keystore <name>",
+		Short: "Export an Ethereum private key as a geth keystore file",
+		Long: `Export an Ethereum private key as a keystore v3 json of geth, encrypted with a passphrase
+prompted for. The keystore is written to the output file if set, to the standard output otherwise.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt the keystore:", inBuf)
+			if err != nil {
+				return err
+			}
+			repeated, err := input.GetPassword("Repeat the passphrase:", inBuf)
+			if err != nil {
+				return err
+			}
+			if passphrase != repeated {
+				return errors.New("passphrases don't match")
+			}
+
+			privKey, err := keyringEthPrivKey(clientCtx.Keyring, args[0], passphrase)
+			if err != nil {
+				return err
+			}
+
+			scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+			if lightKDF, _ := cmd.Flags().GetBool(flagLightKDF); lightKDF {
+				scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+			}
+			keyJSON, err := ethsecp256k1.EncryptKeystore(privKey, passphrase, scryptN, scryptP)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				cmd.Println(string(keyJSON))
+				return nil
+			}
+			return os.WriteFile(output, keyJSON, 0o600)
+		},
+	}
+
+	cmd.Flags().String(flagOutput, "", "The file the keystore is written to, the standard output if empty")
+	cmd.Flags().Bool(flagLightKDF, false, "Encrypt the keystore with the light scrypt parameters, faster but less secure")
+	return cmd
+}
+
+// keyringEthPrivKey returns the eth_secp256k1 private key of the key with the given name, the
+// key is exported from the keyring armored with the passphrase.
+func keyringEthPrivKey(kr keyring.Keyring, name, passphrase string) (*ethsecp256k1.PrivKey, error) {
+	armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+	return ethPrivKey, nil
+}
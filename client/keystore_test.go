@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	ethcodec "github.com/artela-network/artela/ethereum/crypto/codec"
+	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
+	"github.com/artela-network/artela/ethereum/crypto/hd"
+)
+
+func newTestKeyring(t *testing.T) keyring.Keyring {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	ethcodec.RegisterInterfaces(registry)
+	// the keys are armored with the amino codec
+	ethcodec.RegisterCrypto(codec.NewLegacyAmino())
+
+	kr, err := keyring.New(t.Name(), keyring.BackendTest, t.TempDir(), nil, codec.NewProtoCodec(registry), hd.EthSecp256k1Option())
+	require.NoError(t, err)
+	return kr
+}
+
+func runKeystoreCmd(t *testing.T, cmd *cobra.Command, kr keyring.Keyring, stdin string, args ...string) error {
+	clientCtx := client.Context{}.WithKeyring(kr)
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetOut(os.Stderr)
+	cmd.SetArgs(args)
+	cmd.SilenceErrors, cmd.SilenceUsage = true, true
+	return cmd.Execute()
+}
+
+func TestImportExportEthKeystore(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	address := common.BytesToAddress(privKey.PubKey().Address())
+
+	keyJSON, err := ethsecp256k1.EncryptKeystore(privKey, "import-pass", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	require.NoError(t, os.WriteFile(keyFile, keyJSON, 0o600))
+
+	kr := newTestKeyring(t)
+
+	// a wrong passphrase doesn't import the key
+	err = runKeystoreCmd(t, ImportEthKeystoreCommand(), kr, "wrong-pass\n", "mykey", keyFile)
+	require.ErrorIs(t, err, keystore.ErrDecrypt)
+	_, err = kr.Key("mykey")
+	require.Error(t, err)
+
+	require.NoError(t, runKeystoreCmd(t, ImportEthKeystoreCommand(), kr, "import-pass\n", "mykey", keyFile))
+	record, err := kr.Key("mykey")
+	require.NoError(t, err)
+	imported, err := record.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(address.Bytes()), imported)
+
+	// mismatched passphrases don't export the key
+	outFile := filepath.Join(t.TempDir(), "exported.json")
+	err = runKeystoreCmd(t, ExportEthKeystoreCommand(), kr, "export-pass\nother-pass\n",
+		"mykey", "--light-kdf", "--output", outFile)
+	require.Error(t, err)
+	_, err = os.Stat(outFile)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, runKeystoreCmd(t, ExportEthKeystoreCommand(), kr, "export-pass\nexport-pass\n",
+		"mykey", "--light-kdf", "--output", outFile))
+	exportedJSON, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+
+	exported, err := ethsecp256k1.DecryptKeystore(exportedJSON, "export-pass")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(exported))
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/ethereum/rpc/checkpoints"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+const (
+	flagCheckpointsNode   = "node"
+	flagCheckpointsFrom   = "from"
+	flagCheckpointsTo     = "to"
+	flagCheckpointsOutput = "output"
+)
+
+// CheckpointsCmd returns the command to export the compact block checkpoints
+// used by external light indexers.
+func CheckpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoints",
+		Short: "Block checkpoints subcommands for external indexers",
+	}
+
+	cmd.AddCommand(checkpointsExportCmd())
+	return cmd
+}
+
+func checkpointsExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the block hash, receipt root and bloom of a block range to a binary file",
+		Long: fmt.Sprintf(`Export the checkpoints of the blocks in [from, to] with the artela_exportCheckpoints
+JSON-RPC method, large ranges are fetched in chunks of %d blocks. The output file
+has the binary format of a single artela_exportCheckpoints result.
+
+Example:
+$ %s checkpoints export --from 1 --to 100000 --output checkpoints.bin --node http://127.0.0.1:8545
+`, checkpoints.MaxCheckpointsRange, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, _ := cmd.Flags().GetString(flagCheckpointsNode)
+			from, _ := cmd.Flags().GetUint64(flagCheckpointsFrom)
+			to, _ := cmd.Flags().GetUint64(flagCheckpointsTo)
+			output, _ := cmd.Flags().GetString(flagCheckpointsOutput)
+			if from == 0 || from > to {
+				return fmt.Errorf("invalid block range [%d, %d]", from, to)
+			}
+
+			client, err := rpc.DialContext(cmd.Context(), node)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			var all []rpctypes.Checkpoint
+			for start := from; start <= to; start += checkpoints.MaxCheckpointsRange {
+				end := start + checkpoints.MaxCheckpointsRange - 1
+				if end > to {
+					end = to
+				}
+
+				var result hexutil.Bytes
+				if err := client.CallContext(cmd.Context(), &result, "artela_exportCheckpoints", hexutil.Uint64(start), hexutil.Uint64(end)); err != nil {
+					return err
+				}
+				chunk, err := rpctypes.DecodeCheckpoints(result)
+				if err != nil {
+					return err
+				}
+				all = append(all, chunk...)
+			}
+
+			if err := os.WriteFile(output, rpctypes.EncodeCheckpoints(all), 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("exported %d checkpoints to %s\n", len(all), output)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCheckpointsNode, "http://"+config.DefaultJSONRPCAddress, "the JSON-RPC endpoint of the node")
+	cmd.Flags().Uint64(flagCheckpointsFrom, 1, "the first block of the range")
+	cmd.Flags().Uint64(flagCheckpointsTo, 0, "the last block of the range")
+	cmd.Flags().String(flagCheckpointsOutput, "checkpoints.bin", "the output file")
+	_ = cmd.MarkFlagRequired(flagCheckpointsTo)
+	return cmd
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+const (
+	flagFaucetNode    = "node"
+	flagFaucetCaptcha = "captcha"
+)
+
+// FaucetCmd returns the command to request funds from the development faucet
+// of a test network.
+func FaucetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "faucet",
+		Short: "Development faucet subcommands for test networks",
+	}
+
+	cmd.AddCommand(
+		faucetRequestCmd(),
+		faucetAddressCmd(),
+	)
+	return cmd
+}
+
+func faucetRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request [address]",
+		Short: "Request funds from the faucet served by a node's JSON-RPC",
+		Long: fmt.Sprintf(`Request funds for a hex or bech32 address from the artela_requestFunds
+JSON-RPC method, the node must be started with faucet.enable = true.
+
+Example:
+$ %s faucet request 0x1A2b3C4d5E6f7A8b9C0d1E2f3A4b5C6d7E8f9A0b --node http://127.0.0.1:8545
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			node, _ := cmd.Flags().GetString(flagFaucetNode)
+			captcha, _ := cmd.Flags().GetString(flagFaucetCaptcha)
+
+			client, err := rpc.DialContext(cmd.Context(), node)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			var token *string
+			if captcha != "" {
+				token = &captcha
+			}
+
+			var result json.RawMessage
+			if err := client.CallContext(cmd.Context(), &result, "artela_requestFunds", args[0], token); err != nil {
+				return err
+			}
+
+			cmd.Println(string(result))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFaucetNode, "http://"+config.DefaultJSONRPCAddress, "the JSON-RPC endpoint of the node serving the faucet")
+	cmd.Flags().String(flagFaucetCaptcha, "", "the captcha token, required if the node has a captcha webhook configured")
+	return cmd
+}
+
+func faucetAddressCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "address",
+		Short: "Print the address of the faucet module account",
+		Long: fmt.Sprintf(`Print the bech32 address of the faucet module account, the faucet sends the
+funds of this account, fund it at genesis.
+
+Example:
+$ %s add-genesis-account $(%s faucet address) 1000000000000000000000000uart
+`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), authtypes.NewModuleAddress(evmtypes.FaucetAccountName).String())
+			return err
+		},
+	}
+}
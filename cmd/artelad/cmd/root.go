@@ -134,6 +134,9 @@ func initRootCmd(
 		config.Cmd(),
 		// this line is used by starport scaffolding # root/commands
 		KeyInfoCmd(),
+		FaucetCmd(),
+		CheckpointsCmd(),
+		SafeCmd(),
 	)
 
 	a := appCreator{
@@ -302,7 +305,7 @@ func (a appCreator) newApp(
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server2.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server2.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server2.FlagHaltTime))),
-		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server2.FlagMinRetainBlocks))),
+		baseapp.SetMinRetainBlocks(app.HistoryRetainBlocks(appOpts, cast.ToUint64(appOpts.Get(server2.FlagMinRetainBlocks)))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server2.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server2.FlagIndexEvents))),
@@ -362,6 +365,7 @@ func initAppConfig() (string, interface{}) {
 		JSONRPC config2.JSONRPCConfig `mapstructure:"json-rpc"`
 		TLS     config2.TLSConfig     `mapstructure:"tls"`
 		Aspect  config2.AspectConfig  `mapstructure:"aspect"`
+		Faucet  config2.FaucetConfig  `mapstructure:"faucet"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -387,6 +391,7 @@ func initAppConfig() (string, interface{}) {
 		JSONRPC: *config2.DefaultJSONRPCConfig(),
 		TLS:     *config2.DefaultTLSConfig(),
 		Aspect:  *config2.DefaultAspectConfig(),
+		Faucet:  *config2.DefaultFaucetConfig(),
 	}
 	customAppTemplate := serverconfig.DefaultConfigTemplate + config2.DefaultConfigTemplate
 
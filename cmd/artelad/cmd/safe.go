@@ -0,0 +1,327 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/ethereum/crypto/ethsecp256k1"
+	artela "github.com/artela-network/artela/ethereum/types"
+)
+
+const (
+	flagSafeNonce     = "safe-nonce"
+	flagSafeOperation = "operation"
+)
+
+// safeExecTransactionABI is the execTransaction method of the Safe (v1.3.0) multisig contract
+const safeExecTransactionABI = `[{"name":"execTransaction","type":"function","stateMutability":"payable","inputs":[
+{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},
+{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},
+{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},
+{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]}]`
+
+var (
+	safeDomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash     = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+// safeTx is a transaction of a Safe multisig contract, executed without gas refund.
+type safeTx struct {
+	Safe      common.Address
+	To        common.Address
+	Value     *big.Int
+	Data      []byte
+	Operation uint8
+	Nonce     *big.Int
+}
+
+// SafeCmd returns the commands operating a Safe multisig contract owned by the members of
+// a Cosmos multisig key, since a Cosmos multisig can't sign the ethereum txs directly.
+func SafeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "safe",
+		Short: "Operate EVM assets with a Safe multisig contract owned by the members of a multisig key",
+		Long: `A Cosmos multisig account can't sign ethereum txs, a treasury operates its EVM assets with a
+Safe multisig contract instead, deployed with the members of the multisig key as owners and the
+same threshold. The members sign the Safe transactions with their keys, then anyone submits the
+execTransaction call with the collected signatures.`,
+	}
+
+	cmd.AddCommand(
+		safeOwnersCmd(),
+		safeSignCmd(),
+		safeExecDataCmd(),
+	)
+	return cmd
+}
+
+func safeOwnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owners [multisig-key-name]",
+		Short: "Print the Safe owners and threshold matching a multisig key of the keyring",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigKey, ok := pubKey.(*multisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("key %s is not a multisig key", args[0])
+			}
+
+			owners := make([]common.Address, 0, len(multisigKey.GetPubKeys()))
+			for _, member := range multisigKey.GetPubKeys() {
+				if _, ok := member.(*ethsecp256k1.PubKey); !ok {
+					return fmt.Errorf("member key %s is not an %s key", member.Address(), ethsecp256k1.KeyType)
+				}
+				owners = append(owners, common.BytesToAddress(member.Address()))
+			}
+
+			out, err := json.MarshalIndent(struct {
+				Owners    []common.Address `json:"owners"`
+				Threshold uint32           `json:"threshold"`
+			}{owners, multisigKey.Threshold}, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	flags.AddKeyringFlags(cmd.Flags())
+	return cmd
+}
+
+func safeSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [safe-address] [to] [value] [data]",
+		Short: "Sign a Safe transaction with a member key of the keyring",
+		Long: fmt.Sprintf(`Sign the EIP-712 hash of a Safe transaction with the key of --from, the value is in the
+smallest unit and the data is the hex calldata ("0x" for a transfer). The chain id is the one of --chain-id.
+
+Example:
+$ %s safe sign 0xSafe... 0xTo... 1000000000000000000 0x --safe-nonce 0 --from member1 --chain-id artela_11822-1
+`, version.AppName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := parseSafeTx(cmd, args)
+			if err != nil {
+				return err
+			}
+			chainID, err := artela.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			hash, err := tx.hash(chainID)
+			if err != nil {
+				return err
+			}
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.FromName, hash.Bytes())
+			if err != nil {
+				return err
+			}
+			if len(sig) != crypto.SignatureLength {
+				return fmt.Errorf("key %s is not an %s key", clientCtx.FromName, ethsecp256k1.KeyType)
+			}
+			// the Safe expects the recovery id of the ECDSA signatures in 27 or 28
+			sig[crypto.RecoveryIDOffset] += 27
+
+			out, err := json.MarshalIndent(struct {
+				SafeTxHash common.Hash    `json:"safeTxHash"`
+				Signer     common.Address `json:"signer"`
+				Signature  hexutil.Bytes  `json:"signature"`
+			}{hash, common.BytesToAddress(clientCtx.FromAddress), sig}, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	addSafeTxFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func safeExecDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-data [safe-address] [to] [value] [data] [signature]...",
+		Short: "Print the execTransaction calldata of a Safe transaction with the collected signatures",
+		Long: `Print the calldata of the execTransaction call of the Safe, the signatures are ordered by
+their signers as the Safe requires. Submit it to the Safe address from any account, e.g. with
+eth_sendTransaction.`,
+		Args: cobra.MinimumNArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := parseSafeTx(cmd, args[:4])
+			if err != nil {
+				return err
+			}
+			chainID, err := artela.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+			hash, err := tx.hash(chainID)
+			if err != nil {
+				return err
+			}
+
+			signatures, err := sortSafeSignatures(hash, args[4:])
+			if err != nil {
+				return err
+			}
+
+			execABI, err := abi.JSON(strings.NewReader(safeExecTransactionABI))
+			if err != nil {
+				return err
+			}
+			zero := big.NewInt(0)
+			data, err := execABI.Pack("execTransaction", tx.To, tx.Value, tx.Data, tx.Operation,
+				zero, zero, zero, common.Address{}, common.Address{}, signatures)
+			if err != nil {
+				return err
+			}
+			cmd.Println(hexutil.Encode(data))
+			return nil
+		},
+	}
+
+	addSafeTxFlags(cmd)
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	return cmd
+}
+
+func addSafeTxFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagSafeNonce, 0, "the nonce of the Safe transaction")
+	cmd.Flags().Uint8(flagSafeOperation, 0, "the operation of the Safe transaction, 0 for call and 1 for delegatecall")
+	_ = cmd.MarkFlagRequired(flagSafeNonce)
+}
+
+func parseSafeTx(cmd *cobra.Command, args []string) (*safeTx, error) {
+	if !common.IsHexAddress(args[0]) || !common.IsHexAddress(args[1]) {
+		return nil, errors.New("the safe and to addresses must be hex addresses")
+	}
+	value, ok := new(big.Int).SetString(args[2], 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %s", args[2])
+	}
+	data, err := hexutil.Decode(args[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid data: %w", err)
+	}
+
+	nonce, _ := cmd.Flags().GetUint64(flagSafeNonce)
+	operation, _ := cmd.Flags().GetUint8(flagSafeOperation)
+	if operation > 1 {
+		return nil, fmt.Errorf("invalid operation %d", operation)
+	}
+
+	return &safeTx{
+		Safe:      common.HexToAddress(args[0]),
+		To:        common.HexToAddress(args[1]),
+		Value:     value,
+		Data:      data,
+		Operation: operation,
+		Nonce:     new(big.Int).SetUint64(nonce),
+	}, nil
+}
+
+// hash returns the EIP-712 hash of the Safe transaction signed by the owners.
+func (tx *safeTx) hash(chainID *big.Int) (common.Hash, error) {
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	uint256, _ := abi.NewType("uint256", "", nil)
+	uint8Type, _ := abi.NewType("uint8", "", nil)
+
+	domain, err := abi.Arguments{{Type: bytes32}, {Type: uint256}, {Type: address}}.
+		Pack(safeDomainTypeHash, chainID, tx.Safe)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	zero := big.NewInt(0)
+	message, err := abi.Arguments{
+		{Type: bytes32}, {Type: address}, {Type: uint256}, {Type: bytes32}, {Type: uint8Type},
+		{Type: uint256}, {Type: uint256}, {Type: uint256}, {Type: address}, {Type: address}, {Type: uint256},
+	}.Pack(safeTxTypeHash, tx.To, tx.Value, crypto.Keccak256Hash(tx.Data), tx.Operation,
+		zero, zero, zero, common.Address{}, common.Address{}, tx.Nonce)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, crypto.Keccak256(domain), crypto.Keccak256(message)), nil
+}
+
+// sortSafeSignatures concatenates the signatures of the hash ordered by their signers.
+func sortSafeSignatures(hash common.Hash, hexSigs []string) ([]byte, error) {
+	type signed struct {
+		signer common.Address
+		sig    []byte
+	}
+
+	sigs := make([]signed, 0, len(hexSigs))
+	for _, hexSig := range hexSigs {
+		sig, err := hexutil.Decode(hexSig)
+		if err != nil || len(sig) != crypto.SignatureLength || sig[crypto.RecoveryIDOffset] < 27 {
+			return nil, fmt.Errorf("invalid signature %s", hexSig)
+		}
+
+		recoverable := common.CopyBytes(sig)
+		recoverable[crypto.RecoveryIDOffset] -= 27
+		pubKey, err := crypto.SigToPub(hash.Bytes(), recoverable)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %s: %w", hexSig, err)
+		}
+		sigs = append(sigs, signed{crypto.PubkeyToAddress(*pubKey), sig})
+	}
+
+	sort.Slice(sigs, func(i, j int) bool {
+		return bytes.Compare(sigs[i].signer.Bytes(), sigs[j].signer.Bytes()) < 0
+	})
+
+	concatenated := make([]byte, 0, len(sigs)*crypto.SignatureLength)
+	for i, s := range sigs {
+		if i > 0 && s.signer == sigs[i-1].signer {
+			return nil, fmt.Errorf("duplicate signature of %s", s.signer.Hex())
+		}
+		concatenated = append(concatenated, s.sig...)
+	}
+	return concatenated, nil
+}
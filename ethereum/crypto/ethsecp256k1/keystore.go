@@ -0,0 +1,36 @@
+package ethsecp256k1
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+// EncryptKeystore encrypts the private key into the keystore v3 json of geth with the
+// passphrase, scryptN and scryptP are the scrypt parameters of the key derivation, e.g.
+// keystore.StandardScryptN and keystore.StandardScryptP.
+func EncryptKeystore(privKey *PrivKey, passphrase string, scryptN, scryptP int) ([]byte, error) {
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, passphrase, scryptN, scryptP)
+}
+
+// DecryptKeystore decrypts the private key of a keystore v3 json of geth with the passphrase.
+func DecryptKeystore(keyJSON []byte, passphrase string) (*PrivKey, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivKey{Key: crypto.FromECDSA(key.PrivateKey)}, nil
+}
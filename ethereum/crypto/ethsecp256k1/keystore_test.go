@@ -0,0 +1,49 @@
+package ethsecp256k1
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreRoundTrip(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	keyJSON, err := EncryptKeystore(privKey, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	decrypted, err := DecryptKeystore(keyJSON, "passphrase")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decrypted))
+
+	_, err = DecryptKeystore(keyJSON, "wrong passphrase")
+	require.ErrorIs(t, err, keystore.ErrDecrypt)
+}
+
+func TestKeystoreGethCompatibility(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	ecdsaKey, err := privKey.ToECDSA()
+	require.NoError(t, err)
+
+	// a keystore exported by geth is imported
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Address:    crypto.PubkeyToAddress(ecdsaKey.PublicKey),
+		PrivateKey: ecdsaKey,
+	}, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	decrypted, err := DecryptKeystore(keyJSON, "passphrase")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decrypted))
+
+	// an exported keystore is imported by geth
+	keyJSON, err = EncryptKeystore(privKey, "passphrase", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+	key, err := keystore.DecryptKey(keyJSON, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(ecdsaKey.PublicKey), key.Address)
+	require.Equal(t, crypto.FromECDSA(ecdsaKey), crypto.FromECDSA(key.PrivateKey))
+}
@@ -0,0 +1,269 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// aspectFrameType is the type of the call frames of the aspect executions in the
+// callTracer output, they're not calls of the txs.
+const aspectFrameType = "ASPECT"
+
+// CallTracer returns the addresses touched by the successful internal calls of the
+// eth txs of a block, in the order of the txs, msgs are the eth txs executed in the block.
+type CallTracer func(block *tmtypes.Block, msgs []*txs.MsgEthereumTx) ([][]common.Address, error)
+
+// callFrame is the part of a frame of the callTracer output indexed in the address index.
+type callFrame struct {
+	Type  string          `json:"type"`
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to,omitempty"`
+	Error string          `json:"error,omitempty"`
+	Calls []callFrame     `json:"calls,omitempty"`
+}
+
+// NewQueryCallTracer creates a CallTracer replaying the blocks with the callTracer through
+// the EVM query service of the node, the client context must have a node client.
+func NewQueryCallTracer(clientCtx client.Context) CallTracer {
+	queryClient := txs.NewQueryClient(clientCtx)
+	return func(block *tmtypes.Block, msgs []*txs.MsgEthereumTx) ([][]common.Address, error) {
+		req := &txs.QueryTraceBlockRequest{
+			Txs:             msgs,
+			TraceConfig:     &support.TraceConfig{Tracer: "callTracer"},
+			BlockNumber:     block.Height,
+			BlockTime:       block.Time,
+			BlockHash:       common.Bytes2Hex(block.Hash()),
+			ProposerAddress: sdk.ConsAddress(block.ProposerAddress),
+		}
+
+		// minus one to get the context of block beginning
+		contextHeight := block.Height - 1
+		if contextHeight < 1 {
+			// 0 is a special value in `ContextWithHeight`
+			contextHeight = 1
+		}
+		res, err := queryClient.TraceBlock(rpctypes.ContextWithHeight(contextHeight), req)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []struct {
+			Result *callFrame `json:"result"`
+			Error  string     `json:"error"`
+		}
+		if err := json.Unmarshal(res.Data, &results); err != nil {
+			return nil, err
+		}
+		if len(results) != len(msgs) {
+			return nil, fmt.Errorf("unexpected number of tx traces, expected %d, got %d", len(msgs), len(results))
+		}
+
+		touched := make([][]common.Address, len(results))
+		for i, result := range results {
+			if result.Result == nil {
+				continue
+			}
+			// the top call is the tx itself, which is indexed from the tx
+			for _, call := range result.Result.Calls {
+				touched[i] = collectCallAddresses(call, touched[i])
+			}
+		}
+		return touched, nil
+	}
+}
+
+// collectCallAddresses appends the callers and the callees of the successful frames
+// of the call tree of frame to addresses.
+func collectCallAddresses(frame callFrame, addresses []common.Address) []common.Address {
+	if frame.Type == aspectFrameType || frame.Error != "" {
+		return addresses
+	}
+	addresses = append(addresses, frame.From)
+	if frame.To != nil {
+		addresses = append(addresses, *frame.To)
+	}
+	for _, call := range frame.Calls {
+		addresses = collectCallAddresses(call, addresses)
+	}
+	return addresses
+}
+
+// SetAddressIndex enables or disables the address to txs index of the senders and the
+// recipients of the eth txs, tracer indexes the addresses of the internal calls too if
+// it's not nil. It must be set before indexing blocks.
+func (kv *KVIndexer) SetAddressIndex(enable bool, tracer CallTracer) {
+	kv.addressIndex = enable
+	kv.callTracer = tracer
+}
+
+// AddressIndexStart returns the first block covered by the address index, returns -1
+// if the address index is empty.
+func (kv *KVIndexer) AddressIndexStart() (int64, error) {
+	bz, err := kv.db.Get([]byte{KeyPrefixAddressIndexStart})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "AddressIndexStart")
+	}
+	if len(bz) != 8 {
+		return -1, nil
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil // #nosec G701
+}
+
+// TxsByAddress returns at most limit txs of the address before the (height, eth tx index)
+// position in the descending order of the position.
+func (kv *KVIndexer) TxsByAddress(address common.Address, beforeHeight int64, beforeIndex int32, limit int) ([]artela.AddressTx, error) {
+	it, err := kv.db.ReverseIterator(AddressTxKey(address, 0, 0), AddressTxKey(address, beforeHeight, beforeIndex))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "TxsByAddress")
+	}
+	defer it.Close()
+
+	var addressTxs []artela.AddressTx
+	for ; it.Valid() && len(addressTxs) < limit; it.Next() {
+		height, ethTxIndex := parseAddressTxKey(it.Key())
+		addressTxs = append(addressTxs, artela.AddressTx{
+			Height:     height,
+			EthTxIndex: ethTxIndex,
+			Hash:       common.BytesToHash(it.Value()),
+		})
+	}
+	return addressTxs, nil
+}
+
+// collectAddressTxs adds the address tx keys of the sender, the recipient or the created
+// contract of the eth tx to keys, the value is the tx hash.
+func collectAddressTxs(msg *txs.MsgEthereumTx, height int64, ethTxIndex int32, keys map[string][]byte) error {
+	tx := msg.AsTransaction()
+	hash := common.HexToHash(msg.Hash).Bytes()
+
+	from, err := msg.GetSender(tx.ChainId())
+	if err != nil {
+		return err
+	}
+	keys[string(AddressTxKey(from, height, ethTxIndex))] = hash
+
+	to := tx.To()
+	if to == nil {
+		created := crypto.CreateAddress(from, tx.Nonce())
+		to = &created
+	}
+	keys[string(AddressTxKey(*to, height, ethTxIndex))] = hash
+	return nil
+}
+
+// traceAddressTxs adds the address tx keys of the addresses touched by the internal
+// calls of the eth txs to keys, ethTxIndexes are the eth tx indexes of msgs.
+func (kv *KVIndexer) traceAddressTxs(block *tmtypes.Block, msgs []*txs.MsgEthereumTx, ethTxIndexes []int32, keys map[string][]byte) error {
+	touched, err := kv.callTracer(block, msgs)
+	if err != nil {
+		return err
+	}
+	for i, addresses := range touched {
+		hash := common.HexToHash(msgs[i].Hash).Bytes()
+		for _, address := range addresses {
+			keys[string(AddressTxKey(address, block.Height, ethTxIndexes[i]))] = hash
+		}
+	}
+	return nil
+}
+
+// deleteAddressTxsFrom deletes the address tx keys from height onwards in the batch, the
+// height follows the address in the keys, so the whole index is scanned.
+func (kv *KVIndexer) deleteAddressTxsFrom(batch dbm.Batch, height int64) error {
+	it, err := kv.db.Iterator([]byte{KeyPrefixAddressTx}, []byte{KeyPrefixAddressTx + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if h, _ := parseAddressTxKey(it.Key()); h < height {
+			continue
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	start, err := kv.AddressIndexStart()
+	if err != nil {
+		return err
+	}
+	if start >= height {
+		return batch.Delete([]byte{KeyPrefixAddressIndexStart})
+	}
+	return nil
+}
+
+// pruneAddressTxsBefore deletes the address tx keys below height in the batch, and moves
+// the start of the address index up to height.
+func (kv *KVIndexer) pruneAddressTxsBefore(batch dbm.Batch, height int64) error {
+	it, err := kv.db.Iterator([]byte{KeyPrefixAddressTx}, []byte{KeyPrefixAddressTx + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if h, _ := parseAddressTxKey(it.Key()); h >= height {
+			continue
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+
+	start, err := kv.AddressIndexStart()
+	if err != nil {
+		return err
+	}
+	if start != -1 && start < height {
+		return batch.Set([]byte{KeyPrefixAddressIndexStart}, sdk.Uint64ToBigEndian(uint64(height))) // #nosec G701
+	}
+	return nil
+}
+
+// markAddressIndex records the start of the address index at the first block indexed
+// with it enabled, and drops the start if it's disabled, like markLogIndex.
+func (kv *KVIndexer) markAddressIndex(batch dbm.Batch, height int64) error {
+	start, err := kv.AddressIndexStart()
+	if err != nil {
+		return err
+	}
+	switch {
+	case kv.addressIndex && start == -1:
+		return batch.Set([]byte{KeyPrefixAddressIndexStart}, sdk.Uint64ToBigEndian(uint64(height))) // #nosec G701
+	case !kv.addressIndex && start != -1:
+		return batch.Delete([]byte{KeyPrefixAddressIndexStart})
+	}
+	return nil
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, eth tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, ethTxIndex int32) []byte {
+	key := make([]byte, 0, AddressTxKeyLength)
+	key = append(key, KeyPrefixAddressTx)
+	key = append(key, address.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) // #nosec G701
+	return append(key, sdk.Uint64ToBigEndian(uint64(ethTxIndex))...) // #nosec G701
+}
+
+func parseAddressTxKey(key []byte) (int64, int32) {
+	height := binary.BigEndian.Uint64(key[AddressTxKeyLength-16:])
+	ethTxIndex := binary.BigEndian.Uint64(key[AddressTxKeyLength-8:])
+	return int64(height), int32(ethTxIndex) // #nosec G701
+}
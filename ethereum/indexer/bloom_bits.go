@@ -0,0 +1,229 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// BloomBitsSectionSize is the number of the blocks of a bloom bits section, a section
+// covers the heights [section*size, (section+1)*size).
+const BloomBitsSectionSize = params.BloomBitsBlocks
+
+// bloomBitsGroup is a filter clause, a bloom matches it if it has the 3 bits of any of
+// its alternatives set.
+type bloomBitsGroup [][3]uint
+
+// BloomSections returns the number of the sections indexed in the bloom bits.
+func (kv *KVIndexer) BloomSections() (uint64, error) {
+	bz, err := kv.db.Get([]byte{KeyPrefixBloomSections})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "BloomSections")
+	}
+	if len(bz) != 8 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// IndexBloomSection stores the bit-transposed blooms of the blocks of the next section,
+// blooms are the blooms of the blocks of the section in order.
+func (kv *KVIndexer) IndexBloomSection(section uint64, blooms []ethtypes.Bloom) error {
+	sections, err := kv.BloomSections()
+	if err != nil {
+		return err
+	}
+	if section != sections {
+		return fmt.Errorf("IndexBloomSection %d, the next section is %d", section, sections)
+	}
+	if len(blooms) != int(BloomBitsSectionSize) {
+		return fmt.Errorf("IndexBloomSection %d, %d blooms of %d blocks", section, len(blooms), BloomBitsSectionSize)
+	}
+
+	gen, err := bloombits.NewGenerator(uint(BloomBitsSectionSize))
+	if err != nil {
+		return err
+	}
+	for i, bloom := range blooms {
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d", section)
+		}
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d", section)
+		}
+		// the vector of a bit unset in all the blooms compresses to nothing
+		compressed := append([]byte{}, bitutil.CompressBytes(bits)...)
+		if err := batch.Set(BloomBitsKey(bit, section), compressed); err != nil {
+			return errorsmod.Wrapf(err, "IndexBloomSection %d, set bloom bits", section)
+		}
+	}
+	if err := batch.Set([]byte{KeyPrefixBloomSections}, sdk.Uint64ToBigEndian(section+1)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBloomSection %d, set sections", section)
+	}
+	return batch.Write()
+}
+
+// BlocksByBloomBits returns the ascending numbers of the blocks in [from, to] whose blooms
+// match the addresses and the topics, and the last block covered by the bloom bits, the
+// blocks after it are not looked up. It returns false if from is not covered.
+func (kv *KVIndexer) BlocksByBloomBits(addresses []common.Address, topics [][]common.Hash, from, to int64) ([]int64, int64, bool, error) {
+	sections, err := kv.BloomSections()
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	indexedTo := int64(sections*BloomBitsSectionSize) - 1 // #nosec G701
+	if from < 0 || from > indexedTo {
+		return nil, 0, false, nil
+	}
+	if indexedTo > to {
+		indexedTo = to
+	}
+
+	groups := bloomBitsGroups(addresses, topics)
+	size := int64(BloomBitsSectionSize) // #nosec G701
+
+	var heights []int64
+	for section := from / size; section <= indexedTo/size; section++ {
+		matches, err := kv.matchBloomSection(uint64(section), groups) // #nosec G701
+		if err != nil {
+			return nil, 0, false, err
+		}
+
+		for i := int64(0); i < size; i++ {
+			height := section*size + i
+			if height < from || height > indexedTo {
+				continue
+			}
+			if matches[i/8]&(1<<(7-i%8)) != 0 {
+				heights = append(heights, height)
+			}
+		}
+	}
+	return heights, indexedTo, true, nil
+}
+
+// matchBloomSection returns the bit vector of the blocks of the section whose blooms
+// match all the groups.
+func (kv *KVIndexer) matchBloomSection(section uint64, groups []bloomBitsGroup) ([]byte, error) {
+	matches := make([]byte, BloomBitsSectionSize/8)
+	for i := range matches {
+		matches[i] = 0xff
+	}
+
+	for _, group := range groups {
+		groupMatches := make([]byte, len(matches))
+		for _, bits := range group {
+			alternative := make([]byte, len(matches))
+			for i := range alternative {
+				alternative[i] = 0xff
+			}
+			for _, bit := range bits {
+				vector, err := kv.bloomBits(bit, section)
+				if err != nil {
+					return nil, err
+				}
+				bitutil.ANDBytes(alternative, alternative, vector)
+			}
+			bitutil.ORBytes(groupMatches, groupMatches, alternative)
+		}
+		bitutil.ANDBytes(matches, matches, groupMatches)
+	}
+	return matches, nil
+}
+
+// bloomBits returns the bit vector of a bloom bit over the blocks of the section.
+func (kv *KVIndexer) bloomBits(bit uint, section uint64) ([]byte, error) {
+	bz, err := kv.db.Get(BloomBitsKey(bit, section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "bloomBits %d of section %d", bit, section)
+	}
+	return bitutil.DecompressBytes(bz, int(BloomBitsSectionSize/8))
+}
+
+// deleteBloomSectionsFrom drops the sections covering the blocks from height onwards.
+func (kv *KVIndexer) deleteBloomSectionsFrom(height int64) error {
+	sections, err := kv.BloomSections()
+	if err != nil {
+		return err
+	}
+	keep := uint64(height) / BloomBitsSectionSize // #nosec G701
+	if height < 0 || keep >= sections {
+		return nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for section := keep; section < sections; section++ {
+		for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+			if err := batch.Delete(BloomBitsKey(bit, section)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := batch.Set([]byte{KeyPrefixBloomSections}, sdk.Uint64ToBigEndian(keep)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// bloomBitsGroups converts the filter criteria to the bloom bits groups, an empty clause
+// matches any bloom and has no group.
+func bloomBitsGroups(addresses []common.Address, topics [][]common.Hash) []bloomBitsGroup {
+	var groups []bloomBitsGroup
+	if len(addresses) > 0 {
+		group := make(bloomBitsGroup, len(addresses))
+		for i, address := range addresses {
+			group[i] = bloomBitIndexes(address.Bytes())
+		}
+		groups = append(groups, group)
+	}
+	for _, clause := range topics {
+		if len(clause) == 0 {
+			continue
+		}
+		group := make(bloomBitsGroup, len(clause))
+		for i, topic := range clause {
+			group[i] = bloomBitIndexes(topic.Bytes())
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// bloomBitIndexes returns the indexes of the 3 bloom bits set by the data, in the order of
+// the bloom bits generator.
+func bloomBitIndexes(data []byte) [3]uint {
+	hash := crypto.Keccak256(data)
+
+	var idxs [3]uint
+	for i := range idxs {
+		idxs[i] = (uint(hash[2*i])<<8)&2047 + uint(hash[2*i+1])
+	}
+	return idxs
+}
+
+// BloomBitsKey returns the key of the bit vector of a bloom bit over a section
+func BloomBitsKey(bit uint, section uint64) []byte {
+	key := make([]byte, 1+2+8)
+	key[0] = KeyPrefixBloomBits
+	binary.BigEndian.PutUint16(key[1:], uint16(bit)) // #nosec G701
+	binary.BigEndian.PutUint64(key[3:], section)
+	return key
+}
@@ -0,0 +1,61 @@
+package indexer
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomBits(t *testing.T) {
+	var (
+		token    = common.HexToAddress("0x01")
+		other    = common.HexToAddress("0x02")
+		transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	)
+
+	kv := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+
+	_, _, ok, err := kv.BlocksByBloomBits([]common.Address{token}, nil, 1, 10)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	blooms := make([]ethtypes.Bloom, BloomBitsSectionSize)
+	blooms[5].Add(token.Bytes())
+	blooms[5].Add(transfer.Bytes())
+	blooms[100].Add(token.Bytes())
+	blooms[4000].Add(other.Bytes())
+	blooms[4000].Add(transfer.Bytes())
+
+	require.Error(t, kv.IndexBloomSection(1, blooms))
+	require.NoError(t, kv.IndexBloomSection(0, blooms))
+
+	heights, indexedTo, ok, err := kv.BlocksByBloomBits([]common.Address{token}, nil, 1, 10000)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int64(BloomBitsSectionSize-1), indexedTo)
+	require.Equal(t, []int64{5, 100}, heights)
+
+	heights, _, _, err = kv.BlocksByBloomBits([]common.Address{token}, [][]common.Hash{{transfer}}, 1, 10000)
+	require.NoError(t, err)
+	require.Equal(t, []int64{5}, heights)
+
+	heights, _, _, err = kv.BlocksByBloomBits([]common.Address{token, other}, [][]common.Hash{{transfer}}, 6, 10000)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4000}, heights)
+
+	// the blooms don't keep the positions of the topics, a wildcard clause matches any
+	heights, indexedTo, _, err = kv.BlocksByBloomBits(nil, [][]common.Hash{nil, {transfer}}, 1, 50)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), indexedTo)
+	require.Equal(t, []int64{5}, heights)
+
+	require.NoError(t, kv.DeleteBlocksFrom(4000))
+	sections, err := kv.BloomSections()
+	require.NoError(t, err)
+	require.Zero(t, sections)
+}
@@ -0,0 +1,622 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/rpc/utils"
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+const (
+	KeyPrefixTxHash  = 1
+	KeyPrefixTxIndex = 2
+	// KeyPrefixBlock marks a block as indexed, the value is the block hash
+	KeyPrefixBlock = 3
+	// KeyPrefixLogTopic is the (address, topic0) to blocks inverted index of the logs
+	KeyPrefixLogTopic = 4
+	// KeyPrefixLogIndexStart records the first block covered by the log index
+	KeyPrefixLogIndexStart = 5
+	// KeyPrefixBloomBits is the bit-transposed blooms of the blocks keyed by bit and section
+	KeyPrefixBloomBits = 6
+	// KeyPrefixBloomSections records the number of the sections indexed in the bloom bits
+	KeyPrefixBloomSections = 7
+	// KeyPrefixAddressTx is the address to txs index of the senders, the recipients and the internal calls
+	KeyPrefixAddressTx = 8
+	// KeyPrefixAddressIndexStart records the first block covered by the address index
+	KeyPrefixAddressIndexStart = 9
+
+	// TxIndexKeyLength is the length of tx-index key
+	TxIndexKeyLength = 1 + 8 + 8
+	// BlockKeyLength is the length of block key
+	BlockKeyLength = 1 + 8
+	// LogTopicKeyLength is the length of log topic key
+	LogTopicKeyLength = 1 + common.AddressLength + common.HashLength + 8
+	// AddressTxKeyLength is the length of address tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
+)
+
+var (
+	_ artela.EVMTxIndexer      = &KVIndexer{}
+	_ artela.EVMLogIndexer     = &KVIndexer{}
+	_ artela.EVMBloomIndexer   = &KVIndexer{}
+	_ artela.EVMAddressIndexer = &KVIndexer{}
+)
+
+// KVIndexer implements a eth tx indexer on a KV db.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+
+	// logIndex enables the (address, topic0) to blocks inverted index
+	logIndex bool
+	// addressIndex enables the address to txs index
+	addressIndex bool
+	// callTracer indexes the addresses of the internal calls, if it's not nil
+	callTracer CallTracer
+}
+
+// NewKVIndexer creates the KVIndexer
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// SetLogIndex enables or disables the (address, topic0) to blocks inverted index
+// of the logs, it must be set before indexing blocks.
+func (kv *KVIndexer) SetLogIndex(enable bool) {
+	kv.logIndex = enable
+}
+
+// IndexBlock index all the eth txs in a block through the following steps:
+// - Parses the system txs applied by the begin blockers from the begin block events
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Parses the system txs applied by the end blockers from the end block events
+// - Indexes the (address, topic0) pairs of the logs if the log index is enabled
+// - Indexes the senders, the recipients and the internal calls of the eth txs if the address index is enabled
+// - Marks the block as indexed with its hash, in the same batch
+//
+// The eth tx indexes follow the order the txs are applied in, the system txs of the begin
+// blockers first, then the eth txs of the block, then the system txs of the end blockers.
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, blockRes *tmrpctypes.ResultBlockResults) error {
+	height := block.Header.Height
+	txResults := blockRes.TxsResults
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.markLogIndex(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, mark log index", height)
+	}
+	logTopics := make(map[string]struct{})
+
+	if err := kv.markAddressIndex(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, mark address index", height)
+	}
+	addressTxs := make(map[string][]byte)
+	// the executed eth txs and their eth tx indexes, traced for the internal calls
+	var (
+		executedMsgs    []*txs.MsgEthereumTx
+		executedIndexes []int32
+	)
+
+	beginTxs, endTxs, err := rpctypes.BlockSystemTxs(blockRes)
+	if err != nil {
+		kv.logger.Error("Fail to parse system txs", "err", err, "block", height)
+		beginTxs, endTxs = nil, nil
+	}
+
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	// the system txs are indexed by their index in the system txs of the block, their
+	// cumulative gas used is the one of the block
+	var systemTxIndex uint32
+	indexSystemTxs := func(systemTxs []*rpctypes.SystemTx, cumulativeGasUsed uint64) error {
+		for _, systemTx := range systemTxs {
+			if systemTx.EthTxIndex != ethTxIndex {
+				kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", systemTx.EthTxIndex)
+			}
+			cumulativeGasUsed += systemTx.GasUsed
+
+			txResult := artela.TxResult{
+				Height:            height,
+				MsgIndex:          systemTxIndex,
+				EthTxIndex:        ethTxIndex,
+				GasUsed:           systemTx.GasUsed,
+				Failed:            systemTx.Failed,
+				CumulativeGasUsed: cumulativeGasUsed,
+				SystemTx:          true,
+			}
+
+			if kv.logIndex {
+				collectLogsTopics(systemTx.Logs, height, logTopics)
+			}
+			if kv.addressIndex {
+				msg, err := systemTx.Msg()
+				if err == nil {
+					err = collectAddressTxs(msg, height, ethTxIndex, addressTxs)
+				}
+				if err != nil {
+					kv.logger.Error("Fail to index addresses", "err", err, "block", height, "systemTxIndex", systemTxIndex)
+				}
+			}
+			systemTxIndex++
+			ethTxIndex++
+
+			if err := saveTxResult(kv.clientCtx.Codec, batch, systemTx.Tx.Hash(), &txResult); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := indexSystemTxs(beginTxs, 0); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if kv.logIndex && result.Code == abci.CodeTypeOK {
+			if err := collectLogTopics(result, height, logTopics); err != nil {
+				kv.logger.Error("Fail to parse logs", "err", err, "block", height, "txIndex", txIndex)
+			}
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*txs.MsgEthereumTx)
+			txHash := common.HexToHash(ethMsg.Hash)
+
+			txResult := artela.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  // #nosec G701
+				MsgIndex:   uint32(msgIndex), // #nosec G701
+				EthTxIndex: ethTxIndex,
+			}
+			txResult.SetTxMetadata(tx)
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+
+			if kv.addressIndex {
+				if err := collectAddressTxs(ethMsg, height, ethTxIndex, addressTxs); err != nil {
+					kv.logger.Error("Fail to index addresses", "err", err, "block", height, "txIndex", txIndex)
+				}
+				if result.Code == abci.CodeTypeOK {
+					executedMsgs = append(executedMsgs, ethMsg)
+					executedIndexes = append(executedIndexes, ethTxIndex)
+				}
+			}
+			ethTxIndex++
+
+			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+	}
+
+	blockGasUsed := rpctypes.SystemTxsGasUsed(beginTxs)
+	for _, result := range txResults {
+		blockGasUsed += uint64(result.GasUsed) // #nosec G701
+	}
+	if err := indexSystemTxs(endTxs, blockGasUsed); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	for key := range logTopics {
+		if err := batch.Set([]byte(key), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log topic key", height)
+		}
+	}
+
+	if kv.callTracer != nil && len(executedMsgs) > 0 {
+		// a failed trace only loses the internal calls, the block is indexed anyway
+		if err := kv.traceAddressTxs(block, executedMsgs, executedIndexes, addressTxs); err != nil {
+			kv.logger.Error("Fail to trace internal calls", "err", err, "block", height)
+		}
+	}
+	for key, hash := range addressTxs {
+		if err := batch.Set([]byte(key), hash); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set address tx key", height)
+		}
+	}
+
+	if err := batch.Set(BlockKey(height), block.Hash()); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, mark block", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	return blockHeightBoundary(kv.db, true)
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	return blockHeightBoundary(kv.db, false)
+}
+
+// IndexedBlockHash returns the hash of the indexed block at height, returns nil
+// if the block is not indexed.
+func (kv *KVIndexer) IndexedBlockHash(height int64) ([]byte, error) {
+	return kv.db.Get(BlockKey(height))
+}
+
+// DeleteBlocksFrom deletes all the indexed txs, block marks and address txs from height
+// onwards, and the bloom bits sections covering them.
+func (kv *KVIndexer) DeleteBlocksFrom(height int64) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	txIt, err := kv.db.Iterator(TxIndexKey(height, 0), []byte{KeyPrefixTxIndex + 1})
+	if err != nil {
+		return err
+	}
+	for ; txIt.Valid(); txIt.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(txIt.Value()))); err != nil {
+			txIt.Close()
+			return err
+		}
+		if err := batch.Delete(txIt.Key()); err != nil {
+			txIt.Close()
+			return err
+		}
+	}
+	txIt.Close()
+
+	blockIt, err := kv.db.Iterator(BlockKey(height), []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return err
+	}
+	for ; blockIt.Valid(); blockIt.Next() {
+		if err := batch.Delete(blockIt.Key()); err != nil {
+			blockIt.Close()
+			return err
+		}
+	}
+	blockIt.Close()
+
+	// the height is the suffix of the log topic keys, so the whole index is scanned
+	logIt, err := kv.db.Iterator([]byte{KeyPrefixLogTopic}, []byte{KeyPrefixLogTopic + 1})
+	if err != nil {
+		return err
+	}
+	for ; logIt.Valid(); logIt.Next() {
+		if parseLogTopicHeight(logIt.Key()) < height {
+			continue
+		}
+		if err := batch.Delete(logIt.Key()); err != nil {
+			logIt.Close()
+			return err
+		}
+	}
+	logIt.Close()
+
+	if err := kv.deleteBloomSectionsFrom(height); err != nil {
+		return err
+	}
+
+	if err := kv.deleteAddressTxsFrom(batch, height); err != nil {
+		return err
+	}
+
+	start, err := kv.LogIndexStart()
+	if err != nil {
+		return err
+	}
+	if start >= height {
+		if err := batch.Delete([]byte{KeyPrefixLogIndexStart}); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// PruneBlocksBefore deletes all the indexed txs, block marks, log topics and address txs
+// below height, the history retention drops the blocks the block store no longer keeps.
+// The starts of the log and the address indexes are moved up to height. The bloom bits are
+// kept, the pruned blocks stay in their sections.
+func (kv *KVIndexer) PruneBlocksBefore(height int64) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	txIt, err := kv.db.Iterator([]byte{KeyPrefixTxIndex}, TxIndexKey(height, 0))
+	if err != nil {
+		return err
+	}
+	for ; txIt.Valid(); txIt.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(txIt.Value()))); err != nil {
+			txIt.Close()
+			return err
+		}
+		if err := batch.Delete(txIt.Key()); err != nil {
+			txIt.Close()
+			return err
+		}
+	}
+	txIt.Close()
+
+	blockIt, err := kv.db.Iterator([]byte{KeyPrefixBlock}, BlockKey(height))
+	if err != nil {
+		return err
+	}
+	for ; blockIt.Valid(); blockIt.Next() {
+		if err := batch.Delete(blockIt.Key()); err != nil {
+			blockIt.Close()
+			return err
+		}
+	}
+	blockIt.Close()
+
+	// the height is the suffix of the log topic keys, so the whole index is scanned
+	logIt, err := kv.db.Iterator([]byte{KeyPrefixLogTopic}, []byte{KeyPrefixLogTopic + 1})
+	if err != nil {
+		return err
+	}
+	for ; logIt.Valid(); logIt.Next() {
+		if parseLogTopicHeight(logIt.Key()) >= height {
+			continue
+		}
+		if err := batch.Delete(logIt.Key()); err != nil {
+			logIt.Close()
+			return err
+		}
+	}
+	logIt.Close()
+
+	if err := kv.pruneAddressTxsBefore(batch, height); err != nil {
+		return err
+	}
+
+	start, err := kv.LogIndexStart()
+	if err != nil {
+		return err
+	}
+	if start != -1 && start < height {
+		if err := batch.Set([]byte{KeyPrefixLogIndexStart}, sdk.Uint64ToBigEndian(uint64(height))); err != nil { // #nosec G701
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// LogIndexStart returns the first block covered by the log index, returns -1
+// if the log index is empty.
+func (kv *KVIndexer) LogIndexStart() (int64, error) {
+	bz, err := kv.db.Get([]byte{KeyPrefixLogIndexStart})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LogIndexStart")
+	}
+	if len(bz) != 8 {
+		return -1, nil
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil // #nosec G701
+}
+
+// BlocksByLogTopic returns the ascending numbers of the blocks in [from, to]
+// which contain a log of address with topic0.
+func (kv *KVIndexer) BlocksByLogTopic(address common.Address, topic0 common.Hash, from, to int64) ([]int64, error) {
+	it, err := kv.db.Iterator(LogTopicKey(address, topic0, from), LogTopicKey(address, topic0, to+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "BlocksByLogTopic")
+	}
+	defer it.Close()
+
+	var heights []int64
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, parseLogTopicHeight(it.Key()))
+	}
+	return heights, nil
+}
+
+// markLogIndex records the start of the log index at the first block indexed with
+// it enabled, and drops the start if it's disabled, so a later re-enable doesn't
+// report the skipped blocks as covered.
+func (kv *KVIndexer) markLogIndex(batch dbm.Batch, height int64) error {
+	start, err := kv.LogIndexStart()
+	if err != nil {
+		return err
+	}
+	switch {
+	case kv.logIndex && start == -1:
+		return batch.Set([]byte{KeyPrefixLogIndexStart}, sdk.Uint64ToBigEndian(uint64(height))) // #nosec G701
+	case !kv.logIndex && start != -1:
+		return batch.Delete([]byte{KeyPrefixLogIndexStart})
+	}
+	return nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*artela.TxResult, error) {
+	bz, err := kv.db.Get(TxHashKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	var txKey artela.TxResult
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txKey, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*artela.TxResult, error) {
+	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return kv.GetByTxHash(common.BytesToHash(bz))
+}
+
+// TxHashKey returns the key for db entry: `tx hash -> tx result struct`
+func TxHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
+}
+
+// TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
+func TxIndexKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) // #nosec G701
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     // #nosec G701
+	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
+}
+
+// LogTopicKey returns the key for db entry: `(address, topic0, block number) -> nil`
+func LogTopicKey(address common.Address, topic0 common.Hash, blockNumber int64) []byte {
+	key := make([]byte, 0, LogTopicKeyLength)
+	key = append(key, KeyPrefixLogTopic)
+	key = append(key, address.Bytes()...)
+	key = append(key, topic0.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) // #nosec G701
+}
+
+// BlockKey returns the key for db entry: `block number -> block hash`
+func BlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) // #nosec G701
+}
+
+// blockHeightBoundary returns the first or last indexed block number
+func blockHeightBoundary(db dbm.DB, last bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if last {
+		it, err = db.ReverseIterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	} else {
+		it, err = db.Iterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	}
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "blockHeightBoundary")
+	}
+	defer it.Close()
+	if it.Valid() {
+		return parseBlockNumberFromKey(it.Key())
+	}
+	return -1, nil
+}
+
+// isEthTx check if the tx is an eth tx
+func isEthTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	opts := extTx.GetExtensionOptions()
+	if len(opts) != 1 || opts[0].GetTypeUrl() != "/artela.evm.v1.ExtensionOptionsEthereumTx" {
+		return false
+	}
+	return true
+}
+
+// saveTxResult index the txResult into the kv db batch
+func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *artela.TxResult) error {
+	bz := codec.MustMarshal(txResult)
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-hash key")
+	}
+	if err := batch.Set(TxIndexKey(txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set tx-index key")
+	}
+	return nil
+}
+
+// collectLogTopics adds the log topic keys of the logs of a tx result to keys
+func collectLogTopics(result *abci.ResponseDeliverTx, height int64, keys map[string]struct{}) error {
+	txLogs, err := utils.AllTxLogsFromEvents(result.Events)
+	if err != nil {
+		return err
+	}
+	for _, logs := range txLogs {
+		collectLogsTopics(logs, height, keys)
+	}
+	return nil
+}
+
+// collectLogsTopics adds the log topic keys of the logs to keys
+func collectLogsTopics(logs []*ethtypes.Log, height int64, keys map[string]struct{}) {
+	for _, txLog := range logs {
+		if len(txLog.Topics) == 0 {
+			continue
+		}
+		keys[string(LogTopicKey(txLog.Address, txLog.Topics[0], height))] = struct{}{}
+	}
+}
+
+func parseLogTopicHeight(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[LogTopicKeyLength-8:])) // #nosec G701
+}
+
+func parseBlockNumberFromKey(key []byte) (int64, error) {
+	if len(key) != BlockKeyLength || !bytes.HasPrefix(key, []byte{KeyPrefixBlock}) {
+		return 0, fmt.Errorf("wrong block key length, expect: %d, got: %d", BlockKeyLength, len(key))
+	}
+
+	return int64(binary.BigEndian.Uint64(key[1:9])), nil // #nosec G701
+}
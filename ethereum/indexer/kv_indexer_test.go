@@ -0,0 +1,249 @@
+package indexer
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestLogIndex(t *testing.T) {
+	var (
+		token    = common.HexToAddress("0x01")
+		other    = common.HexToAddress("0x02")
+		transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		approval = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+	)
+
+	db := dbm.NewMemDB()
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	start, err := kv.LogIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), start)
+
+	kv.SetLogIndex(true)
+	batch := db.NewBatch()
+	require.NoError(t, kv.markLogIndex(batch, 5))
+	for _, height := range []int64{5, 7, 9, 12} {
+		require.NoError(t, batch.Set(LogTopicKey(token, transfer, height), []byte{}))
+		require.NoError(t, batch.Set(BlockKey(height), []byte{0x1}))
+	}
+	require.NoError(t, batch.Set(LogTopicKey(token, approval, 8), []byte{}))
+	require.NoError(t, batch.Set(LogTopicKey(other, transfer, 10), []byte{}))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	start, err = kv.LogIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), start)
+
+	heights, err := kv.BlocksByLogTopic(token, transfer, 6, 12)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 9, 12}, heights)
+
+	heights, err = kv.BlocksByLogTopic(other, transfer, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10}, heights)
+
+	require.NoError(t, kv.DeleteBlocksFrom(9))
+	heights, err = kv.BlocksByLogTopic(token, transfer, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 7}, heights)
+	heights, err = kv.BlocksByLogTopic(other, transfer, 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	require.NoError(t, kv.DeleteBlocksFrom(5))
+	start, err = kv.LogIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), start)
+}
+
+func TestAddressIndex(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x01")
+		bob   = common.HexToAddress("0x02")
+	)
+
+	db := dbm.NewMemDB()
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	kv.SetAddressIndex(true, nil)
+	batch := db.NewBatch()
+	require.NoError(t, kv.markAddressIndex(batch, 5))
+	for i, pos := range [][2]int64{{5, 0}, {5, 2}, {7, 1}, {9, 0}} {
+		hash := common.BigToHash(common.Big1).Bytes()
+		hash[0] = byte(i)
+		require.NoError(t, batch.Set(AddressTxKey(alice, pos[0], int32(pos[1])), hash))
+	}
+	require.NoError(t, batch.Set(AddressTxKey(bob, 7, 1), []byte{0x1}))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	start, err := kv.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), start)
+
+	addressTxs, err := kv.TxsByAddress(alice, 100, 0, 3)
+	require.NoError(t, err)
+	require.Len(t, addressTxs, 3)
+	require.Equal(t, int64(9), addressTxs[0].Height)
+	require.Equal(t, int64(7), addressTxs[1].Height)
+	require.Equal(t, int32(2), addressTxs[2].EthTxIndex)
+
+	// the page before the last tx of the previous page
+	addressTxs, err = kv.TxsByAddress(alice, 5, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, addressTxs, 1)
+	require.Equal(t, int64(5), addressTxs[0].Height)
+	require.Equal(t, int32(0), addressTxs[0].EthTxIndex)
+
+	require.NoError(t, kv.DeleteBlocksFrom(7))
+	addressTxs, err = kv.TxsByAddress(alice, 100, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, addressTxs, 2)
+	addressTxs, err = kv.TxsByAddress(bob, 100, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, addressTxs)
+
+	require.NoError(t, kv.DeleteBlocksFrom(5))
+	start, err = kv.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), start)
+}
+
+// systemTxEvents returns the events of a system tx as emitted by the evm keeper.
+func systemTxEvents(t *testing.T, tx *ethtypes.Transaction, txIndex int, gasUsed uint64) []abci.Event {
+	rawTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return []abci.Event{
+		{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: tx.Hash().Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: strconv.Itoa(txIndex)},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: strconv.FormatUint(gasUsed, 10)},
+				{Key: evmtypes.AttributeKeySystemTx, Value: "true"},
+				{Key: evmtypes.AttributeKeyTxData, Value: hexutil.Encode(rawTx)},
+			},
+		},
+		{Type: evmtypes.EventTypeTxLog},
+	}
+}
+
+func TestIndexSystemTxs(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x01")
+		to     = common.HexToAddress("0x02")
+	)
+
+	clientCtx := client.Context{}.WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	kv := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	beginTx0 := txs.NewSystemTx(sender, 0, &to, big.NewInt(0), 30_000, big.NewInt(0), nil)
+	beginTx1 := txs.NewSystemTx(sender, 1, &to, big.NewInt(0), 30_000, big.NewInt(0), nil)
+	endTx := txs.NewSystemTx(sender, 2, &to, big.NewInt(0), 30_000, big.NewInt(0), nil)
+
+	block := tmtypes.MakeBlock(10, nil, &tmtypes.Commit{}, nil)
+	// the block hash is nil without the validators hash
+	block.ValidatorsHash = common.HexToHash("0x01").Bytes()
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height:           10,
+		BeginBlockEvents: append(systemTxEvents(t, beginTx0, 0, 21_000), systemTxEvents(t, beginTx1, 1, 22_000)...),
+		EndBlockEvents:   systemTxEvents(t, endTx, 2, 23_000),
+	}
+	require.NoError(t, kv.IndexBlock(block, blockRes))
+
+	for i, expected := range []struct {
+		tx                *ethtypes.Transaction
+		cumulativeGasUsed uint64
+	}{
+		{beginTx0, 21_000},
+		{beginTx1, 43_000},
+		{endTx, 66_000},
+	} {
+		res, err := kv.GetByTxHash(expected.tx.Hash())
+		require.NoError(t, err)
+		require.True(t, res.SystemTx)
+		require.Equal(t, int64(10), res.Height)
+		require.Equal(t, int32(i), res.EthTxIndex)
+		require.Equal(t, uint32(i), res.MsgIndex)
+		require.Equal(t, expected.cumulativeGasUsed, res.CumulativeGasUsed)
+
+		res, err = kv.GetByBlockAndIndex(10, int32(i))
+		require.NoError(t, err)
+		require.True(t, res.SystemTx)
+	}
+}
+
+func TestPruneBlocksBefore(t *testing.T) {
+	var (
+		token    = common.HexToAddress("0x01")
+		alice    = common.HexToAddress("0x02")
+		transfer = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	)
+
+	db := dbm.NewMemDB()
+	kv := NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+	kv.SetLogIndex(true)
+	kv.SetAddressIndex(true, nil)
+
+	batch := db.NewBatch()
+	require.NoError(t, kv.markLogIndex(batch, 5))
+	require.NoError(t, kv.markAddressIndex(batch, 5))
+	for _, height := range []int64{5, 7, 9, 12} {
+		hash := common.BigToHash(big.NewInt(height))
+		require.NoError(t, batch.Set(TxHashKey(hash), []byte{0x1}))
+		require.NoError(t, batch.Set(TxIndexKey(height, 0), hash.Bytes()))
+		require.NoError(t, batch.Set(LogTopicKey(token, transfer, height), []byte{}))
+		require.NoError(t, batch.Set(AddressTxKey(alice, height, 0), hash.Bytes()))
+		require.NoError(t, batch.Set(BlockKey(height), []byte{0x1}))
+	}
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	require.NoError(t, kv.PruneBlocksBefore(9))
+
+	first, err := kv.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), first)
+	for height, pruned := range map[int64]bool{5: true, 7: true, 9: false, 12: false} {
+		bz, err := db.Get(TxHashKey(common.BigToHash(big.NewInt(height))))
+		require.NoError(t, err)
+		require.Equal(t, pruned, bz == nil, "height %d", height)
+		bz, err = db.Get(TxIndexKey(height, 0))
+		require.NoError(t, err)
+		require.Equal(t, pruned, bz == nil, "height %d", height)
+	}
+
+	heights, err := kv.BlocksByLogTopic(token, transfer, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, []int64{9, 12}, heights)
+	addressTxs, err := kv.TxsByAddress(alice, 100, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, addressTxs, 2)
+
+	// the indexes don't cover the pruned blocks anymore
+	start, err := kv.LogIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), start)
+	start, err = kv.AddressIndexStart()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), start)
+}
@@ -0,0 +1,104 @@
+package replica
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StoreChange is a write to a store of the app committed in a block, a set or a delete.
+type StoreChange struct {
+	Store  string        `json:"store"`
+	Key    hexutil.Bytes `json:"key"`
+	Value  hexutil.Bytes `json:"value"`
+	Delete bool          `json:"delete,omitempty"`
+}
+
+// ChangeSet is the state changes committed in a block, in the order they are written to
+// the stores, and the app hash resulting from them.
+type ChangeSet struct {
+	Height  hexutil.Uint64 `json:"height"`
+	AppHash hexutil.Bytes  `json:"appHash"`
+	Changes []StoreChange  `json:"changes"`
+}
+
+// latestKey is the key of the latest height recorded in the state feed db.
+var latestKey = []byte("latest")
+
+// ChangeSetKey returns the key of the change set of a block in the state feed db.
+func ChangeSetKey(height int64) []byte {
+	return cosmos.Uint64ToBigEndian(uint64(height)) // #nosec G701
+}
+
+// LoadChangeSet returns the change set of the block recorded in the state feed db, nil if
+// the block isn't recorded or it's pruned.
+func LoadChangeSet(db dbm.DB, height int64) (*ChangeSet, error) {
+	bz, err := db.Get(ChangeSetKey(height))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	changeSet := new(ChangeSet)
+	if err := json.Unmarshal(bz, changeSet); err != nil {
+		return nil, fmt.Errorf("failed to decode the change set of block %d: %w", height, err)
+	}
+	return changeSet, nil
+}
+
+// LatestHeight returns the latest height recorded in the state feed db, 0 if none is.
+func LatestHeight(db dbm.DB) (int64, error) {
+	bz, err := db.Get(latestKey)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	return int64(cosmos.BigEndianToUint64(bz)), nil // #nosec G701
+}
+
+// ApplyChangeSet writes the change set of the next block to the stores of the multistore
+// and commits it. The commit is rolled back if its app hash differs from the one of the
+// change set, the local state diverged from the upstream one.
+func ApplyChangeSet(cms storetypes.CommitMultiStore, changeSet *ChangeSet) error {
+	height := int64(changeSet.Height) // #nosec G701
+	if next := cms.LastCommitID().Version + 1; height != next {
+		return fmt.Errorf("change set of block %d doesn't follow the committed block %d", height, next-1)
+	}
+
+	keys, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return fmt.Errorf("multistore %T doesn't expose its store keys", cms)
+	}
+	keysByName := keys.StoreKeysByName()
+
+	for _, change := range changeSet.Changes {
+		key, ok := keysByName[change.Store]
+		if !ok {
+			return fmt.Errorf("unknown store %s in the change set of block %d", change.Store, height)
+		}
+		store := cms.GetCommitKVStore(key)
+		if change.Delete {
+			store.Delete(change.Key)
+		} else {
+			store.Set(change.Key, change.Value)
+		}
+	}
+
+	commitID := cms.Commit()
+	if bytes.Equal(commitID.Hash, changeSet.AppHash) {
+		return nil
+	}
+
+	err := fmt.Errorf("app hash mismatch at block %d, expected %X, got %X", height, []byte(changeSet.AppHash), commitID.Hash)
+	if height > 1 {
+		if rollbackErr := cms.RollbackToVersion(height - 1); rollbackErr != nil {
+			return fmt.Errorf("%w, rollback failed: %s", err, rollbackErr)
+		}
+	}
+	return err
+}
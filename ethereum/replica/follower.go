@@ -0,0 +1,100 @@
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// changeSetBuffer is the buffer size of the change sets streamed by the upstream node
+	changeSetBuffer = 16
+	// resubscribeDelay is the delay before resubscribing to the state feed of the upstream
+	resubscribeDelay = 3 * time.Second
+	// idleTimeout is the time without change sets after which the follower resubscribes, the
+	// upstream stops streaming without closing the subscription if a block isn't retained
+	idleTimeout = time.Minute
+)
+
+// errDiverged is returned when a change set can't be applied to the local state.
+var errDiverged = errors.New("replica state diverged from the upstream node")
+
+// Follower keeps the local multistore of a replica in sync with an upstream node, it applies
+// the change sets streamed by the state feed of the upstream JSON-RPC server in order.
+type Follower struct {
+	cms    storetypes.CommitMultiStore
+	url    string
+	logger log.Logger
+}
+
+// NewFollower creates a Follower of the state feed served by the upstream websocket url.
+func NewFollower(cms storetypes.CommitMultiStore, url string, logger log.Logger) *Follower {
+	return &Follower{
+		cms:    cms,
+		url:    url,
+		logger: logger,
+	}
+}
+
+// Run follows the state feed until the context is done, it resubscribes from the next block
+// when the stream breaks. It returns an error only if a change set can't be applied.
+func (f *Follower) Run(ctx context.Context) error {
+	for {
+		err := f.follow(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, errDiverged) {
+			return err
+		}
+
+		f.logger.Error("state feed interrupted, resubscribing", "upstream", f.url, "error", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+func (f *Follower) follow(ctx context.Context) error {
+	client, err := rpc.DialContext(ctx, f.url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	next := f.cms.LastCommitID().Version + 1
+	changeSets := make(chan *ChangeSet, changeSetBuffer)
+	sub, err := client.Subscribe(ctx, "artela", changeSets, "stateChanges", hexutil.Uint64(next)) // #nosec G701
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+	f.logger.Info("following the upstream state feed", "upstream", f.url, "height", next)
+
+	for {
+		select {
+		case changeSet := <-changeSets:
+			if err := ApplyChangeSet(f.cms, changeSet); err != nil {
+				return fmt.Errorf("%w: %s", errDiverged, err)
+			}
+			f.logger.Debug("applied state change set", "height", uint64(changeSet.Height), "changes", len(changeSet.Changes))
+		case <-time.After(idleTimeout):
+			return fmt.Errorf("no change set streamed in %s", idleTimeout)
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("state feed closed by the upstream node")
+			}
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package replica
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common/lru"
+)
+
+// headerCacheSize is the number of block headers of the query contexts kept in memory
+const headerCacheSize = 256
+
+// QueryClient is the CometBFT client of a replica, it serves the gRPC queries of the app
+// modules from the local multistore and forwards the other calls to the upstream node.
+// The queries of the blocks not applied locally yet, and the queries with proofs, are
+// forwarded too.
+type QueryClient struct {
+	rpcclient.Client
+
+	cms     storetypes.CommitMultiStore
+	router  *baseapp.GRPCQueryRouter
+	logger  log.Logger
+	headers *lru.Cache[int64, tmproto.Header]
+}
+
+// NewQueryClient creates a QueryClient serving the queries routed by the router from the
+// multistore, the upstream client serves the rest and the headers of the blocks.
+func NewQueryClient(upstream rpcclient.Client, cms storetypes.CommitMultiStore, router *baseapp.GRPCQueryRouter, logger log.Logger) *QueryClient {
+	return &QueryClient{
+		Client:  upstream,
+		cms:     cms,
+		router:  router,
+		logger:  logger,
+		headers: lru.NewCache[int64, tmproto.Header](headerCacheSize),
+	}
+}
+
+// ABCIQuery implements rpcclient.ABCIClient.
+func (c *QueryClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+// ABCIQueryWithOptions implements rpcclient.ABCIClient, the errors of the local queries are
+// returned as is, so their gRPC statuses reach the client.
+func (c *QueryClient) ABCIQueryWithOptions(
+	ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	handler := c.router.Route(path)
+	height := opts.Height
+	if height == 0 {
+		height = c.cms.LastCommitID().Version
+	}
+	if handler == nil || opts.Prove || height > c.cms.LastCommitID().Version {
+		return c.Client.ABCIQueryWithOptions(ctx, path, data, opts)
+	}
+
+	sdkCtx, err := c.queryContext(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	res, err := handler(sdkCtx, abci.RequestQuery{Data: data, Path: path, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	res.Height = height
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+// queryContext returns the query context of the state at the height, with the header of
+// the block.
+func (c *QueryClient) queryContext(ctx context.Context, height int64) (cosmos.Context, error) {
+	ms, err := c.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return cosmos.Context{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"failed to load state at height %d; %s (latest height: %d)", height, err, c.cms.LastCommitID().Version)
+	}
+
+	header, ok := c.headers.Get(height)
+	if !ok {
+		res, err := c.Client.Header(ctx, &height)
+		if err != nil {
+			return cosmos.Context{}, err
+		}
+		header = *res.Header.ToProto()
+		c.headers.Add(height, header)
+	}
+
+	return cosmos.NewContext(ms, header, true, c.logger).WithBlockHeight(height), nil
+}
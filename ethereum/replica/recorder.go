@@ -0,0 +1,149 @@
+package replica
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Recorder is the streaming service of the app recording the state changes committed in
+// each block into the state feed db, which is served to the read replicas by the JSON-RPC
+// server. Only the latest retainBlocks change sets are kept, 0 keeps all of them.
+type Recorder struct {
+	db           dbm.DB
+	retainBlocks int64
+	keys         []storetypes.StoreKey
+
+	mu      sync.Mutex
+	changes []StoreChange
+
+	feed event.Feed
+}
+
+// NewRecorder creates a Recorder storing the change sets into the db.
+func NewRecorder(db dbm.DB, retainBlocks uint64) *Recorder {
+	return &Recorder{
+		db:           db,
+		retainBlocks: int64(retainBlocks), // #nosec G701
+	}
+}
+
+// SetStoreKeys sets the stores whose writes are recorded, all the committed stores of the
+// app must be listed for the replicas to reproduce its app hash.
+func (r *Recorder) SetStoreKeys(keys []storetypes.StoreKey) {
+	r.keys = keys
+}
+
+// DB returns the state feed db.
+func (r *Recorder) DB() dbm.DB {
+	return r.db
+}
+
+// Subscribe subscribes to the heights of the blocks whose change set is recorded.
+func (r *Recorder) Subscribe(ch chan<- int64) event.Subscription {
+	return r.feed.Subscribe(ch)
+}
+
+// Listeners implements baseapp.StreamingService, the recorder listens to the writes of
+// the deliver state to the root multistore, which are flushed on commit.
+func (r *Recorder) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(r.keys))
+	for _, key := range r.keys {
+		listeners[key] = []storetypes.WriteListener{r}
+	}
+	return listeners
+}
+
+// OnWrite implements storetypes.WriteListener.
+func (r *Recorder) OnWrite(storeKey storetypes.StoreKey, key, value []byte, delete bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changes = append(r.changes, StoreChange{
+		Store:  storeKey.Name(),
+		Key:    common.CopyBytes(key),
+		Value:  common.CopyBytes(value),
+		Delete: delete,
+	})
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (r *Recorder) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (r *Recorder) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (r *Recorder) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener, it stores the changes written since the
+// last commit as the change set of the committed block and prunes the change sets out of
+// the retention window. The feed is node local data and doesn't affect consensus, so a
+// failed write is only logged, the replicas stop following at the missing block.
+func (r *Recorder) ListenCommit(goCtx context.Context, res abci.ResponseCommit) error {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	height := ctx.BlockHeight()
+
+	r.mu.Lock()
+	changes := r.changes
+	r.changes = nil
+	r.mu.Unlock()
+
+	bz, err := json.Marshal(&ChangeSet{
+		Height:  hexutil.Uint64(height), // #nosec G701
+		AppHash: res.Data,
+		Changes: changes,
+	})
+	if err != nil {
+		ctx.Logger().Error("failed to encode state change set", "height", height, "error", err)
+		return nil
+	}
+
+	batch := r.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(ChangeSetKey(height), bz); err != nil {
+		ctx.Logger().Error("failed to record state change set", "height", height, "error", err)
+		return nil
+	}
+	if err := batch.Set(latestKey, cosmos.Uint64ToBigEndian(uint64(height))); err != nil { // #nosec G701
+		ctx.Logger().Error("failed to record state change set", "height", height, "error", err)
+		return nil
+	}
+	if r.retainBlocks > 0 && height > r.retainBlocks {
+		if err := batch.Delete(ChangeSetKey(height - r.retainBlocks)); err != nil {
+			ctx.Logger().Error("failed to prune state change set", "height", height-r.retainBlocks, "error", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		ctx.Logger().Error("failed to record state change set", "height", height, "error", err)
+		return nil
+	}
+
+	r.feed.Send(height)
+	return nil
+}
+
+// Stream implements baseapp.StreamingService, the change sets are written on commit.
+func (r *Recorder) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Close implements baseapp.StreamingService, the db is closed by its owner.
+func (r *Recorder) Close() error {
+	return nil
+}
@@ -0,0 +1,205 @@
+package replica_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/event"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/replica"
+	"github.com/artela-network/artela/ethereum/rpc/statefeed"
+)
+
+var storeKeys = []storetypes.StoreKey{
+	storetypes.NewKVStoreKey("acc"),
+	storetypes.NewKVStoreKey("evm"),
+}
+
+func newMultiStore(t *testing.T) storetypes.CommitMultiStore {
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range storeKeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	return cms
+}
+
+// upstream is a multistore committing blocks with a state feed recorder.
+type upstream struct {
+	cms      storetypes.CommitMultiStore
+	recorder *replica.Recorder
+}
+
+func newUpstream(t *testing.T, retainBlocks uint64) *upstream {
+	cms := newMultiStore(t)
+	recorder := replica.NewRecorder(dbm.NewMemDB(), retainBlocks)
+	recorder.SetStoreKeys(storeKeys)
+	for key, listeners := range recorder.Listeners() {
+		cms.AddListeners(key, listeners)
+	}
+	return &upstream{cms: cms, recorder: recorder}
+}
+
+// commit writes the block through a cache store as the deliver state does, and commits it.
+func (u *upstream) commit(t *testing.T, write func(stores map[string]cosmos.KVStore)) storetypes.CommitID {
+	cache := u.cms.CacheMultiStore()
+	stores := make(map[string]cosmos.KVStore)
+	for _, key := range storeKeys {
+		stores[key.Name()] = cache.GetKVStore(key)
+	}
+	write(stores)
+	cache.Write()
+
+	commitID := u.cms.Commit()
+	ctx := cosmos.NewContext(u.cms, tmproto.Header{Height: commitID.Version}, false, log.NewNopLogger())
+	require.NoError(t, u.recorder.ListenCommit(cosmos.WrapSDKContext(ctx), abci.ResponseCommit{Data: commitID.Hash}))
+	return commitID
+}
+
+func (u *upstream) commitBlocks(t *testing.T) []storetypes.CommitID {
+	return []storetypes.CommitID{
+		u.commit(t, func(stores map[string]cosmos.KVStore) {
+			stores["acc"].Set([]byte("alice"), []byte("100"))
+			stores["acc"].Set([]byte("bob"), []byte("50"))
+			stores["evm"].Set([]byte("code"), []byte{0x60, 0x00})
+		}),
+		u.commit(t, func(stores map[string]cosmos.KVStore) {
+			stores["acc"].Set([]byte("alice"), []byte("70"))
+			stores["acc"].Delete([]byte("bob"))
+		}),
+		u.commit(t, func(map[string]cosmos.KVStore) {}),
+	}
+}
+
+func TestApplyChangeSet(t *testing.T) {
+	up := newUpstream(t, 0)
+	commitIDs := up.commitBlocks(t)
+
+	latest, err := replica.LatestHeight(up.recorder.DB())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest)
+
+	cms := newMultiStore(t)
+	for _, commitID := range commitIDs {
+		changeSet, err := replica.LoadChangeSet(up.recorder.DB(), commitID.Version)
+		require.NoError(t, err)
+		require.NoError(t, replica.ApplyChangeSet(cms, changeSet))
+		require.Equal(t, commitID, cms.LastCommitID())
+	}
+	require.Equal(t, []byte("70"), cms.GetCommitKVStore(storeKeys[0]).Get([]byte("alice")))
+	require.Nil(t, cms.GetCommitKVStore(storeKeys[0]).Get([]byte("bob")))
+
+	// the change sets must be applied in order
+	changeSet, err := replica.LoadChangeSet(up.recorder.DB(), 2)
+	require.NoError(t, err)
+	require.ErrorContains(t, replica.ApplyChangeSet(cms, changeSet), "doesn't follow")
+}
+
+func TestApplyChangeSetDiverged(t *testing.T) {
+	up := newUpstream(t, 0)
+	commitIDs := up.commitBlocks(t)
+
+	cms := newMultiStore(t)
+	changeSet, err := replica.LoadChangeSet(up.recorder.DB(), 1)
+	require.NoError(t, err)
+	require.NoError(t, replica.ApplyChangeSet(cms, changeSet))
+
+	// a change set whose writes don't produce its app hash is rolled back
+	changeSet, err = replica.LoadChangeSet(up.recorder.DB(), 2)
+	require.NoError(t, err)
+	changeSet.Changes = changeSet.Changes[1:]
+	require.ErrorContains(t, replica.ApplyChangeSet(cms, changeSet), "app hash mismatch")
+	require.Equal(t, commitIDs[0], cms.LastCommitID())
+}
+
+func TestRecorderRetainBlocks(t *testing.T) {
+	up := newUpstream(t, 2)
+	up.commitBlocks(t)
+
+	changeSet, err := replica.LoadChangeSet(up.recorder.DB(), 1)
+	require.NoError(t, err)
+	require.Nil(t, changeSet)
+	for _, height := range []int64{2, 3} {
+		changeSet, err := replica.LoadChangeSet(up.recorder.DB(), height)
+		require.NoError(t, err)
+		require.NotNil(t, changeSet)
+	}
+}
+
+// feedBackend serves the state feed API from a recorder.
+type feedBackend struct {
+	recorder *replica.Recorder
+}
+
+func (b feedBackend) StateChangeSet(height int64) (*replica.ChangeSet, error) {
+	return replica.LoadChangeSet(b.recorder.DB(), height)
+}
+
+func (b feedBackend) LatestStateChangeSet() (int64, error) {
+	return replica.LatestHeight(b.recorder.DB())
+}
+
+func (b feedBackend) SubscribeStateChangeSets(ch chan<- int64) event.Subscription {
+	return b.recorder.Subscribe(ch)
+}
+
+func TestFollower(t *testing.T) {
+	up := newUpstream(t, 0)
+	commitIDs := up.commitBlocks(t)
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("artela", statefeed.NewAPI(gethlog.Root(), feedBackend{up.recorder})))
+	httpServer := httptest.NewServer(server.WebsocketHandler([]string{"*"}))
+	defer httpServer.Close()
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+
+	// the replica catches up with the recorded blocks
+	cms := newMultiStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- replica.NewFollower(cms, url, log.NewNopLogger()).Run(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return cms.LastCommitID().Version == 3
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, commitIDs[2], cms.LastCommitID())
+
+	// and follows the newly committed ones
+	commitID := up.commit(t, func(stores map[string]cosmos.KVStore) {
+		stores["evm"].Set([]byte("slot"), []byte{0x01})
+	})
+	require.Eventually(t, func() bool {
+		return cms.LastCommitID().Version == 4
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, commitID, cms.LastCommitID())
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestStateChangesNotRetained(t *testing.T) {
+	up := newUpstream(t, 1)
+	up.commitBlocks(t)
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("artela", statefeed.NewAPI(gethlog.Root(), feedBackend{up.recorder})))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	_, err := client.Subscribe(context.Background(), "artela", make(chan *replica.ChangeSet), "stateChanges", "0x1")
+	require.ErrorContains(t, err, "not retained")
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -29,28 +32,125 @@ import (
 	types2 "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/ethereum/utils"
 	"github.com/artela-network/artela/x/evm/txs"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
 
+// derivedKeyPrefix prefixes the names of the keys derived by DeriveAccount, which are
+// named "derived/<address>/<path>" to keep the HD path of the keys.
+const derivedKeyPrefix = "derived/"
+
+// maxDerivedAccounts bounds the search of the first account of an HD wallet not in the keyring
+const maxDerivedAccounts = 1000
+
+// Accounts returns the addresses of the keyring, ordered by the key names.
 func (b *BackendImpl) Accounts() []common.Address {
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
-	infos, err := b.clientCtx.Keyring.List()
+	infos, err := b.AccountsInfo()
 	if err != nil {
 		b.logger.Info("keying list failed", "error", err)
 		return nil
 	}
 
 	for _, info := range infos {
-		pubKey, err := info.GetPubKey()
+		addresses = append(addresses, info.Address)
+	}
+
+	return addresses
+}
+
+// AccountsInfo returns the metadata of the keys of the keyring, ordered by the key names.
+func (b *BackendImpl) AccountsInfo() ([]ethapi2.AccountInfo, error) {
+	records, err := b.clientCtx.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Name < records[j].Name
+	})
+
+	infos := make([]ethapi2.AccountInfo, 0, len(records))
+	for _, record := range records {
+		pubKey, err := record.GetPubKey()
 		if err != nil {
-			b.logger.Info("getPubKey failed", "info", info, "error", err)
-			return nil
+			b.logger.Info("getPubKey failed", "name", record.Name, "error", err)
+			return nil, err
+		}
+
+		info := ethapi2.AccountInfo{
+			Address: common.BytesToAddress(pubKey.Address().Bytes()),
+			Name:    record.Name,
+			Type:    record.GetType().String(),
+			KeyType: pubKey.Type(),
+		}
+		if ledger := record.GetLedger(); ledger != nil && ledger.GetPath() != nil {
+			info.Path = ledger.GetPath().String()
+		} else if parts := strings.SplitN(record.Name, "/", 3); strings.HasPrefix(record.Name, derivedKeyPrefix) && len(parts) == 3 {
+			info.Path = parts[2]
 		}
-		addressBytes := pubKey.Address().Bytes()
-		addresses = append(addresses, common.BytesToAddress(addressBytes))
+		infos = append(infos, info)
 	}
 
-	return addresses
+	return infos, nil
+}
+
+// DeriveAccount derives the account of the mnemonic at the HD path, or at the first path
+// of the default derivation base not in the keyring if the path is empty. The pinned
+// accounts are stored in the keyring.
+func (b *BackendImpl) DeriveAccount(mnemonic, path string, pin bool) (accounts.Account, error) {
+	derive := func(path string) (common.Address, error) {
+		derived, err := hd.EthSecp256k1.Derive()(mnemonic, "", path)
+		if err != nil {
+			return common.Address{}, err
+		}
+		privKey := hd.EthSecp256k1.Generate()(derived)
+		return common.BytesToAddress(privKey.PubKey().Address().Bytes()), nil
+	}
+
+	var addr common.Address
+	if path != "" {
+		hdPath, err := accounts.ParseDerivationPath(path)
+		if err != nil {
+			return accounts.Account{}, err
+		}
+		path = hdPath.String()
+		if addr, err = derive(path); err != nil {
+			return accounts.Account{}, err
+		}
+	} else {
+		hdPathIter, err := types2.NewHDPathIterator(sdktypes.GetConfig().GetFullBIP44Path(), false)
+		if err != nil {
+			return accounts.Account{}, err
+		}
+		for i := 0; ; i++ {
+			if i == maxDerivedAccounts {
+				return accounts.Account{}, fmt.Errorf("the first %d accounts of the wallet are in the keyring already", maxDerivedAccounts)
+			}
+			path = hdPathIter().String()
+			if addr, err = derive(path); err != nil {
+				return accounts.Account{}, err
+			}
+			if _, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(addr.Bytes())); err != nil {
+				break
+			}
+		}
+	}
+
+	name := derivedKeyPrefix + addr.Hex() + "/" + path
+	if pin {
+		if record, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(addr.Bytes())); err == nil {
+			// the account is in the keyring already
+			name = record.Name
+		} else if _, err := b.clientCtx.Keyring.NewAccount(name, mnemonic, "", path, hd.EthSecp256k1); err != nil {
+			b.logger.Info("NewAccount failed", "error", err)
+			return accounts.Account{}, err
+		}
+	}
+
+	return accounts.Account{
+		Address: addr,
+		URL:     accounts.URL{Scheme: "keyring", Path: name},
+	}, nil
 }
 
 func (b *BackendImpl) NewAccount(password string) (common.AddressEIP55, error) {
@@ -88,8 +188,22 @@ func (b *BackendImpl) ImportRawKey(privkey, password string) (common.Address, er
 		return common.Address{}, err
 	}
 
-	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
+	return b.importPrivKey(&ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}, password)
+}
 
+// ImportKeystore imports the key of a keystore v3 json of geth into the keyring, the key is
+// decrypted with the passphrase, which also encrypts the imported key.
+func (b *BackendImpl) ImportKeystore(keyJSON, passphrase string) (common.Address, error) {
+	privKey, err := ethsecp256k1.DecryptKeystore([]byte(keyJSON), passphrase)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return b.importPrivKey(privKey, passphrase)
+}
+
+// importPrivKey imports the private key into the keyring encrypted with the password, it's a
+// no-op if the key is in the keyring already.
+func (b *BackendImpl) importPrivKey(privKey *ethsecp256k1.PrivKey, password string) (common.Address, error) {
 	addr := sdktypes.AccAddress(privKey.PubKey().Address().Bytes())
 	ethereumAddr := common.BytesToAddress(addr)
 
@@ -112,6 +226,10 @@ func (b *BackendImpl) ImportRawKey(privkey, password string) (common.Address, er
 }
 
 func (b *BackendImpl) SignTransaction(args *ethapi2.TransactionArgs) (*ethtypes.Transaction, error) {
+	if b.appConf.JSONRPC.ReadOnly {
+		return nil, errReadOnly
+	}
+
 	_, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(args.From.Bytes()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
@@ -143,6 +261,10 @@ func (b *BackendImpl) SignTransaction(args *ethapi2.TransactionArgs) (*ethtypes.
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *BackendImpl) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if b.appConf.JSONRPC.ReadOnly {
+		return nil, errReadOnly
+	}
+
 	from := sdktypes.AccAddress(address.Bytes())
 
 	_, err := b.clientCtx.Keyring.KeyByAddress(from)
@@ -160,6 +282,38 @@ func (b *BackendImpl) Sign(address common.Address, data hexutil.Bytes) (hexutil.
 	return signature, nil
 }
 
+// UnlockAccount unlocks the account of the keyring for the duration, indefinitely if the
+// duration is 0. The keys are guarded by the keyring backend rather than by per key
+// passwords, so unlocking only checks the account is in the keyring.
+func (b *BackendImpl) UnlockAccount(address common.Address, duration time.Duration) error {
+	if _, err := b.clientCtx.Keyring.KeyByAddress(sdktypes.AccAddress(address.Bytes())); err != nil {
+		return fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	var expiry time.Time
+	if duration > 0 {
+		expiry = time.Now().Add(duration)
+	}
+
+	b.unlockMu.Lock()
+	defer b.unlockMu.Unlock()
+	b.unlocked[address] = expiry
+	return nil
+}
+
+// LockAccount locks the account, it returns false if the account is not unlocked.
+func (b *BackendImpl) LockAccount(address common.Address) bool {
+	b.unlockMu.Lock()
+	defer b.unlockMu.Unlock()
+
+	expiry, ok := b.unlocked[address]
+	if !ok {
+		return false
+	}
+	delete(b.unlocked, address)
+	return expiry.IsZero() || time.Now().Before(expiry)
+}
+
 func (b *BackendImpl) GetTransactionCount(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error) {
 	n := hexutil.Uint64(0)
 	height, err := b.blockNumberFromCosmos(blockNrOrHash)
@@ -220,13 +374,23 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 
 	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
 	// to manually add them.
-	pendingTxs, err := b.PendingTransactions()
+	pendingTxs, err := b.pendingTxsFrom(accAddr)
 	if err != nil {
 		return nonce, nil
 	}
 
-	// add the uncommitted txs to the nonce counter
-	// only supports `MsgEthereumTx` style tx
+	return nonce + uint64(len(pendingTxs)), nil
+}
+
+// pendingTxsFrom returns the uncommitted ethereum txs sent by the address in the mempool,
+// only supports `MsgEthereumTx` style tx.
+func (b *BackendImpl) pendingTxsFrom(accAddr common.Address) ([]*ethtypes.Transaction, error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*ethtypes.Transaction
 	for _, tx := range pendingTxs {
 		for _, msg := range (*tx).GetMsgs() {
 			ethMsg, ok := msg.(*txs.MsgEthereumTx)
@@ -240,19 +404,22 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 				continue
 			}
 			if sender == accAddr {
-				nonce++
+				result = append(result, ethMsg.AsTransaction())
 			}
 		}
 	}
 
-	return nonce, nil
+	return result, nil
 }
 
+// GetBalance returns the balance of the account at the given block, the state of any height
+// retained by the node can be queried.
 func (b *BackendImpl) GetBalance(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
-	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	height, err := b.stateHeight(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
+	blockNum := rpc.BlockNumber(height)
 
 	req := &txs.QueryBalanceRequest{
 		Address: address.String(),
@@ -263,9 +430,9 @@ func (b *BackendImpl) GetBalance(address common.Address, blockNrOrHash rpc.Block
 		return nil, err
 	}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(height), req)
 	if err != nil {
-		return nil, err
+		return nil, b.prunedError(height, err)
 	}
 
 	val, ok := sdkmath.NewIntFromString(res.Balance)
@@ -277,7 +444,24 @@ func (b *BackendImpl) GetBalance(address common.Address, blockNrOrHash rpc.Block
 		return nil, errors.New("couldn't fetch balance. Node state is pruned")
 	}
 
-	return (*hexutil.Big)(val.BigInt()), nil
+	balance := val.BigInt()
+	if blockNum == rpc.PendingBlockNumber {
+		// reserve the balance committed to the uncommitted txs of the account, i.e. the
+		// value and the max fee of each tx, so the balance can't be spent twice by the
+		// following txs.
+		pendingTxs, err := b.pendingTxsFrom(address)
+		if err != nil {
+			b.logger.Debug("failed to fetch the pending txs", "address", address.Hex(), "error", err)
+		}
+		for _, tx := range pendingTxs {
+			balance.Sub(balance, tx.Cost())
+		}
+		if balance.Sign() < 0 {
+			balance.SetUint64(0)
+		}
+	}
+
+	return (*hexutil.Big)(balance), nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
@@ -312,3 +496,78 @@ func (b *BackendImpl) GetSender(msg *txs.MsgEthereumTx, chainID *big.Int) (from
 	msg.From = from.Hex()
 	return from, nil
 }
+
+// GetProof returns the account and the storage values of the address with their merkle
+// proofs at the given block. The proofs are Artela specific: each proof is the list of the
+// hex encoded cosmos ProofOps proving the key, i.e. the IAVL proof of the key in the module
+// store followed by the proof of the module store in the app hash of the next block header.
+// The account is proven by its key in the auth store and the storage slots by their keys in
+// the evm store, the storage hash is not defined as the storage has no per account trie.
+func (b *BackendImpl) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*ethapi2.AccountResult, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	height := blockNum.Int64()
+	if height <= 0 {
+		latest, err := b.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(latest)
+	}
+
+	if _, err := b.CosmosBlockByNumber(rpc.BlockNumber(height)); err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	clientCtx := b.clientCtx.WithHeight(height)
+
+	// query storage proofs
+	storageProofs := make([]ethapi2.StorageResult, len(storageKeys))
+	for i, key := range storageKeys {
+		hexKey := common.HexToHash(key)
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+
+		storageProofs[i] = ethapi2.StorageResult{
+			Key:   key,
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+			Proof: rpctypes.HexProofs(proof),
+		}
+	}
+
+	// query EVM account
+	res, err := b.queryClient.Account(rpctypes.ContextWithHeight(height), &txs.QueryAccountRequest{
+		Address: address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// query account proofs
+	accountKey := authtypes.AddressStoreKey(sdktypes.AccAddress(address.Bytes()))
+	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := sdkmath.NewIntFromString(res.Balance)
+	if !ok {
+		return nil, errors.New("invalid balance")
+	}
+
+	return &ethapi2.AccountResult{
+		Address:      address,
+		AccountProof: rpctypes.HexProofs(proof),
+		Balance:      (*hexutil.Big)(balance.BigInt()),
+		CodeHash:     common.HexToHash(res.CodeHash),
+		Nonce:        hexutil.Uint64(res.Nonce),
+		StorageHash:  common.Hash{},
+		StorageProof: storageProofs,
+	}, nil
+}
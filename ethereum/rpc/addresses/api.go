@@ -0,0 +1,131 @@
+package addresses
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const (
+	// defaultPageSize is the number of the txs of a page if the limit is not given
+	defaultPageSize = 100
+	// maxPageSize caps the number of the txs of a page
+	maxPageSize = 1000
+)
+
+// errInvalidCursor is returned for a cursor not issued by a previous page.
+var errInvalidCursor = errors.New("invalid address txs cursor")
+
+// Backend defines the methods required by the addresses API
+type Backend interface {
+	TxsByAddress(address common.Address, beforeHeight int64, beforeIndex int32, limit int) ([]ethereumtypes.AddressTx, int64, error)
+}
+
+// AddressTx is a tx sent or received by an address, directly or through an internal call.
+type AddressTx struct {
+	Hash             common.Hash    `json:"hash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+}
+
+// AddressTxsPage is a page of the txs of an address from the newest to the oldest, Cursor
+// continues the query from the tx following the page, it's empty if the page is the last one.
+// The txs of the blocks before IndexedFrom are not covered by the address index.
+type AddressTxsPage struct {
+	Transactions []*AddressTx   `json:"transactions"`
+	IndexedFrom  hexutil.Uint64 `json:"indexedFrom"`
+	Cursor       string         `json:"cursor,omitempty"`
+}
+
+// API offers the txs of the addresses indexed by the node, it is served under the artela
+// namespace only if the address index is enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new addresses API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// GetTransactionsByAddress returns a page of the txs sent or received by the address, from
+// the newest to the oldest, starting from the cursor returned by the previous page, or from
+// the latest tx if the cursor is empty. A page holds at most limit txs, 100 by default and
+// capped to 1000.
+func (api *API) GetTransactionsByAddress(address common.Address, cursor string, limit *hexutil.Uint) (*AddressTxsPage, error) {
+	api.logger.Debug("artela_getTransactionsByAddress", "address", address.Hex(), "cursor", cursor)
+
+	pageSize := defaultPageSize
+	if limit != nil && *limit > 0 {
+		pageSize = int(*limit)
+		if pageSize > maxPageSize {
+			pageSize = maxPageSize
+		}
+	}
+
+	beforeHeight, beforeIndex := int64(math.MaxInt64), int32(math.MaxInt32)
+	if cursor != "" {
+		var err error
+		if beforeHeight, beforeIndex, err = decodeCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// one more tx is queried to know if the page is the last one
+	addressTxs, start, err := api.backend.TxsByAddress(address, beforeHeight, beforeIndex, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AddressTxsPage{
+		Transactions: make([]*AddressTx, 0, len(addressTxs)),
+		IndexedFrom:  hexutil.Uint64(start), // #nosec G701
+	}
+	if len(addressTxs) > pageSize {
+		addressTxs = addressTxs[:pageSize]
+		last := addressTxs[pageSize-1]
+		page.Cursor = encodeCursor(last.Height, last.EthTxIndex)
+	}
+	for _, addressTx := range addressTxs {
+		page.Transactions = append(page.Transactions, &AddressTx{
+			Hash:             addressTx.Hash,
+			BlockNumber:      hexutil.Uint64(addressTx.Height),     // #nosec G701
+			TransactionIndex: hexutil.Uint64(addressTx.EthTxIndex), // #nosec G701
+		})
+	}
+	return page, nil
+}
+
+// encodeCursor encodes the height of a block and the eth tx index of a tx in the block to
+// an opaque cursor.
+func encodeCursor(height int64, ethTxIndex int32) string {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(height))         // #nosec G701
+	binary.BigEndian.PutUint64(bz[8:], uint64(ethTxIndex)) // #nosec G701
+	return hexutil.Encode(bz)
+}
+
+// decodeCursor decodes a cursor returned by encodeCursor.
+func decodeCursor(cursor string) (int64, int32, error) {
+	bz, err := hexutil.Decode(cursor)
+	if err != nil || len(bz) != 16 {
+		return 0, 0, errInvalidCursor
+	}
+
+	height := binary.BigEndian.Uint64(bz)
+	ethTxIndex := binary.BigEndian.Uint64(bz[8:])
+	if height == 0 || height > math.MaxInt64 || ethTxIndex > math.MaxInt32 {
+		return 0, 0, errInvalidCursor
+	}
+	return int64(height), int32(ethTxIndex), nil // #nosec G701
+}
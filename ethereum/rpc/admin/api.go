@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/p2p"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// protocolName is the key of the CometBFT protocol in the protocols of the node and the peers.
+const protocolName = "cometbft"
+
+// Backend defines the methods required by the admin API
+type Backend interface {
+	NodeStatus() (*tmrpctypes.ResultStatus, error)
+	NetInfo() (*tmrpctypes.ResultNetInfo, error)
+}
+
+// Ports is the listening ports of the node, the discovery port is the P2P port of CometBFT
+// as the peers are discovered by the PEX reactor on the same port.
+type Ports struct {
+	Discovery int `json:"discovery"`
+	Listener  int `json:"listener"`
+}
+
+// ProtocolInfo is the CometBFT protocol run by the node or a peer.
+type ProtocolInfo struct {
+	Network string `json:"network"`
+	Version string `json:"version"`
+	P2P     uint64 `json:"p2p"`
+	Block   uint64 `json:"block"`
+	App     uint64 `json:"app"`
+	// the sync status is known for the node only
+	LatestBlockHeight int64  `json:"latestBlockHeight,omitempty"`
+	LatestBlockHash   string `json:"latestBlockHash,omitempty"`
+	CatchingUp        *bool  `json:"catchingUp,omitempty"`
+}
+
+// NodeInfo is the P2P information of the node, in the layout of admin_nodeInfo of geth.
+type NodeInfo struct {
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name"`
+	IP         string                   `json:"ip"`
+	Ports      Ports                    `json:"ports"`
+	ListenAddr string                   `json:"listenAddr"`
+	Protocols  map[string]*ProtocolInfo `json:"protocols"`
+}
+
+// PeerNetwork is the connection to a peer, the remote address is the IP of the peer.
+type PeerNetwork struct {
+	RemoteAddress string `json:"remoteAddress"`
+	Inbound       bool   `json:"inbound"`
+}
+
+// PeerInfo is the information of a connected peer, in the layout of admin_peers of geth.
+type PeerInfo struct {
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name"`
+	ListenAddr string                   `json:"listenAddr"`
+	Network    PeerNetwork              `json:"network"`
+	Protocols  map[string]*ProtocolInfo `json:"protocols"`
+}
+
+// API offers the P2P information of the CometBFT node under the admin namespace.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new admin API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// NodeInfo returns the P2P information of the node.
+func (api *API) NodeInfo() (*NodeInfo, error) {
+	api.logger.Debug("admin_nodeInfo")
+
+	status, err := api.backend.NodeStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	protocol := protocolInfo(nodeInfo)
+	catchingUp := status.SyncInfo.CatchingUp
+	protocol.LatestBlockHeight = status.SyncInfo.LatestBlockHeight
+	protocol.LatestBlockHash = "0x" + strings.ToLower(status.SyncInfo.LatestBlockHash.String())
+	protocol.CatchingUp = &catchingUp
+
+	ip, port := splitListenAddr(nodeInfo.ListenAddr)
+	return &NodeInfo{
+		ID:         string(nodeInfo.DefaultNodeID),
+		Name:       nodeInfo.Moniker,
+		IP:         ip,
+		Ports:      Ports{Discovery: port, Listener: port},
+		ListenAddr: nodeInfo.ListenAddr,
+		Protocols:  map[string]*ProtocolInfo{protocolName: protocol},
+	}, nil
+}
+
+// Peers returns the information of the connected peers.
+func (api *API) Peers() ([]*PeerInfo, error) {
+	api.logger.Debug("admin_peers")
+
+	netInfo, err := api.backend.NetInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, &PeerInfo{
+			ID:         string(peer.NodeInfo.DefaultNodeID),
+			Name:       peer.NodeInfo.Moniker,
+			ListenAddr: peer.NodeInfo.ListenAddr,
+			Network: PeerNetwork{
+				RemoteAddress: peer.RemoteIP,
+				Inbound:       !peer.IsOutbound,
+			},
+			Protocols: map[string]*ProtocolInfo{protocolName: protocolInfo(peer.NodeInfo)},
+		})
+	}
+	return peers, nil
+}
+
+func protocolInfo(nodeInfo p2p.DefaultNodeInfo) *ProtocolInfo {
+	return &ProtocolInfo{
+		Network: nodeInfo.Network,
+		Version: nodeInfo.Version,
+		P2P:     nodeInfo.ProtocolVersion.P2P,
+		Block:   nodeInfo.ProtocolVersion.Block,
+		App:     nodeInfo.ProtocolVersion.App,
+	}
+}
+
+// splitListenAddr splits a listen address like tcp://0.0.0.0:26656 into the host and the
+// port, the port is 0 if the address is malformed.
+func splitListenAddr(addr string) (string, int) {
+	if i := strings.Index(addr, "://"); i >= 0 {
+		addr = addr[i+3:]
+	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr, 0
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, 0
+	}
+	return host, port
+}
@@ -0,0 +1,118 @@
+package analytics
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+const (
+	// DefaultHotContractsWindow is the default number of latest blocks of a hot contracts report
+	DefaultHotContractsWindow = 100
+	// MaxHotContractsWindow is the max number of blocks of a hot contracts report
+	MaxHotContractsWindow = 10000
+	// DefaultHotContractsLimit is the default number of contracts of a hot contracts report
+	DefaultHotContractsLimit = 20
+)
+
+// Backend defines the methods required by the analytics API
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	BlockAccessStats(height int64) (states.AccessStats, error)
+}
+
+// HotContract is the storage access counters of a contract over a window.
+type HotContract struct {
+	Address  common.Address `json:"address"`
+	Reads    hexutil.Uint64 `json:"reads"`
+	Writes   hexutil.Uint64 `json:"writes"`
+	Accesses hexutil.Uint64 `json:"accesses"`
+}
+
+// HotContractsReport is the result of artela_hotContracts.
+type HotContractsReport struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+	Contracts []HotContract  `json:"contracts"`
+}
+
+// API offers the state access analytics of the node, it is served under the
+// artela namespace only if the access stats are enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new analytics API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// HotContracts reports the contracts with the most storage accesses over the
+// given number of latest blocks, ordered by the total of reads and writes.
+func (api *API) HotContracts(blocks, limit *hexutil.Uint64) (*HotContractsReport, error) {
+	window := uint64(DefaultHotContractsWindow)
+	if blocks != nil {
+		window = uint64(*blocks)
+	}
+	if window == 0 || window > MaxHotContractsWindow {
+		return nil, fmt.Errorf("window must be between 1 and %d blocks", MaxHotContractsWindow)
+	}
+
+	n := uint64(DefaultHotContractsLimit)
+	if limit != nil {
+		n = uint64(*limit)
+	}
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	to := int64(latest)
+	from := to - int64(window) + 1
+	if from < 1 {
+		from = 1
+	}
+
+	total := make(states.AccessStats)
+	for height := from; height <= to; height++ {
+		stats, err := api.backend.BlockAccessStats(height)
+		if err != nil {
+			return nil, err
+		}
+		total.Merge(stats)
+	}
+
+	contracts := make([]HotContract, 0, len(total))
+	for addr, count := range total {
+		contracts = append(contracts, HotContract{
+			Address:  addr,
+			Reads:    hexutil.Uint64(count.Reads),
+			Writes:   hexutil.Uint64(count.Writes),
+			Accesses: hexutil.Uint64(count.Reads + count.Writes),
+		})
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		if contracts[i].Accesses != contracts[j].Accesses {
+			return contracts[i].Accesses > contracts[j].Accesses
+		}
+		return contracts[i].Address.Hex() < contracts[j].Address.Hex()
+	})
+	if uint64(len(contracts)) > n {
+		contracts = contracts[:n]
+	}
+
+	return &HotContractsReport{
+		FromBlock: hexutil.Uint64(from),
+		ToBlock:   hexutil.Uint64(to),
+		Contracts: contracts,
+	}, nil
+}
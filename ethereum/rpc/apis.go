@@ -3,12 +3,24 @@ package rpc
 import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/exp/slices"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 
+	"github.com/artela-network/artela/ethereum/rpc/addresses"
+	"github.com/artela-network/artela/ethereum/rpc/admin"
+	"github.com/artela-network/artela/ethereum/rpc/analytics"
+	"github.com/artela-network/artela/ethereum/rpc/aspects"
+	"github.com/artela-network/artela/ethereum/rpc/checkpoints"
+	"github.com/artela-network/artela/ethereum/rpc/contracts"
 	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	"github.com/artela-network/artela/ethereum/rpc/faucet"
+	"github.com/artela-network/artela/ethereum/rpc/feestats"
 	"github.com/artela-network/artela/ethereum/rpc/filters"
+	"github.com/artela-network/artela/ethereum/rpc/statefeed"
+	"github.com/artela-network/artela/ethereum/rpc/traces"
+	"github.com/artela-network/artela/ethereum/rpc/witness"
 	"github.com/artela-network/artela/ethereum/types"
 )
 
@@ -19,7 +31,8 @@ func GetAPIs(clientCtx client.Context, wsClient *rpcclient.WSClient, logger log.
 	}
 
 	nonceLock := new(ethapi.AddrLocker)
-	return []rpc.API{
+	filterAPI := filters.NewPublicFilterAPI(logger, clientCtx, wsClient, apiBackend)
+	apis := []rpc.API{
 		{
 			Namespace: "eth",
 			Service:   ethapi.NewEthereumAPI(apiBackend),
@@ -39,14 +52,102 @@ func GetAPIs(clientCtx client.Context, wsClient *rpcclient.WSClient, logger log.
 			Namespace: "eth",
 			Service:   ethapi.NewEthereumAccountAPI(apiBackend),
 		}, {
-			Namespace: "personal",
-			Service:   ethapi.NewPersonalAccountAPI(apiBackend, logger, nonceLock),
+			Namespace: "admin",
+			Service:   admin.NewAPI(logger, apiBackend),
 		}, {
 			Namespace: "net",
-			Service:   ethapi.NewNetAPI(nil, chainID.Uint64()),
+			Service:   ethapi.NewNetAPI(apiBackend, chainID.Uint64()),
 		}, {
 			Namespace: "eth",
-			Service:   filters.NewPublicFilterAPI(logger, clientCtx, wsClient, apiBackend),
+			Service:   filterAPI,
+		}, {
+			Namespace: "artela",
+			Service:   filters.NewTransactionStatusAPI(filterAPI, apiBackend),
+		}, {
+			Namespace: "artela",
+			Service:   filters.NewSendSyncAPI(filterAPI, apiBackend),
+		}, {
+			Namespace: "artela",
+			Service:   filters.NewAspectEventsAPI(filterAPI),
+		}, {
+			Namespace: "artela",
+			Service:   filters.NewLogsPageAPI(filterAPI),
+		}, {
+			Namespace: "artela",
+			Service:   filters.NewProposalsAPI(logger, apiBackend),
+		}, {
+			Namespace: "artela",
+			Service:   checkpoints.NewAPI(logger, apiBackend),
+		}, {
+			Namespace: "artela",
+			Service:   contracts.NewProxyAPI(logger, apiBackend),
+		}, {
+			Namespace: "artela",
+			Service:   aspects.NewAPI(logger, apiBackend),
+		}, {
+			Namespace: "trace",
+			Service:   traces.NewAPI(logger, apiBackend),
 		},
 	}
+
+	// the personal namespace is also served by the authenticated server, which doesn't
+	// require the unsafe flag
+	if apiBackend.appConf.JSONRPC.UnsafePersonal || slices.Contains(apiBackend.appConf.JSONRPC.AuthNamespaces(), "personal") {
+		apis = append(apis, rpc.API{
+			Namespace: "personal",
+			Service:   ethapi.NewPersonalAccountAPI(apiBackend, logger, nonceLock),
+		})
+	}
+
+	if apiBackend.cfg.AccessStatsDB != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   analytics.NewAPI(logger, apiBackend),
+		})
+	}
+
+	if apiBackend.feeStats != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   feestats.NewAPI(logger, apiBackend.feeStats),
+		})
+	}
+
+	if apiBackend.cfg.ContractIndexDB != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   contracts.NewAPI(logger, apiBackend),
+		})
+	}
+
+	if apiBackend.appConf.JSONRPC.EnableAddressIndex {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   addresses.NewAPI(logger, apiBackend),
+		})
+	}
+
+	if apiBackend.cfg.WitnessDB != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "debug",
+			Service:   witness.NewAPI(logger, apiBackend),
+		})
+	}
+
+	if apiBackend.cfg.StateFeed != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   statefeed.NewAPI(logger, apiBackend),
+		})
+	}
+
+	// the faucet sends txs, it's not served in the read-only mode
+	if apiBackend.FaucetConfig().Enable && !apiBackend.appConf.JSONRPC.ReadOnly {
+		apis = append(apis, rpc.API{
+			Namespace: "artela",
+			Service:   faucet.NewAPI(logger, apiBackend),
+		})
+	}
+
+	return apis
 }
@@ -0,0 +1,41 @@
+package aspects
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+)
+
+// Backend defines the methods required by the aspects API
+type Backend interface {
+	AspectMetadata(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*artelatypes.AspectMetadata, error)
+}
+
+// API offers the metadata of the deployed aspects, so the aspect SDKs can introspect an
+// aspect before binding to it.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new aspects API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// GetAspectMetadata returns the join points, the version history, the property keys and
+// the declared operation interface of the aspect, nil if the aspect is not deployed.
+func (api *API) GetAspectMetadata(aspectId common.Address, blockNrOrHash *rpc.BlockNumberOrHash) (*artelatypes.AspectMetadata, error) {
+	api.logger.Debug("artela_getAspectMetadata", "aspect", aspectId.Hex())
+
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	return api.backend.AspectMetadata(aspectId, *blockNrOrHash)
+}
@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// registerAuthRPC serves the auth modules over http and websocket on the auth address, the
+// requests must carry a JWT signed with the secret of the JWT secret file, as the engine API
+// of the ethereum clients. The secret file is generated if it doesn't exist.
+func (n *Node) registerAuthRPC() error {
+	cfg := n.Config()
+	secret, err := loadJWTSecret(cfg.JWTSecret)
+	if err != nil {
+		return err
+	}
+
+	srv := n.newModulesServer(n.authModules)
+	httpHandler := node.NewHTTPHandlerStack(srv, node.DefaultAuthCors, cfg.AuthVirtualHosts, secret)
+	wsHandler := node.NewWSHandlerStack(srv.WebsocketHandler(node.DefaultAuthOrigins), secret)
+
+	n.RegisterLifecycle(&authServer{
+		node: n,
+		addr: net.JoinHostPort(cfg.AuthAddr, fmt.Sprintf("%d", cfg.AuthPort)),
+		srv:  srv,
+		handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isWebsocket(r) {
+				wsHandler.ServeHTTP(w, r)
+				return
+			}
+			httpHandler.ServeHTTP(w, r)
+		}),
+	})
+	return nil
+}
+
+// loadJWTSecret loads the hex encoded 32 bytes secret of the file, a new secret is
+// generated and written to the file if it doesn't exist.
+func loadJWTSecret(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.New("JWT secret file of the authenticated endpoint is not set")
+	}
+
+	if data, err := os.ReadFile(path); err == nil {
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != 32 {
+			return nil, fmt.Errorf("invalid JWT secret in %s, expect 32 hex encoded bytes", path)
+		}
+		return secret, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// isWebsocket reports if the request is a websocket upgrade request.
+func isWebsocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// authServer is the listener of the authenticated endpoint, it's started and stopped
+// along with the node.
+type authServer struct {
+	node    *Node
+	addr    string
+	srv     *rpc.Server
+	handler http.Handler
+
+	httpSrv *http.Server
+}
+
+func (s *authServer) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the authenticated endpoint %s, %w", s.addr, err)
+	}
+
+	timeouts := s.node.Config().HTTPTimeouts
+	s.httpSrv = &http.Server{
+		Handler:           s.handler,
+		ReadTimeout:       timeouts.ReadTimeout,
+		ReadHeaderTimeout: timeouts.ReadHeaderTimeout,
+		WriteTimeout:      timeouts.WriteTimeout,
+		IdleTimeout:       timeouts.IdleTimeout,
+	}
+	go func() {
+		_ = s.httpSrv.Serve(listener)
+	}()
+
+	if logger := s.node.Config().Logger; logger != nil {
+		logger.Info("Authenticated RPC server started", "endpoint", listener.Addr().String(), "modules", s.node.authModules)
+	}
+	return nil
+}
+
+// Stop drains the in-flight http requests, then stops the rpc server, which closes the
+// websocket connections and their subscriptions.
+func (s *authServer) Stop() error {
+	var err error
+	if s.httpSrv != nil {
+		err = shutdownHTTP(s.httpSrv)
+	}
+	s.srv.Stop()
+	return err
+}
@@ -2,12 +2,15 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -25,13 +28,18 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/replica"
 	ethapi2 "github.com/artela-network/artela/ethereum/rpc/ethapi"
+	"github.com/artela-network/artela/ethereum/rpc/feestats"
 	"github.com/artela-network/artela/ethereum/rpc/filters"
+	"github.com/artela-network/artela/ethereum/rpc/policy"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/server/config"
 	"github.com/artela-network/artela/ethereum/types"
 	ethereumtypes "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/states"
 	"github.com/artela-network/artela/x/evm/txs"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
 	feetypes "github.com/artela-network/artela/x/fee/types"
 )
 
@@ -59,10 +67,39 @@ type BackendImpl struct {
 	rmLogsFeed      event.Feed
 	chainSideFeed   event.Feed
 	newTxsFeed      event.Feed
+	txStatusFeed    event.Feed
+	proposalFeed    event.Feed
 
 	ctx         context.Context
 	clientCtx   client.Context
 	queryClient *rpctypes.QueryClient
+	indexer     ethereumtypes.EVMTxIndexer
+	txPolicy    *policy.TxPolicy
+	// traceSnapshots runs the traces against the state snapshots, nil if the app doesn't
+	// support it and the traces are queried over gRPC.
+	traceSnapshots rpctypes.TraceSnapshots
+	// feeStats tracks the rolling fee stats, nil if the fee stats are disabled.
+	feeStats *feestats.Tracker
+
+	faucetMu      sync.Mutex
+	faucetSeq     uint64
+	faucetSeqUsed bool
+
+	unlockMu sync.Mutex
+	// unlocked is the expiry of the unlocked accounts, zero if unlocked indefinitely.
+	unlocked map[common.Address]time.Time
+
+	// localTxs is the journal of the pending txs submitted through the node.
+	localTxs *localTxs
+	// callCache caches the eth_call results at fixed heights, nil if it's disabled.
+	callCache *callCache
+	// respCache caches the responses of the committed blocks, nil if it's disabled.
+	respCache *responseCache
+
+	syncMu sync.Mutex
+	// syncStart is the latest block when the node was first seen catching up, zero if
+	// the node is caught up.
+	syncStart int64
 }
 
 // NewBackend create the backend instance
@@ -73,6 +110,7 @@ func NewBackend(
 	extRPCEnabled bool,
 	cfg *Config,
 	logger log.Logger,
+	indexer ethereumtypes.EVMTxIndexer,
 ) *BackendImpl {
 	b := &BackendImpl{
 		ctx:           context.Background(),
@@ -82,6 +120,9 @@ func NewBackend(
 		logger:        logger,
 		clientCtx:     clientCtx,
 		queryClient:   rpctypes.NewQueryClient(clientCtx),
+		indexer:       indexer,
+		unlocked:      make(map[common.Address]time.Time),
+		localTxs:      newLocalTxs(),
 
 		scope: event.SubscriptionScope{},
 	}
@@ -97,20 +138,100 @@ func NewBackend(
 		panic(err)
 	}
 
+	b.txPolicy, err = policy.NewTxPolicy(logger, b.appConf.JSONRPC.AllowAddresses, b.appConf.JSONRPC.DenyAddresses)
+	if err != nil {
+		panic(err)
+	}
+
 	if cfg.GPO.Default == nil {
 		panic("cfg.GPO.Default is nil")
 	}
 	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+
+	feeStatsWindows, err := b.appConf.JSONRPC.ParseFeeStatsWindows()
+	if err != nil {
+		panic(err)
+	}
+	if len(feeStatsWindows) > 0 {
+		b.feeStats = feestats.NewTracker(logger, b, feeStatsWindows)
+	}
+
+	if b.appConf.JSONRPC.CallCacheSize > 0 {
+		b.callCache = newCallCache(b.appConf.JSONRPC.CallCacheSize)
+	}
+	if b.appConf.JSONRPC.ResponseCacheSize > 0 {
+		b.respCache = newResponseCache(b.appConf.JSONRPC.ResponseCacheSize)
+	}
 	return b
 }
 
 // General Ethereum API
 
+// SyncProgress returns the progress of the block sync or the state sync of the CometBFT
+// node, the current block equals the highest block if the node is caught up.
 func (b *BackendImpl) SyncProgress() ethereum.SyncProgress {
+	status, err := b.NodeStatus()
+	if err != nil {
+		b.logger.Debug("failed to query node status", "error", err.Error())
+		return ethereum.SyncProgress{}
+	}
+
+	current := status.SyncInfo.LatestBlockHeight
+	b.syncMu.Lock()
+	defer b.syncMu.Unlock()
+	if !status.SyncInfo.CatchingUp {
+		b.syncStart = 0
+		return ethereum.SyncProgress{
+			CurrentBlock: uint64(current), // #nosec G701
+			HighestBlock: uint64(current), // #nosec G701
+		}
+	}
+
+	if b.syncStart == 0 {
+		b.syncStart = current
+	}
+	highest := b.peersHeight()
+	if highest <= current {
+		// the node is catching up, so the chain is ahead of it even if no peer has
+		// reported its height yet
+		highest = current + 1
+	}
 	return ethereum.SyncProgress{
-		CurrentBlock: 0,
-		HighestBlock: 0,
+		StartingBlock: uint64(b.syncStart), // #nosec G701
+		CurrentBlock:  uint64(current),     // #nosec G701
+		HighestBlock:  uint64(highest),     // #nosec G701
+	}
+}
+
+// peersHeight returns the highest block committed by the peers, from the round states of
+// the peers gossiped to the consensus reactor, zero if it's unknown.
+func (b *BackendImpl) peersHeight() int64 {
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return 0
+	}
+	res, err := nc.DumpConsensusState(b.ctx)
+	if err != nil {
+		b.logger.Debug("failed to dump consensus state", "error", err.Error())
+		return 0
+	}
+
+	var height int64
+	for _, peer := range res.Peers {
+		var peerState struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			continue
+		}
+		// the peer is working on the height, the block before it is committed
+		if committed := peerState.RoundState.Height - 1; committed > height {
+			height = committed
+		}
 	}
+	return height
 }
 
 func (b *BackendImpl) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
@@ -119,26 +240,24 @@ func (b *BackendImpl) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 		return big.NewInt(0), nil
 	}
 
-	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feetypes.QueryParamsRequest{})
+	// the oracle samples the effective priority fees of the recent blocks, the
+	// suggestion is cached until a new head block arrives.
+	tipCap, err := b.gpo.SuggestTipCap(b.ctx)
 	if err != nil {
 		return nil, err
 	}
-	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
-	// ```
-	// GasTarget = GasLimit / ElasticityMultiplier
-	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
-	// ```
-	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
-	// ```
-	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
-	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```t
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G701
-	if maxDelta < 0 {
-		// impossible if the parameter validation passed.
-		maxDelta = 0
+
+	// the txs paying less than the min priority fee are rejected by the ante handler
+	minPriorityFee := new(big.Int).SetUint64(b.appConf.EVM.MinPriorityFee)
+	if res, err := b.queryClient.FeeMarket.Params(b.ctx, &feetypes.QueryParamsRequest{}); err == nil {
+		if global := res.Params.MinPriorityFee.BigInt(); global.Cmp(minPriorityFee) > 0 {
+			minPriorityFee = global
+		}
 	}
-	return big.NewInt(maxDelta), nil
+	if tipCap.Cmp(minPriorityFee) < 0 {
+		return minPriorityFee, nil
+	}
+	return tipCap, nil
 }
 
 func (b *BackendImpl) ChainConfig() *params.ChainConfig {
@@ -151,17 +270,29 @@ func (b *BackendImpl) ChainConfig() *params.ChainConfig {
 	return params.Params.ChainConfig.EthereumConfig(b.chainID)
 }
 
+// FeeHistory returns the base fees, the gas used ratios and the reward percentiles of
+// the blockCount blocks up to lastBlock. The base fee of the block after lastBlock is
+// appended to the base fees, it's estimated if lastBlock is the latest block.
 func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	rewardPercentiles []float64,
 ) (*rpctypes.FeeHistoryResult, error) {
-	blockEnd := int64(lastBlock)
-
-	if blockEnd < 0 {
-		blockNumber, err := b.BlockNumber()
-		if err != nil {
-			return nil, err
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f > #%d:%f", i-1, rewardPercentiles[i-1], i, p)
 		}
-		blockEnd = int64(blockNumber)
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	blockEnd := int64(lastBlock)
+	if blockEnd < 0 || blockEnd > int64(latest) {
+		blockEnd = int64(latest)
 	}
 
 	blocks := int64(blockCount)
@@ -174,6 +305,13 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 		blocks = blockEnd + 1
 	}
 
+	if blocks == 0 {
+		return &rpctypes.FeeHistoryResult{
+			OldestBlock:  (*hexutil.Big)(new(big.Int)),
+			GasUsedRatio: []float64{},
+		}, nil
+	}
+
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
@@ -195,20 +333,20 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 			return nil, err
 		}
 
-		// eth block
-		ethBlock, err := b.GetBlockByNumber(rpc.BlockNumber(blockID), true)
-		if ethBlock == nil {
-			return nil, err
-		}
-
 		// tendermint block result
 		tendermintBlockResult, err := b.CosmosBlockResultByNumber(&tendermintblock.Block.Height)
 		if tendermintBlockResult == nil {
-			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err.Error())
+			b.logger.Debug("block result not found", "height", tendermintblock.Block.Height, "error", err)
 			return nil, err
 		}
 
-		oneFeeHistory, err := b.processBlock(tendermintblock, &ethBlock, rewardPercentiles, tendermintBlockResult)
+		// eth block
+		ethBlock, err := b.BlockFromCosmosBlock(tendermintblock, tendermintBlockResult)
+		if err != nil {
+			return nil, err
+		}
+
+		oneFeeHistory, err := b.processBlock(tendermintblock, ethBlock.Header(), rewardPercentiles, tendermintBlockResult)
 		if err != nil {
 			return nil, err
 		}
@@ -227,6 +365,16 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 		}
 	}
 
+	// the base fee of the next block is known if it's committed
+	if blockEnd < int64(latest) {
+		nextHeight := blockEnd + 1
+		if nextBlockResult, err := b.CosmosBlockResultByNumber(&nextHeight); err == nil {
+			if nextBaseFee, err := b.BaseFee(nextBlockResult); err == nil && nextBaseFee != nil {
+				thisBaseFee[blocks] = (*hexutil.Big)(nextBaseFee)
+			}
+		}
+	}
+
 	feeHistory := rpctypes.FeeHistoryResult{
 		OldestBlock:  oldestBlock,
 		BaseFee:      thisBaseFee,
@@ -240,6 +388,44 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	return &feeHistory, nil
 }
 
+// FeeSample returns the base fee, the median tip and the gas used ratio of the block at the
+// height, which are sampled by the fee stats tracker.
+func (b *BackendImpl) FeeSample(height int64) (*feestats.Sample, error) {
+	tendermintBlock, err := b.CosmosBlockByNumber(rpc.BlockNumber(height))
+	if tendermintBlock == nil {
+		if err == nil {
+			err = fmt.Errorf("block %d not found", height)
+		}
+		return nil, err
+	}
+
+	tendermintBlockResult, err := b.CosmosBlockResultByNumber(&tendermintBlock.Block.Height)
+	if tendermintBlockResult == nil {
+		if err == nil {
+			err = fmt.Errorf("block result %d not found", height)
+		}
+		return nil, err
+	}
+
+	ethBlock, err := b.BlockFromCosmosBlock(tendermintBlock, tendermintBlockResult)
+	if err != nil {
+		return nil, err
+	}
+
+	oneFeeHistory, err := b.processBlock(tendermintBlock, ethBlock.Header(), []float64{50}, tendermintBlockResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feestats.Sample{
+		Height:       height,
+		Time:         tendermintBlock.Block.Time,
+		BaseFee:      oneFeeHistory.BaseFee,
+		MedianTip:    oneFeeHistory.Reward[0],
+		GasUsedRatio: oneFeeHistory.GasUsedRatio,
+	}, nil
+}
+
 func (b *BackendImpl) ChainDb() ethdb.Database { //nolint:stylecheck // conforms to interface.
 	return ethdb.Database(nil)
 }
@@ -297,8 +483,20 @@ func (b *BackendImpl) SubscribePendingLogsEvent(ch chan<- []*ethtypes.Log) event
 	return b.scope.Track(b.pendingLogsFeed.Subscribe(ch))
 }
 
+// BloomStatus returns the number of the blocks of a bloom bits section and the number of
+// the sections indexed by the custom indexer.
 func (b *BackendImpl) BloomStatus() (uint64, uint64) {
-	return 0, 0
+	bloomIndexer, ok := b.indexer.(ethereumtypes.EVMBloomIndexer)
+	if !ok {
+		return params.BloomBitsBlocks, 0
+	}
+
+	sections, err := bloomIndexer.BloomSections()
+	if err != nil {
+		b.logger.Debug("failed to query the bloom sections", "error", err)
+		return params.BloomBitsBlocks, 0
+	}
+	return params.BloomBitsBlocks, sections
 }
 
 func (b *BackendImpl) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
@@ -306,12 +504,38 @@ func (b *BackendImpl) ServiceFilter(_ context.Context, _ *bloombits.MatcherSessi
 
 // artela rpc API
 
+// Listening returns if the P2P switch of the CometBFT node is listening for connections.
 func (b *BackendImpl) Listening() bool {
-	return true
+	netInfo, err := b.NetInfo()
+	if err != nil {
+		b.logger.Debug("failed to query the net info", "error", err)
+		return false
+	}
+	return netInfo.Listening
 }
 
+// PeerCount returns the number of the peers connected to the CometBFT node.
 func (b *BackendImpl) PeerCount() hexutil.Uint {
-	return 1
+	netInfo, err := b.NetInfo()
+	if err != nil {
+		b.logger.Debug("failed to query the net info", "error", err)
+		return 0
+	}
+	return hexutil.Uint(netInfo.NPeers)
+}
+
+// NetInfo returns the P2P status and the peers of the CometBFT node.
+func (b *BackendImpl) NetInfo() (*tmrpctypes.ResultNetInfo, error) {
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+	return nc.NetInfo(b.ctx)
+}
+
+// NodeStatus returns the status of the CometBFT node.
+func (b *BackendImpl) NodeStatus() (*tmrpctypes.ResultStatus, error) {
+	return b.clientCtx.Client.Status(b.ctx)
 }
 
 // ClientVersion returns the current client version.
@@ -353,8 +577,28 @@ func (b *BackendImpl) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int
 	return res.BaseFee.BigInt(), nil
 }
 
+// PendingTransactions returns the transactions in the CometBFT mempool.
 func (b *BackendImpl) PendingTransactions() ([]*sdktypes.Tx, error) {
-	return nil, errors.New("PendingTransactions is not implemented")
+	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*sdktypes.Tx, 0, len(res.Txs))
+	for _, txBz := range res.Txs {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &tx)
+	}
+
+	return result, nil
 }
 
 func (b *BackendImpl) GasPrice(ctx context.Context) (*hexutil.Big, error) {
@@ -424,3 +668,91 @@ func (b *BackendImpl) RPCFilterCap() int32 {
 func (b *BackendImpl) RPCLogsCap() int32 {
 	return b.appConf.JSONRPC.LogsCap
 }
+
+// GetPreimage returns the SHA3 preimage of hash recorded by the EVM.
+func (b *BackendImpl) GetPreimage(hash common.Hash) ([]byte, error) {
+	if b.cfg.PreimageDB == nil {
+		return nil, errors.New("preimage recording is not enabled, set evm.enable-preimage-recording")
+	}
+	preimage, err := b.cfg.PreimageDB.Get(hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if preimage == nil {
+		return nil, errors.New("unknown preimage")
+	}
+	return preimage, nil
+}
+
+// BlockAccessStats returns the storage access counters of the contracts in the block.
+func (b *BackendImpl) BlockAccessStats(height int64) (states.AccessStats, error) {
+	if b.cfg.AccessStatsDB == nil {
+		return nil, errors.New("access stats recording is not enabled, set evm.enable-access-stats")
+	}
+	bz, err := b.cfg.AccessStatsDB.Get(states.AccessStatsKey(height))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var stats states.AccessStats
+	if err := json.Unmarshal(bz, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// BlockWitness returns the execution witness of the block, nil if nothing is read by the EVM.
+func (b *BackendImpl) BlockWitness(height int64) (*states.Witness, error) {
+	if b.cfg.WitnessDB == nil {
+		return nil, errors.New("witness recording is not enabled, set evm.enable-witness")
+	}
+	bz, err := b.cfg.WitnessDB.Get(states.WitnessKey(height))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var witness states.Witness
+	if err := json.Unmarshal(bz, &witness); err != nil {
+		return nil, err
+	}
+	return &witness, nil
+}
+
+// ContractCreation returns the indexed creation of the contract, nil if it's not indexed.
+func (b *BackendImpl) ContractCreation(address common.Address) (*evmtypes.ContractCreation, error) {
+	if b.cfg.ContractIndexDB == nil {
+		return nil, errors.New("contract indexing is not enabled, set evm.enable-contract-index")
+	}
+	bz, err := b.cfg.ContractIndexDB.Get(evmtypes.ContractCreationKey(address))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var creation evmtypes.ContractCreation
+	if err := json.Unmarshal(bz, &creation); err != nil {
+		return nil, err
+	}
+	return &creation, nil
+}
+
+// StateChangeSet returns the state changes committed in the block, nil if the block isn't
+// recorded by the state feed.
+func (b *BackendImpl) StateChangeSet(height int64) (*replica.ChangeSet, error) {
+	if b.cfg.StateFeed == nil {
+		return nil, errors.New("state feed is not enabled, set json-rpc.enable-state-feed")
+	}
+	return replica.LoadChangeSet(b.cfg.StateFeed.DB(), height)
+}
+
+// LatestStateChangeSet returns the latest height recorded by the state feed.
+func (b *BackendImpl) LatestStateChangeSet() (int64, error) {
+	if b.cfg.StateFeed == nil {
+		return 0, errors.New("state feed is not enabled, set json-rpc.enable-state-feed")
+	}
+	return replica.LatestHeight(b.cfg.StateFeed.DB())
+}
+
+// SubscribeStateChangeSets subscribes to the heights of the blocks recorded by the state feed.
+func (b *BackendImpl) SubscribeStateChangeSets(ch chan<- int64) event.Subscription {
+	return b.scope.Track(b.cfg.StateFeed.Subscribe(ch))
+}
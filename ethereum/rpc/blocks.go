@@ -9,8 +9,10 @@ import (
 	"math/big"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/artela-network/artela-evm/vm"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -32,6 +34,8 @@ import (
 	"github.com/artela-network/artela/ethereum/rpc/ethapi"
 	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/rpc/utils"
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/txs"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
@@ -42,38 +46,55 @@ func (b *BackendImpl) SetHead(_ uint64) {
 	b.logger.Error("SetHead is not implemented")
 }
 
-func (b *BackendImpl) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ethtypes.Header, error) {
-	resBlock, err := b.CosmosBlockByNumber(number)
-	if err != nil {
-		return nil, err
+func (b *BackendImpl) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*ethtypes.Header, error) {
+	header, _, err := b.ArtHeaderByNumber(ctx, number)
+	return header, err
+}
+
+// ArtHeaderByNumber returns the header of the block and the block hash, the txs of the
+// block are only decoded for the txs root instead of being converted into a full block.
+func (b *BackendImpl) ArtHeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ethtypes.Header, common.Hash, error) {
+	if number == rpc.PendingBlockNumber {
+		block, _, err := b.pendingBlock()
+		if err != nil {
+			return nil, common.Hash{}, err
+		}
+		return block.Header(), block.Hash(), nil
 	}
 
-	if resBlock == nil {
-		return nil, fmt.Errorf("block not found for height %d", number)
+	resBlock, err := b.CosmosBlockByNumber(number)
+	var prunedErr *rpctypes.PrunedError
+	if errors.As(err, &prunedErr) {
+		return nil, common.Hash{}, prunedErr
+	}
+	if err != nil || resBlock == nil {
+		return nil, common.Hash{}, fmt.Errorf("block not found for height %d", number)
 	}
 
 	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if errors.As(err, &prunedErr) {
+		return nil, common.Hash{}, prunedErr
+	}
 	if err != nil {
-		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+		return nil, common.Hash{}, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
 
-	bloom, err := b.blockBloom(blockRes)
-	if err != nil {
-		b.logger.Debug("HeaderByNumber BlockBloom failed", "height", resBlock.Block.Height)
+	return b.headerFromCosmosBlock(resBlock, blockRes, b.ethTxsFromCosmosBlock(resBlock, blockRes)),
+		common.BytesToHash(resBlock.Block.Hash().Bytes()), nil
+}
+
+func (b *BackendImpl) HeaderByHash(_ context.Context, hash common.Hash) (*ethtypes.Header, error) {
+	resBlock, err := b.CosmosBlockByHash(hash)
+	if err != nil || resBlock == nil {
+		return nil, fmt.Errorf("failed to get block by hash %s, %w", hash.Hex(), err)
 	}
 
-	baseFee, err := b.BaseFee(blockRes)
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
-		// handle the error for pruned node.
-		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", resBlock.Block.Height, "error", err)
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
 
-	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
-	return ethHeader, nil
-}
-
-func (b *BackendImpl) HeaderByHash(_ context.Context, hash common.Hash) (*ethtypes.Header, error) {
-	return nil, nil
+	return b.headerFromCosmosBlock(resBlock, blockRes, b.ethTxsFromCosmosBlock(resBlock, blockRes)), nil
 }
 
 func (b *BackendImpl) HeaderByNumberOrHash(ctx context.Context,
@@ -109,12 +130,24 @@ func (b *BackendImpl) BlockByNumber(_ context.Context, number rpc.BlockNumber) (
 }
 
 func (b *BackendImpl) ArtBlockByNumber(_ context.Context, number rpc.BlockNumber) (*rpctypes.Block, error) {
+	if number == rpc.PendingBlockNumber {
+		block, _, err := b.pendingBlock()
+		return block, err
+	}
+
 	resBlock, err := b.CosmosBlockByNumber(number)
+	var prunedErr *rpctypes.PrunedError
+	if errors.As(err, &prunedErr) {
+		return nil, prunedErr
+	}
 	if err != nil || resBlock == nil {
 		return nil, fmt.Errorf("query block failed, block number %d, %w", number, err)
 	}
 
 	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if errors.As(err, &prunedErr) {
+		return nil, prunedErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -137,7 +170,13 @@ func (b *BackendImpl) BlockByHash(_ context.Context, hash common.Hash) (*rpctype
 }
 
 func (b *BackendImpl) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*rpctypes.Block, error) {
-	return nil, errors.New("BlockByNumberOrHash is not implemented")
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		return b.BlockByHash(ctx, hash)
+	}
+	if number, ok := blockNrOrHash.Number(); ok {
+		return b.ArtBlockByNumber(ctx, number)
+	}
+	return nil, errors.New("invalid arguments; neither block nor hash specified")
 }
 
 func (b *BackendImpl) StateAndHeaderByNumber(
@@ -152,14 +191,30 @@ func (b *BackendImpl) StateAndHeaderByNumberOrHash(
 	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
 }
 
+// PendingBlockAndReceipts returns the pending block assembled from the mempool txs, there are
+// no receipts as the txs of the pending block are not executed.
 func (b *BackendImpl) PendingBlockAndReceipts() (*ethtypes.Block, types.Receipts) {
-	b.logger.Error("PendingBlockAndReceipts is not implemented")
-	return nil, nil
+	block, _, err := b.pendingBlock()
+	if err != nil {
+		b.logger.Error("failed to assemble the pending block", "error", err)
+		return nil, nil
+	}
+	return block.EthBlock(), nil
 }
 
 // GetReceipts get receipts by block hash
 func (b *BackendImpl) GetReceipts(_ context.Context, hash common.Hash) (types.Receipts, error) {
-	return nil, errors.New("GetReceipts is not implemented")
+	resBlock, err := b.CosmosBlockByHash(hash)
+	if err != nil || resBlock == nil {
+		return nil, fmt.Errorf("failed to get block by hash %s, %w", hash.Hex(), err)
+	}
+
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	return b.ethReceiptsFromCosmosBlock(resBlock, blockRes)
 }
 
 func (b *BackendImpl) GetTd(_ context.Context, hash common.Hash) *big.Int {
@@ -190,6 +245,12 @@ func (b *BackendImpl) SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) eve
 }
 
 func (b *BackendImpl) CosmosBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	if b.respCache != nil {
+		if resBlock, ok := b.respCache.blockByHash(blockHash); ok {
+			return resBlock, nil
+		}
+	}
+
 	resBlock, err := b.clientCtx.Client.BlockByHash(b.ctx, blockHash.Bytes())
 	if err != nil {
 		return nil, err
@@ -199,9 +260,34 @@ func (b *BackendImpl) CosmosBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, fmt.Errorf("failed to query block for hash: %s", blockHash.Hex())
 	}
 
+	if b.respCache != nil {
+		b.respCache.addBlock(resBlock)
+	}
 	return resBlock, nil
 }
 
+// BlockNumberByHash resolves the height of the block with the given hash through the block
+// hash index of cometbft, only the header of the block is loaded if the client supports it.
+func (b *BackendImpl) BlockNumberByHash(blockHash common.Hash) (int64, error) {
+	sc, ok := b.clientCtx.Client.(tmrpcclient.SignClient)
+	if !ok {
+		resBlock, err := b.CosmosBlockByHash(blockHash)
+		if err != nil {
+			return 0, err
+		}
+		return resBlock.Block.Height, nil
+	}
+
+	res, err := sc.HeaderByHash(b.ctx, blockHash.Bytes())
+	if err != nil {
+		return 0, err
+	}
+	if res.Header == nil {
+		return 0, fmt.Errorf("failed to query block for hash: %s", blockHash.Hex())
+	}
+	return res.Header.Height, nil
+}
+
 func (b *BackendImpl) CosmosBlockByNumber(blockNum rpc.BlockNumber) (*tmrpctypes.ResultBlock, error) {
 	height := blockNum.Int64()
 	if height <= 0 {
@@ -212,15 +298,24 @@ func (b *BackendImpl) CosmosBlockByNumber(blockNum rpc.BlockNumber) (*tmrpctypes
 		}
 		height = int64(n) // #nosec G701 -- checked for int overflow already
 	}
+	if b.respCache != nil {
+		if resBlock, ok := b.respCache.block(height); ok {
+			return resBlock, nil
+		}
+	}
+
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &height)
 	if err != nil {
-		return nil, err
+		return nil, b.prunedError(height, err)
 	}
 
 	if resBlock.Block == nil {
 		return nil, fmt.Errorf("failed to query block for blockNum: %d", blockNum.Int64())
 	}
 
+	if b.respCache != nil {
+		b.respCache.addBlock(resBlock)
+	}
 	return resBlock, nil
 }
 
@@ -236,7 +331,7 @@ func (b *BackendImpl) blockNumberFromCosmos(blockNrOrHash rpc.BlockNumberOrHash)
 		}
 		return rpc.BlockNumber(resBlock.Block.Height), nil
 	case blockNrOrHash.BlockNumber != nil:
-		if *blockNrOrHash.BlockNumber == rpc.LatestBlockNumber {
+		if rpctypes.IsLatestBlockTag(*blockNrOrHash.BlockNumber) {
 			currentHeight := b.CurrentHeader().Number
 			return rpc.BlockNumber(currentHeight.Int64()), nil
 		}
@@ -274,17 +369,85 @@ func (b *BackendImpl) BlockNumber() (hexutil.Uint64, error) {
 func (b *BackendImpl) BlockTimeByNumber(blockNum int64) (uint64, error) {
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &blockNum)
 	if err != nil {
-		return 0, err
+		return 0, b.prunedError(blockNum, err)
 	}
 	return uint64(resBlock.Block.Time.Unix()), nil
 }
 
 func (b *BackendImpl) CosmosBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
-	return b.clientCtx.Client.BlockResults(b.ctx, height)
+	// the results of the latest block are only cached by the height it's resolved to
+	cacheable := b.respCache != nil && height != nil && *height > 0
+	if cacheable {
+		if res, ok := b.respCache.blockResult(*height); ok {
+			return res, nil
+		}
+	}
+
+	res, err := b.clientCtx.Client.BlockResults(b.ctx, height)
+	if err != nil && height != nil {
+		return nil, b.prunedError(*height, err)
+	}
+	if err == nil && b.respCache != nil {
+		b.respCache.addBlockResult(res)
+	}
+	return res, err
 }
 
-func (b *BackendImpl) GetCode(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+// EarliestBlockNumber returns the earliest block height still available on the node.
+func (b *BackendImpl) EarliestBlockNumber() (int64, error) {
+	status, err := b.clientCtx.Client.Status(b.ctx)
+	if err != nil {
+		return 0, err
+	}
+	if b.respCache != nil {
+		// the cached responses of the pruned blocks are dropped
+		b.respCache.prune(status.SyncInfo.EarliestBlockHeight)
+	}
+	return status.SyncInfo.EarliestBlockHeight, nil
+}
+
+// stateNotFoundError is the message of the error of the queries against a state version
+// which is pruned, or not committed by the node as it started from a state sync snapshot.
+const stateNotFoundError = "failed to load state at height"
+
+// prunedError converts the error of a block or state query into a PrunedError if the
+// height is below the earliest height retained by the node, or if the state at the
+// height has been pruned.
+func (b *BackendImpl) prunedError(height int64, err error) error {
+	// the latest and pending states are never pruned
+	if height <= 0 {
+		return err
+	}
+	earliest, statusErr := b.EarliestBlockNumber()
+	if statusErr == nil && height < earliest {
+		return rpctypes.NewPrunedError(height, earliest)
+	}
+	if strings.Contains(err.Error(), stateNotFoundError) {
+		return rpctypes.NewStatePrunedError(height)
+	}
+	return err
+}
+
+// stateHeight resolves the height of the state queried at the given block, the earliest
+// tag is resolved to the earliest block retained by the node. The state of any height can
+// be queried as long as it's not pruned, i.e. all the heights on an archive node with
+// the pruning of the app state disabled.
+func (b *BackendImpl) stateHeight(blockNrOrHash rpc.BlockNumberOrHash) (int64, error) {
 	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+
+	if blockNum == rpc.EarliestBlockNumber {
+		return b.EarliestBlockNumber()
+	}
+	return blockNum.Int64(), nil
+}
+
+// GetCode returns the contract code at the given address and block, the state of any
+// height retained by the node can be queried.
+func (b *BackendImpl) GetCode(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	height, err := b.stateHeight(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
@@ -293,9 +456,9 @@ func (b *BackendImpl) GetCode(address common.Address, blockNrOrHash rpc.BlockNum
 		Address: address.String(),
 	}
 
-	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(height), req)
 	if err != nil {
-		return nil, err
+		return nil, b.prunedError(height, err)
 	}
 
 	return res.Code, nil
@@ -303,7 +466,7 @@ func (b *BackendImpl) GetCode(address common.Address, blockNrOrHash rpc.BlockNum
 
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *BackendImpl) GetStorageAt(address common.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
-	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	height, err := b.stateHeight(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
@@ -313,32 +476,110 @@ func (b *BackendImpl) GetStorageAt(address common.Address, key string, blockNrOr
 		Key:     key,
 	}
 
-	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(height), req)
 	if err != nil {
-		return nil, err
+		return nil, b.prunedError(height, err)
 	}
 
 	value := common.HexToHash(res.Value)
 	return value.Bytes(), nil
 }
 
+// AccountRange dumps at most maxResults accounts at the given block from the start address,
+// in the order of the raw addresses, the root of the dump is the state root of the block.
+func (b *BackendImpl) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, start []byte, maxResults int, noCode, noStorage bool) (*state.IteratorDump, error) {
+	height, err := b.stateHeight(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	header, _, err := b.ArtHeaderByNumber(b.ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAccountRangeRequest{
+		Start:      start,
+		MaxResults: uint64(maxResults), // #nosec G701
+		NoCode:     noCode,
+		NoStorage:  noStorage,
+	}
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(height), req)
+	if err != nil {
+		return nil, b.prunedError(height, err)
+	}
+
+	var dump state.IteratorDump
+	if err := json.Unmarshal(res.Data, &dump); err != nil {
+		return nil, err
+	}
+	dump.Root = fmt.Sprintf("%x", header.Root)
+	return &dump, nil
+}
+
+// AspectMetadata returns the metadata of the aspect at the given block, nil if the aspect
+// is not deployed.
+func (b *BackendImpl) AspectMetadata(aspectId common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*artelatypes.AspectMetadata, error) {
+	height, err := b.stateHeight(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &txs.QueryAspectMetadataRequest{
+		AspectId: aspectId.Hex(),
+	}
+
+	res, err := b.queryClient.AspectMetadata(rpctypes.ContextWithHeight(height), req)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, b.prunedError(height, err)
+	}
+
+	var metadata artelatypes.AspectMetadata
+	if err := json.Unmarshal(res.Data, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
 // BlockBloom query block bloom filter from block results
 func (b *BackendImpl) blockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
-	for _, event := range blockRes.EndBlockEvents {
-		if event.Type != evmtypes.EventTypeBlockBloom {
-			continue
-		}
-
-		for _, attr := range event.Attributes {
-			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
-				return ethtypes.BytesToBloom([]byte(attr.Value)), nil
-			}
-		}
+	if bloom, ok := utils.BlockBloomFromBlockResults(blockRes); ok {
+		return bloom, nil
 	}
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
 func (b *BackendImpl) BlockFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*rpctypes.Block, error) {
+	msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
+	txs := make(ethtypes.Transactions, len(msgs))
+	for i, ethMsg := range msgs {
+		txs[i] = ethMsg.AsTransaction()
+	}
+	ethHeader := b.headerFromCosmosBlock(resBlock, blockRes, txs)
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash().Bytes())
+	receipts, err := b.ethReceiptsFromCosmosBlock(resBlock, blockRes)
+	if err != nil {
+		b.logger.Debug(fmt.Sprintf("failed to fetch receipts, block hash %s, block number %d", blockHash.Hex(), resBlock.Block.Height))
+	}
+
+	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, receipts, trie.NewStackTrie(nil))
+	res := rpctypes.EthBlockToBlock(ethBlock)
+	res.SetHash(blockHash)
+	for i, ethMsg := range msgs {
+		// the system txs are unsigned, their senders are set in the msgs
+		if ethMsg.From != "" {
+			res.SetSender(txs[i].Hash(), common.HexToAddress(ethMsg.From))
+		}
+	}
+	return res, nil
+}
+
+// headerFromCosmosBlock builds the ethereum header of the block, the txs root is derived
+// from the given ethereum txs of the block, the same as the root of the full block.
+func (b *BackendImpl) headerFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults, txs ethtypes.Transactions) *ethtypes.Header {
 	block := resBlock.Block
 	height := block.Height
 	bloom, err := b.blockBloom(blockRes)
@@ -352,11 +593,9 @@ func (b *BackendImpl) BlockFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blo
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
-	msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
-
-	txs := make([]*ethtypes.Transaction, len(msgs))
-	for i, ethMsg := range msgs {
-		txs[i] = ethMsg.AsTransaction()
+	ethHeader.TxHash = ethtypes.EmptyTxsHash
+	if len(txs) > 0 {
+		ethHeader.TxHash = ethtypes.DeriveSha(txs, trie.NewStackTrie(nil))
 	}
 
 	gasUsed := uint64(0)
@@ -370,25 +609,41 @@ func (b *BackendImpl) BlockFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blo
 	}
 	ethHeader.GasUsed = gasUsed
 
-	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(context.Background(), b.clientCtx, block.Height)
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(context.Background(), b.clientCtx, height)
 	if err != nil {
 		b.logger.Error("failed to query consensus params", "error", err.Error())
 	}
 	ethHeader.GasLimit = uint64(gasLimit)
+	return ethHeader
+}
+
+func (b *BackendImpl) ethTxsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) ethtypes.Transactions {
+	msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
+
+	txs := make(ethtypes.Transactions, len(msgs))
+	for i, ethMsg := range msgs {
+		txs[i] = ethMsg.AsTransaction()
+	}
+	return txs
+}
 
-	blockHash := common.BytesToHash(block.Hash().Bytes())
-	receipts, err := b.GetReceipts(context.Background(), blockHash)
+// EthMsgsFromCosmosBlock returns the eth txs of the block in the order of their eth tx indexes,
+// the system txs applied by the begin blockers first, then the eth txs of the cosmos txs, then
+// the system txs applied by the end blockers. The senders of the system txs are set in the msgs.
+func (b *BackendImpl) EthMsgsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*txs.MsgEthereumTx {
+	beginTxs, endTxs, err := rpctypes.BlockSystemTxs(blockRes)
 	if err != nil {
-		b.logger.Debug(fmt.Sprintf("failed to fetch receipts, block hash %s, block number %d", blockHash.Hex(), height))
+		b.logger.Debug("failed to parse system txs in block", "height", resBlock.Block.Height, "error", err.Error())
+		beginTxs, endTxs = nil, nil
 	}
 
-	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, receipts, trie.NewStackTrie(nil))
-	res := rpctypes.EthBlockToBlock(ethBlock)
-	res.SetHash(blockHash)
-	return res, nil
+	result := b.systemTxMsgs(beginTxs)
+	result = append(result, b.ethMsgsFromCosmosTxs(resBlock, blockRes)...)
+	return append(result, b.systemTxMsgs(endTxs)...)
 }
 
-func (b *BackendImpl) EthMsgsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*txs.MsgEthereumTx {
+// ethMsgsFromCosmosTxs returns the eth txs of the cosmos txs of the block, without the system txs.
+func (b *BackendImpl) ethMsgsFromCosmosTxs(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*txs.MsgEthereumTx {
 	var result []*txs.MsgEthereumTx
 	block := resBlock.Block
 
@@ -423,17 +678,68 @@ func (b *BackendImpl) EthMsgsFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, b
 	return result
 }
 
-func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error) {
-	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+// cosmosEthMsgs returns the eth txs of the cosmos txs of the block, and the eth tx index of
+// the first one, which follows the system txs of the begin blockers.
+func (b *BackendImpl) cosmosEthMsgs(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) ([]*txs.MsgEthereumTx, int) {
+	beginTxs, err := rpctypes.ParseSystemTxs(blockRes.BeginBlockEvents)
+	if err != nil {
+		b.logger.Debug("failed to parse system txs in block", "height", resBlock.Block.Height, "error", err.Error())
+	}
+	return b.ethMsgsFromCosmosTxs(resBlock, blockRes), len(beginTxs)
+}
+
+// systemTxMsgs returns the system txs as the eth txs msgs with their senders set.
+func (b *BackendImpl) systemTxMsgs(systemTxs []*rpctypes.SystemTx) []*txs.MsgEthereumTx {
+	msgs := make([]*txs.MsgEthereumTx, 0, len(systemTxs))
+	for _, systemTx := range systemTxs {
+		msg, err := systemTx.Msg()
+		if err != nil {
+			b.logger.Debug("failed to convert system tx", "hash", systemTx.Tx.Hash().Hex(), "error", err.Error())
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides) (*txs.MsgEthereumTxResponse, error) {
+	height, err := b.stateHeight(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
+	blockNum := rpc.BlockNumber(height)
+
+	// the call against the pending block is run on top of the pending txs, in the context
+	// of the pending block unless it's overridden
+	var pending []*txs.MsgEthereumTx
+	if blockNum == rpc.PendingBlockNumber {
+		block, msgs, err := b.pendingBlock()
+		if err != nil {
+			return nil, err
+		}
+		pending = msgs
+		blockOverrides = pendingCallOverrides(block, blockOverrides)
+	}
 
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return nil, err
+	}
+	var blockOverridesBz []byte
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
 	header, err := b.CosmosBlockByNumber(blockNum)
+	var prunedErr *rpctypes.PrunedError
+	if errors.As(err, &prunedErr) {
+		return nil, prunedErr
+	}
 	if err != nil {
 		// the error message imitates geth behavior
 		return nil, errors.New("header not found")
@@ -444,6 +750,21 @@ func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.Bloc
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
+		Pending:         pending,
+	}
+
+	// the calls at the fixed heights are cached, the pending state changes with the mempool
+	var cacheKey common.Hash
+	cacheable := b.callCache != nil && blockNum != rpc.PendingBlockNumber && height > 0
+	if cacheable {
+		if cacheKey, err = callCacheKey(height, &req); err != nil {
+			return nil, err
+		}
+		if res, ok := b.callCache.get(cacheKey); ok {
+			return callResult(res)
+		}
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -467,25 +788,235 @@ func (b *BackendImpl) DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.Bloc
 
 	res, err := b.queryClient.EthCall(ctx, &req)
 	if err != nil {
-		return nil, err
+		return nil, queryError(b.prunedError(height, err))
+	}
+	if cacheable {
+		b.callCache.add(cacheKey, res)
 	}
 
+	return callResult(res)
+}
+
+// callResult returns the error of the failed call, the reverted call returns the revert reason.
+func callResult(res *txs.MsgEthereumTxResponse) (*txs.MsgEthereumTxResponse, error) {
 	if res.Failed() {
-		if res.VmError != vm.ErrExecutionReverted.Error() {
-			return nil, status.Error(codes.Internal, res.VmError)
-		}
-		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
+		return nil, execError(res.VmError, res.Revert())
 	}
 
 	return res, nil
 }
 
+// execError returns the error of a failed execution like geth, a revert with data is a
+// json-rpc error of code 3 carrying the revert data, which wallets decode into the revert
+// reason, the other failures are returned as plain errors.
+func execError(vmError string, revert []byte) error {
+	if len(revert) > 0 {
+		return evmtypes.NewExecErrorWithReason(revert)
+	}
+	return errors.New(vmError)
+}
+
+// Simulate simulates the blocks of calls of eth_simulateV1 on top of the given block, the
+// simulation against the pending block is run on top of the pending txs.
+func (b *BackendImpl) Simulate(opts txs.SimulateOptions, blockNrOrHash rpc.BlockNumberOrHash) ([]*txs.SimulateBlockResult, error) {
+	height, err := b.stateHeight(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	blockNum := rpc.BlockNumber(height)
+
+	var pending []*txs.MsgEthereumTx
+	if blockNum == rpc.PendingBlockNumber {
+		if _, pending, err = b.pendingBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CosmosBlockByNumber(blockNum)
+	var prunedErr *rpctypes.PrunedError
+	if errors.As(err, &prunedErr) {
+		return nil, prunedErr
+	}
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := txs.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Pending:         pending,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.EthSimulate(ctx, &req)
+	if err != nil {
+		return nil, queryError(b.prunedError(height, err))
+	}
+
+	var results []*txs.SimulateBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// marshalStateOverride marshals the state overrides of a call into the json format of
+// the query requests, nil if there is none.
+func marshalStateOverride(overrides *ethapi.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(overrides)
+}
+
+// queryError strips the gRPC status of the invalid argument errors of the evm query server,
+// e.g. "gas cap exceeded", so the JSON-RPC clients get the plain message as from geth, the
+// codespace, code and data of the error are served as the JSON-RPC error data.
+func queryError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if _, ok := evmtypes.StatusErrorInfo(st); ok || st.Code() == codes.InvalidArgument {
+		return rpctypes.NewQueryError(st)
+	}
+	return err
+}
+
 func (b *BackendImpl) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
 	return b.blockBloom(blockRes)
 }
 
-func (b *BackendImpl) GetBlockByNumber(blockNum rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
-	block, err := b.BlockByNumber(context.Background(), blockNum)
+// ExecutionDigest returns the execution digest of the block emitted by the evm end blocker,
+// nil if the block was produced before the digest was introduced.
+func (b *BackendImpl) ExecutionDigest(blockNrOrHash rpc.BlockNumberOrHash) (*rpctypes.ExecutionDigest, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	digest, found, err := utils.ExecutionDigestFromBlockResults(blockRes)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return &rpctypes.ExecutionDigest{
+		BlockNumber:  hexutil.Uint64(resBlock.Block.Height),
+		BlockHash:    common.BytesToHash(resBlock.Block.Hash().Bytes()),
+		Digest:       digest.Digest,
+		Transactions: hexutil.Uint64(digest.Txs),
+		GasUsed:      hexutil.Uint64(digest.GasUsed),
+	}, nil
+}
+
+// BlockCheckpoint returns the hash, receipt root and bloom of the block at the height,
+// the values are the same as the ones of the block served by eth_getBlockByNumber.
+func (b *BackendImpl) BlockCheckpoint(height int64) (*rpctypes.Checkpoint, error) {
+	resBlock, err := b.CosmosBlockByNumber(rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	bloom, err := b.blockBloom(blockRes)
+	if err != nil {
+		b.logger.Debug("BlockCheckpoint BlockBloom failed", "height", height)
+	}
+
+	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, nil)
+	return &rpctypes.Checkpoint{
+		Number:      uint64(resBlock.Block.Height),
+		Hash:        common.BytesToHash(resBlock.Block.Hash().Bytes()),
+		ReceiptHash: ethHeader.ReceiptHash,
+		Bloom:       ethHeader.Bloom,
+	}, nil
+}
+
+// BlocksByLogTopics returns the ascending numbers of the blocks in [from, to] which contain a
+// log of one of the addresses with one of the topics0, looked up in the log index of the
+// indexer. The log index covers the blocks up to indexedTo, ok is false if it can't serve
+// the range.
+func (b *BackendImpl) BlocksByLogTopics(addresses []common.Address, topics0 []common.Hash, from, to int64) (heights []int64, indexedTo int64, ok bool, err error) {
+	logIndexer, isLogIndexer := b.indexer.(ethereumtypes.EVMLogIndexer)
+	if !isLogIndexer {
+		return nil, 0, false, nil
+	}
+
+	start, err := logIndexer.LogIndexStart()
+	if err != nil || start == -1 || start > from {
+		return nil, 0, false, err
+	}
+	indexedTo, err = b.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if indexedTo > to {
+		indexedTo = to
+	}
+
+	seen := make(map[int64]struct{})
+	for _, address := range addresses {
+		for _, topic0 := range topics0 {
+			found, err := logIndexer.BlocksByLogTopic(address, topic0, from, indexedTo)
+			if err != nil {
+				return nil, 0, false, err
+			}
+			for _, height := range found {
+				if _, ok := seen[height]; !ok {
+					seen[height] = struct{}{}
+					heights = append(heights, height)
+				}
+			}
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, indexedTo, true, nil
+}
+
+// BlocksByBloomBits returns the blocks in [from, to] whose blooms match the addresses and the
+// topics, looked up in the bloom bits of the custom indexer, and the last block covered by the
+// bloom bits. It returns false if the indexer doesn't cover from.
+func (b *BackendImpl) BlocksByBloomBits(addresses []common.Address, topics [][]common.Hash, from, to int64) ([]int64, int64, bool, error) {
+	bloomIndexer, ok := b.indexer.(ethereumtypes.EVMBloomIndexer)
+	if !ok {
+		return nil, 0, false, nil
+	}
+	return bloomIndexer.BlocksByBloomBits(addresses, topics, from, to)
+}
+
+// GetBlockByNumber returns the ethereum JSON header of the block, the hash is the hash of
+// the cometbft block as in the blocks served by eth_getBlockByNumber.
+func (b *BackendImpl) GetBlockByNumber(blockNum rpc.BlockNumber, _ bool) (map[string]interface{}, error) {
+	block, err := b.ArtBlockByNumber(context.Background(), blockNum)
 	if err != nil {
 		return nil, err
 	}
@@ -495,7 +1026,7 @@ func (b *BackendImpl) GetBlockByNumber(blockNum rpc.BlockNumber, fullTx bool) (m
 
 func (b *BackendImpl) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
-	ethBlock *map[string]interface{},
+	header *ethtypes.Header,
 	rewardPercentiles []float64,
 	tendermintBlockResult *tmrpctypes.ResultBlockResults,
 ) (*rpctypes.OneFeeHistory, error) {
@@ -504,38 +1035,31 @@ func (b *BackendImpl) processBlock(
 	if err != nil {
 		return nil, err
 	}
+	if blockBaseFee == nil {
+		blockBaseFee = new(big.Int)
+	}
 
 	targetOneFeeHistory := &rpctypes.OneFeeHistory{}
 	targetOneFeeHistory.BaseFee = blockBaseFee
 	cfg := b.ChainConfig()
+	if cfg == nil {
+		return nil, errors.New("chain config does not exist")
+	}
 	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		header := b.CurrentHeader()
-		if header == nil {
-			return nil, errors.New("header does not exist")
-		}
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
+		// estimate the base fee of the next block from the header of this block
+		parent := ethtypes.CopyHeader(header)
+		parent.BaseFee = blockBaseFee
+		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, parent)
 	} else {
 		targetOneFeeHistory.NextBaseFee = new(big.Int)
 	}
-	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
-	if !ok {
-		return nil, fmt.Errorf("invalid gas limit type: %T", (*ethBlock)["gasLimit"])
-	}
 
-	gasUsed, ok := (*ethBlock)["gasUsed"].(hexutil.Uint64)
-	if !ok {
-		return nil, fmt.Errorf("invalid gas used type: %T", (*ethBlock)["gasUsed"])
+	if header.GasLimit == 0 {
+		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, header.GasLimit)
 	}
 
-	gasusedfloat, _ := new(big.Float).SetInt(new(big.Int).SetUint64(uint64(gasUsed))).Float64()
-
-	if gasLimitUint64 <= 0 {
-		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockHeight, gasLimitUint64)
-	}
-
-	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
-	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
+	blockGasUsed := float64(header.GasUsed)
+	targetOneFeeHistory.GasUsedRatio = blockGasUsed / float64(header.GasLimit)
 
 	rewardCount := len(rewardPercentiles)
 	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
@@ -549,27 +1073,42 @@ func (b *BackendImpl) processBlock(
 
 	var sorter sortGasAndReward
 
-	for i := 0; i < tendermintTxCount; i++ {
+	for i := 0; i < tendermintTxCount && i < len(tendermintTxResults); i++ {
 		eachTendermintTx := tendermintTxs[i]
 		eachTendermintTxResult := tendermintTxResults[i]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(eachTendermintTxResult) {
+			continue
+		}
 
 		tx, err := b.clientCtx.TxConfig.TxDecoder()(eachTendermintTx)
 		if err != nil {
 			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
 			continue
 		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed) // #nosec G701
-		for _, msg := range tx.GetMsgs() {
+
+		// the gas used of each ethereum tx is parsed from the events, a cosmos tx may
+		// carry several ethereum txs
+		parsedTxs, err := rpctypes.ParseTxResult(eachTendermintTxResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx result", "height", blockHeight, "error", err.Error())
+			continue
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*txs.MsgEthereumTx)
 			if !ok {
 				continue
 			}
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				continue
+			}
 			tx := ethMsg.AsTransaction()
 			reward := tx.EffectiveGasTipValue(blockBaseFee)
-			if reward == nil {
+			if reward == nil || reward.Sign() < 0 {
 				reward = big.NewInt(0)
 			}
-			sorter = append(sorter, txGasAndReward{gasUsed: txGasUsed, reward: reward})
+			sorter = append(sorter, txGasAndReward{gasUsed: parsedTx.GasUsed, reward: reward})
 		}
 	}
 
@@ -579,7 +1118,7 @@ func (b *BackendImpl) processBlock(
 		return targetOneFeeHistory, nil
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(sorter)
 
 	var txIndex int
 	sumGasUsed := sorter[0].gasUsed
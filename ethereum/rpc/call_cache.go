@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// callCache caches the results of the eth calls at fixed heights in a LRU, keyed by the height
+// and the hash of the call request. The state of a committed block never changes, so the same
+// call at the same height always has the same result.
+type callCache struct {
+	cache *lru.Cache[common.Hash, *txs.MsgEthereumTxResponse]
+}
+
+func newCallCache(size int) *callCache {
+	return &callCache{cache: lru.NewCache[common.Hash, *txs.MsgEthereumTxResponse](size)}
+}
+
+// callCacheKey hashes the height with the call request, which holds the args, the overrides
+// and the gas cap of the call.
+func callCacheKey(height int64, req *txs.EthCallRequest) (common.Hash, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(sdk.Uint64ToBigEndian(uint64(height)), bz), nil // #nosec G701
+}
+
+// get returns the cached result of the call, the result is shared so it must not be modified.
+func (c *callCache) get(key common.Hash) (*txs.MsgEthereumTxResponse, bool) {
+	return c.cache.Get(key)
+}
+
+// add caches the result of the call, the reverted calls are cached too.
+func (c *callCache) add(key common.Hash, res *txs.MsgEthereumTxResponse) {
+	c.cache.Add(key, res)
+}
@@ -0,0 +1,73 @@
+package checkpoints
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+// MaxCheckpointsRange is the max number of blocks of a checkpoints export
+const MaxCheckpointsRange = 10000
+
+// Backend defines the methods required by the checkpoints API
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	BlockCheckpoint(height int64) (*rpctypes.Checkpoint, error)
+	ExecutionDigest(blockNrOrHash rpc.BlockNumberOrHash) (*rpctypes.ExecutionDigest, error)
+}
+
+// API exports the compact block checkpoints for external light indexers, and the execution
+// digests of the blocks for the cross-node verification.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new checkpoints API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// ExportCheckpoints returns the checkpoints of the blocks in [from, to] encoded
+// in the binary format of rpctypes.EncodeCheckpoints.
+func (api *API) ExportCheckpoints(from, to hexutil.Uint64) (hexutil.Bytes, error) {
+	if from == 0 || from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if to-from+1 > MaxCheckpointsRange {
+		return nil, fmt.Errorf("block range exceeds the max of %d blocks", MaxCheckpointsRange)
+	}
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	if to > latest {
+		return nil, fmt.Errorf("block %d is beyond the latest block %d", to, latest)
+	}
+
+	checkpoints := make([]rpctypes.Checkpoint, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		checkpoint, err := api.backend.BlockCheckpoint(int64(height))
+		if err != nil {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, *checkpoint)
+	}
+	return rpctypes.EncodeCheckpoints(checkpoints), nil
+}
+
+// ExecutionDigest returns the digest of the receipts of the ethereum txs of the block, the
+// operators compare the digests of their nodes to spot the node diverging in the execution
+// at the block where it happens. It returns nil for the blocks produced before the digest
+// was introduced.
+func (api *API) ExecutionDigest(blockNrOrHash rpc.BlockNumberOrHash) (*rpctypes.ExecutionDigest, error) {
+	return api.backend.ExecutionDigest(blockNrOrHash)
+}
@@ -7,7 +7,9 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	dbm "github.com/cometbft/cometbft-db"
 
+	"github.com/artela-network/artela/ethereum/replica"
 	"github.com/artela-network/artela/ethereum/server/config"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
@@ -50,6 +52,25 @@ type Config struct {
 	// RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64 `toml:""`
+
+	// PreimageDB is the db of the SHA3 preimages recorded by the EVM, nil if
+	// the preimage recording is not enabled.
+	PreimageDB dbm.DB `toml:"-"`
+
+	// AccessStatsDB is the db of the per block storage access counters of the
+	// contracts, nil if the access stats recording is not enabled.
+	AccessStatsDB dbm.DB `toml:"-"`
+
+	// ContractIndexDB is the db of the contract creations, nil if the contract
+	// indexing is not enabled.
+	ContractIndexDB dbm.DB `toml:"-"`
+	// WitnessDB is the db of the per block execution witnesses, nil if the
+	// witness recording is not enabled.
+	WitnessDB dbm.DB `toml:"-"`
+
+	// StateFeed is the recorder of the state changes of the committed blocks
+	// served to the read replicas, nil if the state feed is not enabled.
+	StateFeed *replica.Recorder `toml:"-"`
 }
 
 // LoadConfigFromFilePath reads in a Polaris config file from the fileystem.
@@ -0,0 +1,35 @@
+package contracts
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// Backend defines the methods required by the contracts API
+type Backend interface {
+	ContractCreation(address common.Address) (*evmtypes.ContractCreation, error)
+}
+
+// API offers the metadata of the contracts indexed by the node, it is served under the
+// artela namespace only if the contract index is enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new contracts API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// GetContractCreation returns the creator, the creation tx and block, the init code hash
+// and the CREATE2 salt of the contract, nil if the contract creation is not indexed.
+func (api *API) GetContractCreation(address common.Address) (*evmtypes.ContractCreation, error) {
+	api.logger.Debug("artela_getContractCreation", "address", address.Hex())
+	return api.backend.ContractCreation(address)
+}
@@ -0,0 +1,115 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+var (
+	// eip1967ImplementationSlot is bytes32(uint256(keccak256('eip1967.proxy.implementation')) - 1)
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// eip1967BeaconSlot is bytes32(uint256(keccak256('eip1967.proxy.beacon')) - 1)
+	eip1967BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// eip1967AdminSlot is bytes32(uint256(keccak256('eip1967.proxy.admin')) - 1)
+	eip1967AdminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+
+	// beaconImplementationSelector is the selector of implementation() of the beacons
+	beaconImplementationSelector = hexutil.Bytes{0x5c, 0x60, 0xda, 0x1b}
+)
+
+// ProxyBackend defines the methods required by the proxy API
+type ProxyBackend interface {
+	GetStorageAt(address common.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error)
+	DoCall(args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *ethapi.StateOverride, blockOverrides *ethapi.BlockOverrides) (*txs.MsgEthereumTxResponse, error)
+}
+
+// Implementation is the implementation resolved from the EIP-1967 slots of a proxy.
+type Implementation struct {
+	Proxy common.Address `json:"proxy"`
+	// Implementation is nil if the contract is not an EIP-1967 proxy
+	Implementation *common.Address `json:"implementation"`
+	// Beacon is set if the proxy is a beacon proxy
+	Beacon *common.Address `json:"beacon,omitempty"`
+	Admin  *common.Address `json:"admin,omitempty"`
+}
+
+// ProxyAPI resolves the implementations of the proxy contracts, it's served under the
+// artela namespace.
+type ProxyAPI struct {
+	logger  log.Logger
+	backend ProxyBackend
+}
+
+// NewProxyAPI creates a new proxy API instance.
+func NewProxyAPI(logger log.Logger, backend ProxyBackend) *ProxyAPI {
+	return &ProxyAPI{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// GetImplementation reads the EIP-1967 implementation, beacon and admin slots of the
+// contract at the given block and returns the resolved implementation address. For the
+// beacon proxies the implementation is read by calling implementation() of the beacon.
+func (api *ProxyAPI) GetImplementation(_ context.Context, address common.Address, blockNrOrHash *rpc.BlockNumberOrHash) (*Implementation, error) {
+	api.logger.Debug("artela_getImplementation", "address", address.Hex())
+
+	blockNum := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		blockNum = *blockNrOrHash
+	}
+
+	result := &Implementation{Proxy: address}
+
+	impl, err := api.slotAddress(address, eip1967ImplementationSlot, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := api.slotAddress(address, eip1967AdminSlot, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	beacon, err := api.slotAddress(address, eip1967BeaconSlot, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	result.Implementation = impl
+	result.Admin = admin
+	result.Beacon = beacon
+
+	if impl == nil && beacon != nil {
+		data := beaconImplementationSelector
+		res, err := api.backend.DoCall(ethapi.TransactionArgs{To: beacon, Data: &data}, blockNum, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Ret) >= common.HashLength {
+			if beaconImpl := common.BytesToAddress(res.Ret[:common.HashLength]); beaconImpl != (common.Address{}) {
+				result.Implementation = &beaconImpl
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// slotAddress returns the address stored in the storage slot, nil if the slot is empty.
+func (api *ProxyAPI) slotAddress(address common.Address, slot common.Hash, blockNrOrHash rpc.BlockNumberOrHash) (*common.Address, error) {
+	value, err := api.backend.GetStorageAt(address, slot.Hex(), blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := common.BytesToAddress(value)
+	if addr == (common.Address{}) {
+		return nil, nil
+	}
+	return &addr, nil
+}
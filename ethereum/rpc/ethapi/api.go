@@ -3,6 +3,7 @@ package ethapi
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -23,7 +24,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -50,7 +50,15 @@ func (s *EthereumAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
 
 // MaxPriorityFeePerGas returns a suggestion for a gas tip cap for dynamic fee transactions.
 func (s *EthereumAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
-	return nil, errors.New("MaxPriorityFeePerGas is not implemented")
+	head := s.b.CurrentHeader()
+	if head == nil {
+		return nil, errors.New("latest header not found")
+	}
+	tipcap, err := s.b.SuggestGasTipCap(head.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tipcap), nil
 }
 
 // FeeHistory returns the fee market history.
@@ -66,7 +74,19 @@ func (s *EthereumAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecim
 // - pulledStates:  number of states entries processed until now
 // - knownStates:   number of known states entries that still need to be pulled
 func (s *EthereumAPI) Syncing() (interface{}, error) {
-	return nil, errors.New("Syncing is not implemented")
+	progress := s.b.SyncProgress()
+
+	// Return not syncing if the synchronisation already completed
+	if progress.CurrentBlock >= progress.HighestBlock {
+		return false, nil
+	}
+	// Otherwise gather the block sync stats, the states are synced by the snapshots of
+	// CometBFT, so their progress is not tracked
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(progress.StartingBlock),
+		"currentBlock":  hexutil.Uint64(progress.CurrentBlock),
+		"highestBlock":  hexutil.Uint64(progress.HighestBlock),
+	}, nil
 }
 
 // TxPoolAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
@@ -81,27 +101,96 @@ func NewTxPoolAPI(b Backend) *TxPoolAPI {
 
 // Content returns the transactions contained within the transaction pool.
 func (s *TxPoolAPI) Content() map[string]map[string]map[string]*RPCTransaction {
-	// not implemented
-	return nil
+	content := map[string]map[string]map[string]*RPCTransaction{
+		"pending": make(map[string]map[string]*RPCTransaction),
+		"queued":  make(map[string]map[string]*RPCTransaction),
+	}
+	pending, queue := s.b.TxPoolContent()
+	curHeader := s.b.CurrentHeader()
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["queued"][account.Hex()] = dump
+	}
+	return content
 }
 
 // ContentFrom returns the transactions contained within the transaction pool.
 func (s *TxPoolAPI) ContentFrom(addr common.Address) map[string]map[string]*RPCTransaction {
-	// not implemented
-	return nil
+	content := make(map[string]map[string]*RPCTransaction, 2)
+	pending, queue := s.b.TxPoolContentFrom(addr)
+	curHeader := s.b.CurrentHeader()
+
+	// Build the pending transactions
+	dump := make(map[string]*RPCTransaction, len(pending))
+	for _, tx := range pending {
+		dump[fmt.Sprintf("%d", tx.Nonce())] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+	}
+	content["pending"] = dump
+
+	// Build the queued transactions
+	dump = make(map[string]*RPCTransaction, len(queue))
+	for _, tx := range queue {
+		dump[fmt.Sprintf("%d", tx.Nonce())] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+	}
+	content["queued"] = dump
+
+	return content
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (s *TxPoolAPI) Status() map[string]hexutil.Uint {
-	// not implemented
-	return nil
+	pending, queue := s.b.Stats()
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queue),
+	}
 }
 
 // Inspect retrieves the content of the transaction pool and flattens it into an
 // easily inspectable list.
 func (s *TxPoolAPI) Inspect() map[string]map[string]map[string]string {
-	// not implemented
-	return nil
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
+	}
+	pending, queue := s.b.TxPoolContent()
+
+	// Define a formatter to flatten a transaction into a string
+	var format = func(tx *types.Transaction) string {
+		if to := tx.To(); to != nil {
+			return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To().Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+		}
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+		}
+		content["queued"][account.Hex()] = dump
+	}
+	return content
 }
 
 // EthereumAccountAPI provides an API to access accounts managed by this node.
@@ -143,6 +232,22 @@ func (s *PersonalAccountAPI) ListAccounts() []common.Address {
 	return s.b.Accounts()
 }
 
+// AccountInfo is the metadata of an account managed by the keyring of the node.
+type AccountInfo struct {
+	Address common.Address `json:"address"`
+	Name    string         `json:"name"`
+	Type    string         `json:"type"`
+	KeyType string         `json:"keyType"`
+	Path    string         `json:"path,omitempty"`
+}
+
+// ListAccountsInfo returns the metadata of the accounts this node manages, in the
+// order of ListAccounts. The HD path is known for the ledger keys and the keys
+// derived with DeriveAccount only.
+func (s *PersonalAccountAPI) ListAccountsInfo() ([]AccountInfo, error) {
+	return s.b.AccountsInfo()
+}
+
 // rawWallet is a JSON representation of an accounts.Wallet interface, with its
 // data contents extracted into plain fields.
 type rawWallet struct {
@@ -167,10 +272,12 @@ func (s *PersonalAccountAPI) OpenWallet(url string, passphrase *string) error {
 	return errors.New("OpenWallet is not implemented")
 }
 
-// DeriveAccount requests an HD wallet to derive a new account, optionally pinning
-// it for later reuse.
+// DeriveAccount derives an account of an HD wallet, optionally pinning it in the keyring
+// for later reuse. As the keyring keeps no mnemonics, the url is the mnemonic of the
+// wallet. The first path of the default derivation base not in the keyring is derived
+// if the path is empty.
 func (s *PersonalAccountAPI) DeriveAccount(url string, path string, pin *bool) (accounts.Account, error) {
-	return accounts.Account{}, errors.New("DeriveAccount is not implemented")
+	return s.b.DeriveAccount(url, path, pin != nil && *pin)
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -184,18 +291,39 @@ func (s *PersonalAccountAPI) ImportRawKey(privkey string, password string) (comm
 	return s.b.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore stores the key of the given keystore v3 json of geth into the key
+// directory, the key is decrypted with the passphrase, which also encrypts the stored key.
+func (s *PersonalAccountAPI) ImportKeystore(keyJSON string, passphrase string) (common.Address, error) {
+	return s.b.ImportKeystore(keyJSON, passphrase)
+}
+
 // UnlockAccount will unlock the account associated with the given address with
 // the given password for duration seconds. If duration is nil it will use a
-// default of 300 seconds. It returns an indication if the account was unlocked.
+// default of 300 seconds, 0 unlocks the account until it's locked. It returns an
+// indication if the account was unlocked.
+//
+// Note, the keys of the keyring are guarded by the keyring backend, so the password
+// is not checked.
 func (s *PersonalAccountAPI) UnlockAccount(ctx context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
-	// not implemented
-	return false, errors.New("UnlockAccount is not implemented")
+	const max = uint64(time.Duration(math.MaxInt64) / time.Second)
+	var d time.Duration
+	if duration == nil {
+		d = 300 * time.Second
+	} else if *duration > max {
+		return false, errors.New("unlock duration too large")
+	} else {
+		d = time.Duration(*duration) * time.Second
+	}
+	if err := s.b.UnlockAccount(addr, d); err != nil {
+		s.logger.Warn("Failed account unlock attempt", "address", addr, "err", err)
+		return false, err
+	}
+	return true, nil
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
 func (s *PersonalAccountAPI) LockAccount(addr common.Address) bool {
-	// not implemented"
-	return false
+	return s.b.LockAccount(addr)
 }
 
 // signTransaction sets defaults and signs the given transaction
@@ -244,8 +372,7 @@ func (s *PersonalAccountAPI) SignTransaction(ctx context.Context, args Transacti
 //
 // https://github.com/ethereum/go-ethereum/wiki/Management-APIs#personal_sign
 func (s *PersonalAccountAPI) Sign(ctx context.Context, data hexutil.Bytes, addr common.Address, passwd string) (hexutil.Bytes, error) {
-	// TODO
-	return nil, errors.New("Sign is not implemented")
+	return s.b.Sign(addr, accounts.TextHash(data))
 }
 
 // EcRecover returns the address for the account that was used to create the signature.
@@ -340,8 +467,15 @@ type StorageResult struct {
 }
 
 // GetProof returns the Merkle-proof for a given account and optionally some storage keys.
+// The proofs are the IAVL proofs of the account and the storage keys in the app states,
+// not the Merkle-Patricia proofs of the Ethereum states trie.
 func (s *BlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*AccountResult, error) {
-	return nil, errors.New("GetProof is not implemented")
+	for _, key := range storageKeys {
+		if _, err := decodeHash(key); err != nil {
+			return nil, err
+		}
+	}
+	return s.b.GetProof(address, storageKeys, blockNrOrHash)
 }
 
 // decodeHash parses a hex-encoded 32-byte hash. The input may optionally
@@ -368,9 +502,9 @@ func decodeHash(s string) (common.Hash, error) {
 // * When blockNr is -1 the chain head is returned.
 // * When blockNr is -2 the pending chain head is returned.
 func (s *BlockChainAPI) GetHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (map[string]interface{}, error) {
-	block, err := s.b.ArtBlockByNumber(ctx, number)
-	if block != nil && block.Header() != nil && err == nil {
-		response := s.rpcMarshalHeader(ctx, block.Header(), block.Hash())
+	header, hash, err := s.b.ArtHeaderByNumber(ctx, number)
+	if header != nil && err == nil {
+		response := s.rpcMarshalHeader(ctx, header, hash)
 		if number == rpc.PendingBlockNumber {
 			// Pending header need to nil out a few fields
 			for _, field := range []string{"hash", "nonce", "miner"} {
@@ -384,9 +518,9 @@ func (s *BlockChainAPI) GetHeaderByNumber(ctx context.Context, number rpc.BlockN
 
 // GetHeaderByHash returns the requested header by hash.
 func (s *BlockChainAPI) GetHeaderByHash(ctx context.Context, hash common.Hash) map[string]interface{} {
-	block, _ := s.b.BlockByHash(ctx, hash)
-	if block != nil && block.Header() != nil {
-		return s.rpcMarshalHeader(ctx, block.Header(), block.Hash())
+	header, _ := s.b.HeaderByHash(ctx, hash)
+	if header != nil {
+		return s.rpcMarshalHeader(ctx, header, hash)
 	}
 	return nil
 }
@@ -421,6 +555,11 @@ func (s *BlockChainAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fu
 	return nil, err
 }
 
+// GetBlockReceipts returns the block receipts for the given block hash or number or tag.
+func (s *BlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	return s.b.GetBlockReceipts(ctx, blockNrOrHash)
+}
+
 // GetUncleByBlockNumberAndIndex returns the uncle block for the given block hash and index.
 func (s *BlockChainAPI) GetUncleByBlockNumberAndIndex(ctx context.Context, blockNr rpc.BlockNumber, index hexutil.Uint) (map[string]interface{}, error) {
 	block, err := s.b.ArtBlockByNumber(ctx, blockNr)
@@ -489,15 +628,15 @@ func (s *BlockChainAPI) GetStorageAt(ctx context.Context, address common.Address
 
 // OverrideAccount indicates the overriding fields of account during the execution
 // of a message call.
-// Note, states and stateDiff can't be specified at the same time. If states is
-// set, message execution will only use the data in the given states. Otherwise
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
 // if statDiff is set, all diff will be applied first and then execute the call
 // message.
 type OverrideAccount struct {
 	Nonce     *hexutil.Uint64              `json:"nonce"`
 	Code      *hexutil.Bytes               `json:"code"`
 	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"states"`
+	State     *map[common.Hash]common.Hash `json:"state"`
 	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
 }
 
@@ -554,6 +693,32 @@ type BlockOverrides struct {
 	BaseFee    *hexutil.Big
 }
 
+// UnmarshalJSON accepts the field names of the later geth versions, feeRecipient, prevRandao
+// and baseFeePerGas, as the aliases of coinbase, random and baseFee.
+func (diff *BlockOverrides) UnmarshalJSON(input []byte) error {
+	type blockOverrides BlockOverrides
+	var dec struct {
+		blockOverrides
+		FeeRecipient  *common.Address `json:"feeRecipient"`
+		PrevRandao    *common.Hash    `json:"prevRandao"`
+		BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*diff = BlockOverrides(dec.blockOverrides)
+	if dec.FeeRecipient != nil {
+		diff.Coinbase = dec.FeeRecipient
+	}
+	if dec.PrevRandao != nil {
+		diff.Random = dec.PrevRandao
+	}
+	if dec.BaseFeePerGas != nil {
+		diff.BaseFee = dec.BaseFeePerGas
+	}
+	return nil
+}
+
 // Apply overrides the given header fields into the given block context.
 func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
 	if diff == nil {
@@ -655,7 +820,7 @@ func (e *revertError) ErrorData() interface{} {
 // Note, this function doesn't make and changes in the states/blockchain and is
 // useful to execute and retrieve values.
 func (s *BlockChainAPI) Call(ctx context.Context, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (hexutil.Bytes, error) {
-	data, err := s.b.DoCall(args, blockNrOrHash)
+	data, err := s.b.DoCall(args, blockNrOrHash, overrides, blockOverrides)
 	if err != nil {
 		return hexutil.Bytes{}, err
 	}
@@ -664,9 +829,10 @@ func (s *BlockChainAPI) Call(ctx context.Context, args TransactionArgs, blockNrO
 }
 
 // EstimateGas returns an estimate of the amount of gas needed to execute the
-// given transaction against the current pending block.
-func (s *BlockChainAPI) EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
-	return s.b.EstimateGas(ctx, args, blockNrOrHash)
+// given transaction against the current pending block, with the optional state
+// overrides applied.
+func (s *BlockChainAPI) EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *StateOverride) (hexutil.Uint64, error) {
+	return s.b.EstimateGas(ctx, args, blockNrOrHash, overrides)
 }
 
 // RPCMarshalHeader converts the given header to the RPC output .
@@ -715,7 +881,7 @@ func RPCMarshalBlock(block *rpctypes.Block, inclTx bool, fullTx bool, config *pa
 		}
 		if fullTx {
 			formatTx = func(idx int, tx *types.Transaction) interface{} {
-				return newRPCTransactionFromBlockIndex(block.EthBlock(), block.Hash(), uint64(idx), config)
+				return newRPCTransactionFromBlockIndex(block, uint64(idx), config)
 			}
 		}
 		txs := block.Transactions()
@@ -780,6 +946,9 @@ type RPCTransaction struct {
 	V                *hexutil.Big      `json:"v"`
 	R                *hexutil.Big      `json:"r"`
 	S                *hexutil.Big      `json:"s"`
+	// Memo and TimeoutHeight of the cosmos tx wrapping the eth tx, signed by the sender
+	Memo          string          `json:"memo,omitempty"`
+	TimeoutHeight *hexutil.Uint64 `json:"timeoutHeight,omitempty"`
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -846,7 +1015,12 @@ func NewTransactionFromMsg(
 ) *RPCTransaction {
 	tx := msg.AsTransaction()
 	// use latest singer, so use time.now as block time.
-	return newRPCTransaction(tx, blockHash, blockNumber, uint64(time.Now().Unix()), index, baseFee, cfg)
+	rpcTx := newRPCTransaction(tx, blockHash, blockNumber, uint64(time.Now().Unix()), index, baseFee, cfg)
+	if msg.From != "" {
+		// the sender of an unsigned tx, e.g. a system tx
+		rpcTx.From = common.HexToAddress(msg.From)
+	}
+	return rpcTx
 }
 
 // NewRPCPendingTransaction returns a pending transaction that will serialize to the RPC representation
@@ -864,13 +1038,18 @@ func NewRPCPendingTransaction(tx *types.Transaction, current *types.Header, conf
 	return newRPCTransaction(tx, common.Hash{}, blockNumber, blockTime, 0, baseFee, config)
 }
 
-// newRPCTransactionFromBlockIndex returns a transaction that will serialize to the RPC representation.
-func newRPCTransactionFromBlockIndex(b *types.Block, blockHash common.Hash, index uint64, config *params.ChainConfig) *RPCTransaction {
+// newRPCTransactionFromBlockIndex returns a transaction that will serialize to the RPC representation,
+// the sender of an unsigned transaction, e.g. a system transaction, is the one set in the block.
+func newRPCTransactionFromBlockIndex(b *rpctypes.Block, index uint64, config *params.ChainConfig) *RPCTransaction {
 	txs := b.Transactions()
 	if index >= uint64(len(txs)) {
 		return nil
 	}
-	return newRPCTransaction(txs[index], blockHash, b.NumberU64(), b.Time(), index, b.BaseFee(), config)
+	rpcTx := newRPCTransaction(txs[index], b.Hash(), b.Number().Uint64(), b.EthBlock().Time(), index, b.EthBlock().BaseFee(), config)
+	if sender, ok := b.Sender(txs[index].Hash()); ok {
+		rpcTx.From = sender
+	}
+	return rpcTx
 }
 
 // newRPCRawTransactionFromBlockIndex returns the bytes of a transaction given a block and a transaction index.
@@ -883,10 +1062,10 @@ func newRPCRawTransactionFromBlockIndex(b *types.Block, index uint64) hexutil.By
 	return blob
 }
 
-// accessListResult returns an optional accesslist
+// AccessListResult returns an optional accesslist
 // It's the result of the `debug_createAccessList` RPC call.
 // It contains an error if the transaction itself failed.
-type accessListResult struct {
+type AccessListResult struct {
 	Accesslist *types.AccessList `json:"accessList"`
 	Error      string            `json:"error,omitempty"`
 	GasUsed    hexutil.Uint64    `json:"gasUsed"`
@@ -894,8 +1073,22 @@ type accessListResult struct {
 
 // CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
 // Reexec and BlockNrOrHash can be specified to create the accessList on top of a certain states.
-func (s *BlockChainAPI) CreateAccessList(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*accessListResult, error) {
-	return nil, errors.New("CreateAccessList is not implemented")
+func (s *BlockChainAPI) CreateAccessList(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*AccessListResult, error) {
+	return s.b.CreateAccessList(ctx, args, blockNrOrHash)
+}
+
+// SimulateV1 executes the blocks of calls in sequence on top of the given block, each call
+// sees the effects of the calls and the state overrides before it. It's the implementation
+// of `eth_simulateV1`, nothing is changed in the state of the chain.
+func (s *BlockChainAPI) SimulateV1(ctx context.Context, opts txs.SimulateOptions, blockNrOrHash *rpc.BlockNumberOrHash) ([]*txs.SimulateBlockResult, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	return s.b.Simulate(opts, bNrOrHash)
 }
 
 // TransactionAPI exposes methods for reading and creating transaction data.
@@ -933,7 +1126,7 @@ func (s *TransactionAPI) GetBlockTransactionCountByHash(ctx context.Context, blo
 // GetTransactionByBlockNumberAndIndex returns the transaction for the given block number and index.
 func (s *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNr rpc.BlockNumber, index hexutil.Uint) *RPCTransaction {
 	if block, _ := s.b.ArtBlockByNumber(ctx, blockNr); block != nil {
-		return newRPCTransactionFromBlockIndex(block.EthBlock(), block.Hash(), uint64(index), s.b.ChainConfig())
+		return newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
 	}
 	return nil
 }
@@ -941,7 +1134,7 @@ func (s *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context
 // GetTransactionByBlockHashAndIndex returns the transaction for the given block hash and index.
 func (s *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash common.Hash, index hexutil.Uint) *RPCTransaction {
 	if block, _ := s.b.BlockByHash(ctx, blockHash); block != nil {
-		return newRPCTransactionFromBlockIndex(block.EthBlock(), block.Hash(), uint64(index), s.b.ChainConfig())
+		return newRPCTransactionFromBlockIndex(block, uint64(index), s.b.ChainConfig())
 	}
 	return nil
 }
@@ -974,8 +1167,7 @@ func (s *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 
 // GetRawTransactionByHash returns the bytes of the transaction for the given hash.
 func (s *TransactionAPI) GetRawTransactionByHash(ctx context.Context, hash common.Hash) (hexutil.Bytes, error) {
-	// TODO
-	return nil, errors.New("GetRawTransactionByHash is not implemented")
+	return s.b.GetRawTransaction(ctx, hash)
 }
 
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
@@ -1091,9 +1283,21 @@ type SignTransactionResult struct {
 
 // SignTransaction will sign the given transaction with the from account.
 // The node needs to have the private key of the account corresponding with
-// the given from address and it needs to be unlocked.
+// the given from address in its keyring. Unlike geth, the nonce, gas and fee
+// fields are filled like eth_fillTransaction when they are not specified.
 func (s *TransactionAPI) SignTransaction(ctx context.Context, args TransactionArgs) (*SignTransactionResult, error) {
-	// gas, gas limit, nonce checking are made in SignTransaction
+	if args.From == nil {
+		return nil, errors.New("from not specified")
+	}
+	if err := args.setDefaults(ctx, s.b); err != nil {
+		return nil, err
+	}
+	// the fee of the filled tx is checked like the one of the submitted txs
+	tx := args.toTransaction()
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+		return nil, err
+	}
+
 	signed, err := s.b.SignTransaction(&args)
 	if err != nil {
 		return nil, err
@@ -1166,18 +1370,86 @@ func (api *DebugAPI) GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNum
 	if block == nil {
 		return nil, fmt.Errorf("block #%d not found", hash)
 	}
-	return rlp.EncodeToBytes(block)
+	// the block is reconstructed from the CometBFT block, with the eth txs of the block only
+	return rlp.EncodeToBytes(block.EthBlock())
 }
 
 // GetRawReceipts retrieves the binary-encoded receipts of a single block.
 func (api *DebugAPI) GetRawReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]hexutil.Bytes, error) {
-	return nil, errors.New("GetRawReceipts is not implemented")
+	var hash common.Hash
+	if h, ok := blockNrOrHash.Hash(); ok {
+		hash = h
+	} else {
+		block, err := api.b.BlockByNumberOrHash(ctx, blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+		hash = block.Hash()
+	}
+	receipts, err := api.b.GetReceipts(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		b, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = b
+	}
+	return result, nil
 }
 
 // GetRawTransaction returns the bytes of the transaction for the given hash.
-func (s *DebugAPI) GetRawTransaction(ctx context.Context, hash common.Hash) (hexutil.Bytes, error) {
-	// TODO
-	return hexutil.Bytes{}, errors.New("GetRawTransaction is not implemented")
+func (api *DebugAPI) GetRawTransaction(ctx context.Context, hash common.Hash) (hexutil.Bytes, error) {
+	return api.b.GetRawTransaction(ctx, hash)
+}
+
+// Preimage returns the SHA3 preimage of hash recorded by the EVM, the node must
+// run with evm.enable-preimage-recording.
+func (api *DebugAPI) Preimage(ctx context.Context, hash common.Hash) (hexutil.Bytes, error) {
+	return api.b.GetPreimage(hash)
+}
+
+// TraceTransaction returns the structured logs created during the execution of EVM
+// and returns them as a JSON object, the built-in "callTracer" can be selected
+// through config to get the internal call tree of the transaction, and the built-in
+// "gasProfiler" to get the gas used by the call stacks, in the folded stack format
+// of the flamegraph tools with the tracer config {"format": "folded"}.
+func (api *DebugAPI) TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+	return api.b.TraceTransaction(ctx, hash, config)
+}
+
+// StorageRangeAt returns the storage of the contract after the first txIndex txs of the block,
+// at most maxResult entries starting from keyStart.
+func (api *DebugAPI) StorageRangeAt(ctx context.Context, blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (*txs.StorageRangeResult, error) {
+	return api.b.StorageRangeAt(ctx, blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRangeMaxResults is the maximum number of the accounts of a dump over rpc, as geth.
+const AccountRangeMaxResults = 256
+
+// AccountRange dumps at most maxResults accounts at the given block from the start address,
+// capped to AccountRangeMaxResults. Unlike geth the accounts are iterated in the order of the
+// raw addresses, so next is the address to pass as the start of the next range, and all the
+// accounts have their address, incompletes is ignored.
+func (api *DebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool) (*state.IteratorDump, error) {
+	if maxResults > AccountRangeMaxResults || maxResults <= 0 {
+		maxResults = AccountRangeMaxResults
+	}
+	return api.b.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// DumpBlock dumps the accounts with their code and storage at the given block, at most
+// AccountRangeMaxResults accounts are dumped as geth does, debug_accountRange iterates
+// all of them.
+func (api *DebugAPI) DumpBlock(blockNr rpc.BlockNumber) (*state.Dump, error) {
+	dump, err := api.b.AccountRange(rpc.BlockNumberOrHashWithNumber(blockNr), nil, AccountRangeMaxResults, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return &state.Dump{Root: dump.Root, Accounts: dump.Accounts}, nil
 }
 
 // PrintBlock retrieves a block and returns its pretty printed form.
@@ -1205,28 +1477,31 @@ func (api *DebugAPI) SetHead(number hexutil.Uint64) {
 	// TODO
 }
 
+// NetBackend provides the P2P status of the node to the net API.
+type NetBackend interface {
+	Listening() bool
+	PeerCount() hexutil.Uint
+}
+
 // NetAPI offers network related RPC methods
 type NetAPI struct {
-	net            *p2p.Server
+	net            NetBackend
 	networkVersion uint64
 }
 
 // NewNetAPI creates a new net API instance.
-func NewNetAPI(net *p2p.Server, networkVersion uint64) *NetAPI {
+func NewNetAPI(net NetBackend, networkVersion uint64) *NetAPI {
 	return &NetAPI{net, networkVersion}
 }
 
 // Listening returns an indication if the node is listening for network connections.
 func (s *NetAPI) Listening() bool {
-	return true // always listening
+	return s.net.Listening()
 }
 
 // PeerCount returns the number of connected peers
 func (s *NetAPI) PeerCount() hexutil.Uint {
-	if s.net == nil {
-		return 0
-	}
-	return hexutil.Uint(s.net.PeerCount())
+	return s.net.PeerCount()
 }
 
 // Version returns the current ethereum protocol version.
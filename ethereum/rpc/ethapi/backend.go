@@ -3,8 +3,11 @@ package ethapi
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
@@ -23,19 +26,28 @@ import (
 // both full and light clients) with access to necessary functions.
 type Backend interface {
 	// General Ethereum API
+	SyncProgress() ethereum.SyncProgress
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
 	GasPrice(ctx context.Context) (*hexutil.Big, error)
 
 	// Account releated
 	Accounts() []common.Address
+	AccountsInfo() ([]AccountInfo, error)
 	NewAccount(password string) (common.AddressEIP55, error)
+	DeriveAccount(mnemonic, path string, pin bool) (accounts.Account, error)
 	ImportRawKey(privkey, password string) (common.Address, error)
+	ImportKeystore(keyJSON, passphrase string) (common.Address, error)
+	UnlockAccount(address common.Address, duration time.Duration) error
+	LockAccount(address common.Address) bool
+	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	GetTransactionCount(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error)
 	GetBalance(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error)
+	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*AccountResult, error)
 
 	// Blockchain API
 	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
 	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	ArtHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, common.Hash, error)
 	HeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Header, error)
 	CurrentHeader() *types.Header
 	CurrentBlock() *rpctypes.Block
@@ -55,13 +67,27 @@ type Backend interface {
 	GetTransaction(ctx context.Context, txHash common.Hash) (*RPCTransaction, error)
 	SignTransaction(args *TransactionArgs) (*ethtypes.Transaction, error)
 	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+	GetRawTransaction(ctx context.Context, hash common.Hash) (hexutil.Bytes, error)
+	GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error)
+	GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 	RPCTxFeeCap() float64
 	UnprotectedAllowed() bool
-	EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
-	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*txs.MsgEthereumTxResponse, error)
+	EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *StateOverride) (hexutil.Uint64, error)
+	CreateAccessList(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*AccessListResult, error)
+	DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (*txs.MsgEthereumTxResponse, error)
+	Simulate(opts txs.SimulateOptions, blockNrOrHash rpc.BlockNumberOrHash) ([]*txs.SimulateBlockResult, error)
+	Stats() (int, int)
+	TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
 
 	ChainConfig() *params.ChainConfig
 	Engine() consensus.Engine
 
+	// Debug API
+	GetPreimage(hash common.Hash) ([]byte, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	StorageRangeAt(ctx context.Context, blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (*txs.StorageRangeResult, error)
+	AccountRange(blockNrOrHash rpc.BlockNumberOrHash, start []byte, maxResults int, noCode, noStorage bool) (*state.IteratorDump, error)
+
 	// This is copied from filters.Backend
 }
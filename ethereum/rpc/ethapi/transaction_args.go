@@ -70,12 +70,12 @@ func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend) error {
 		args.Value = new(hexutil.Big)
 	}
 	if args.Nonce == nil {
-		nonce, err := b.GetTransactionCount(args.from(), rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+		// the pending nonce counts the txs of the sender in the mempool
+		nonce, err := b.GetTransactionCount(args.from(), rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber))
 		if err != nil {
 			return err
 		}
 		args.Nonce = (*hexutil.Uint64)(nonce)
-		// TODO set nonce
 	}
 	if args.Data != nil && args.Input != nil && !bytes.Equal(*args.Data, *args.Input) {
 		return errors.New(`both "data" and "input" are set and not equal. Please use "input" to pass transaction call data`)
@@ -99,14 +99,11 @@ func (args *TransactionArgs) setDefaults(ctx context.Context, b Backend) error {
 			AccessList:           args.AccessList,
 		}
 		pendingBlockNr := rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber)
-		// estimated, err := DoEstimateGas(ctx, b, callArgs, pendingBlockNr, b.RPCGasCap())
-		// if err != nil {
-		// 	return err
-		// }
-		// args.Gas = &estimated
-		// TODO set gas
-		_ = callArgs
-		_ = pendingBlockNr
+		estimated, err := b.EstimateGas(ctx, callArgs, &pendingBlockNr, nil)
+		if err != nil {
+			return err
+		}
+		args.Gas = &estimated
 
 		log.Trace("Estimate gas usage automatically", "gas", args.Gas)
 	}
@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/ethereum/rpc/faucet"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/server/config"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+var _ faucet.Backend = (*BackendImpl)(nil)
+
+// FaucetConfig returns the faucet configuration of the node.
+func (b *BackendImpl) FaucetConfig() config.FaucetConfig {
+	return b.appConf.Faucet
+}
+
+// SendFaucetFunds sends the configured faucet amount of the faucet module account to
+// the given address and returns the cosmos tx hash. The drip is signed by the faucet
+// operator key in the node's keyring, which only pays the fees of the drips.
+func (b *BackendImpl) SendFaucetFunds(to sdktypes.AccAddress) (string, error) {
+	cfg := b.appConf.Faucet
+	if !cfg.Enable {
+		return "", errors.New("faucet is not enabled")
+	}
+	if b.appConf.JSONRPC.ReadOnly {
+		return "", errReadOnly
+	}
+
+	amount, err := sdktypes.ParseCoinsNormalized(cfg.Amount)
+	if err != nil {
+		return "", err
+	}
+	fees, err := sdktypes.ParseCoinsNormalized(cfg.Fees)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := b.clientCtx.Keyring.Key(cfg.KeyName)
+	if err != nil {
+		return "", fmt.Errorf("faucet operator key %s not found in keyring: %w", cfg.KeyName, err)
+	}
+	from, err := info.GetAddress()
+	if err != nil {
+		return "", err
+	}
+
+	// faucet transfers are serialized so that sequences of txs sent within
+	// the same block do not collide
+	b.faucetMu.Lock()
+	defer b.faucetMu.Unlock()
+
+	accNum, seq, err := b.clientCtx.AccountRetriever.GetAccountNumberSequence(b.clientCtx, from)
+	if err != nil {
+		return "", fmt.Errorf("failed to query faucet operator account: %w", err)
+	}
+	if b.faucetSeqUsed && seq <= b.faucetSeq {
+		seq = b.faucetSeq + 1
+	}
+
+	txf := tx.Factory{}.
+		WithChainID(b.clientCtx.ChainID).
+		WithKeybase(b.clientCtx.Keyring).
+		WithTxConfig(b.clientCtx.TxConfig).
+		WithAccountRetriever(b.clientCtx.AccountRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq).
+		WithGas(cfg.GasLimit).
+		WithFees(fees.String())
+
+	txBuilder, err := txf.BuildUnsignedTx(&txs.MsgFaucetDrip{
+		Operator:  from.String(),
+		Recipient: to.String(),
+		Amount:    amount.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Sign(txf, cfg.KeyName, txBuilder, true); err != nil {
+		return "", err
+	}
+
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		b.logger.Error("failed to encode faucet tx", "error", err.Error())
+		return "", err
+	}
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = rpctypes.NewABCIError(rsp)
+	}
+	if err != nil {
+		b.logger.Error("failed to broadcast faucet tx", "error", err.Error())
+		return "", err
+	}
+
+	b.faucetSeq = seq
+	b.faucetSeqUsed = true
+	return rsp.TxHash, nil
+}
@@ -0,0 +1,216 @@
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+const (
+	// captchaTimeout is the timeout of a request to the captcha webhook
+	captchaTimeout = 10 * time.Second
+	// pruneInterval is the min duration between two sweeps of the expired rate limit records
+	pruneInterval = time.Minute
+)
+
+// Backend defines the methods required by the faucet API
+type Backend interface {
+	FaucetConfig() config.FaucetConfig
+	SendFaucetFunds(to sdktypes.AccAddress) (string, error)
+}
+
+// RequestFundsResult is the result of an accepted artela_requestFunds call.
+type RequestFundsResult struct {
+	TxHash string `json:"txHash"`
+	Amount string `json:"amount"`
+}
+
+// API offers the development faucet of a test network, it is served under
+// the artela namespace only if the faucet is enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+	client  *http.Client
+
+	mu        sync.Mutex
+	addresses map[string]time.Time
+	ips       map[string]time.Time
+	pruned    time.Time
+}
+
+// NewAPI creates a new faucet API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:    logger,
+		backend:   backend,
+		client:    &http.Client{Timeout: captchaTimeout},
+		addresses: make(map[string]time.Time),
+		ips:       make(map[string]time.Time),
+	}
+}
+
+// RequestFunds sends the configured faucet amount to the given address, which
+// can be either a hex or a bech32 address. The captcha token is forwarded to
+// the configured captcha webhook, if any.
+func (api *API) RequestFunds(ctx context.Context, address string, captchaToken *string) (*RequestFundsResult, error) {
+	cfg := api.backend.FaucetConfig()
+
+	to, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := clientIP(rpc.PeerInfoFromContext(ctx))
+
+	token := ""
+	if captchaToken != nil {
+		token = *captchaToken
+	}
+	if err := api.verifyCaptcha(ctx, cfg.CaptchaWebhook, to.String(), ip, token); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := api.reserve(cfg, to.String(), ip, now); err != nil {
+		return nil, err
+	}
+
+	txHash, err := api.backend.SendFaucetFunds(to)
+	if err != nil {
+		api.release(to.String(), ip, now)
+		api.logger.Debug("faucet transfer failed", "address", to.String(), "error", err)
+		return nil, err
+	}
+
+	api.logger.Info("faucet funds sent", "address", to.String(), "ip", ip, "tx", txHash)
+	return &RequestFundsResult{TxHash: txHash, Amount: cfg.Amount}, nil
+}
+
+// reserve checks the rate limits of the address and IP and records the request.
+func (api *API) reserve(cfg config.FaucetConfig, address, ip string, now time.Time) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.prune(cfg, now)
+
+	if last, ok := api.addresses[address]; ok && now.Sub(last) < cfg.AddressInterval {
+		return fmt.Errorf("address %s already funded, retry in %s", address, (cfg.AddressInterval - now.Sub(last)).Round(time.Second))
+	}
+	if ip != "" {
+		if last, ok := api.ips[ip]; ok && now.Sub(last) < cfg.IPInterval {
+			return fmt.Errorf("too many requests from %s, retry in %s", ip, (cfg.IPInterval - now.Sub(last)).Round(time.Second))
+		}
+	}
+
+	api.addresses[address] = now
+	if ip != "" {
+		api.ips[ip] = now
+	}
+	return nil
+}
+
+// prune drops the records out of their rate limit interval, so the records of the past
+// requests don't pile up in memory. The records are swept at most once per pruneInterval.
+func (api *API) prune(cfg config.FaucetConfig, now time.Time) {
+	if now.Sub(api.pruned) < pruneInterval {
+		return
+	}
+	api.pruned = now
+
+	for address, last := range api.addresses {
+		if now.Sub(last) >= cfg.AddressInterval {
+			delete(api.addresses, address)
+		}
+	}
+	for ip, last := range api.ips {
+		if now.Sub(last) >= cfg.IPInterval {
+			delete(api.ips, ip)
+		}
+	}
+}
+
+// release drops the records of a failed request, so it can be retried.
+func (api *API) release(address, ip string, at time.Time) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if api.addresses[address].Equal(at) {
+		delete(api.addresses, address)
+	}
+	if ip != "" && api.ips[ip].Equal(at) {
+		delete(api.ips, ip)
+	}
+}
+
+// verifyCaptcha posts the request to the captcha webhook, the webhook is
+// expected to reply with a 2xx status and {"success": true}.
+func (api *API) verifyCaptcha(ctx context.Context, webhook, address, ip, token string) error {
+	if webhook == "" {
+		return nil
+	}
+	if token == "" {
+		return errors.New("captcha token is required")
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"address": address,
+		"ip":      ip,
+		"token":   token,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := api.client.Do(req)
+	if err != nil {
+		api.logger.Error("captcha webhook request failed", "error", err)
+		return errors.New("captcha verification unavailable")
+	}
+	defer rsp.Body.Close()
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if rsp.StatusCode/100 != 2 || json.NewDecoder(rsp.Body).Decode(&result) != nil || !result.Success {
+		return errors.New("captcha verification failed")
+	}
+	return nil
+}
+
+func parseAddress(address string) (sdktypes.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+
+	addr, err := sdktypes.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s", address)
+	}
+	return addr, nil
+}
+
+func clientIP(info rpc.PeerInfo) string {
+	if host, _, err := net.SplitHostPort(info.RemoteAddr); err == nil {
+		return host
+	}
+	return info.RemoteAddr
+}
@@ -0,0 +1,44 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+)
+
+func TestReserve(t *testing.T) {
+	api := NewAPI(log.Root(), nil)
+	cfg := *config.DefaultFaucetConfig()
+	now := time.Now()
+
+	require.NoError(t, api.reserve(cfg, "alice", "10.0.0.1", now))
+	require.ErrorContains(t, api.reserve(cfg, "alice", "10.0.0.2", now.Add(time.Minute)), "already funded")
+	require.ErrorContains(t, api.reserve(cfg, "bob", "10.0.0.1", now.Add(time.Minute)), "too many requests")
+
+	// a released request can be retried
+	api.release("alice", "10.0.0.1", now)
+	require.NoError(t, api.reserve(cfg, "alice", "10.0.0.1", now.Add(time.Minute)))
+}
+
+func TestReservePrune(t *testing.T) {
+	api := NewAPI(log.Root(), nil)
+	cfg := *config.DefaultFaucetConfig()
+	now := time.Now()
+
+	require.NoError(t, api.reserve(cfg, "alice", "10.0.0.1", now))
+	require.NoError(t, api.reserve(cfg, "bob", "10.0.0.2", now.Add(cfg.IPInterval)))
+	require.Len(t, api.addresses, 2)
+	require.Len(t, api.ips, 1)
+
+	// the records out of their interval are dropped
+	require.NoError(t, api.reserve(cfg, "carol", "10.0.0.3", now.Add(cfg.AddressInterval)))
+	require.Len(t, api.addresses, 2)
+	require.Contains(t, api.addresses, "bob")
+	require.Contains(t, api.addresses, "carol")
+	require.Len(t, api.ips, 1)
+	require.Contains(t, api.ips, "10.0.0.3")
+}
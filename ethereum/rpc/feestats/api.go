@@ -0,0 +1,50 @@
+package feestats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// API offers the rolling fee stats of the node for the fee dashboards, it is served under
+// the artela namespace only if the fee stats windows are set in the app config.
+type API struct {
+	logger  log.Logger
+	tracker *Tracker
+}
+
+// NewAPI creates a new fee stats API instance.
+func NewAPI(logger log.Logger, tracker *Tracker) *API {
+	return &API{
+		logger:  logger,
+		tracker: tracker,
+	}
+}
+
+// FeeStats returns the time-weighted base fee, median tip and gas used ratio of the latest
+// blocks over the given window, e.g. "10m", or over all the windows of the node if no
+// window is given. The window can't be longer than the longest window of the node.
+func (api *API) FeeStats(window *string) ([]*Stats, error) {
+	windows := api.tracker.Windows()
+	if window != nil && *window != "" {
+		duration, err := time.ParseDuration(*window)
+		if err != nil || duration <= 0 {
+			return nil, fmt.Errorf("invalid fee stats window '%s'", *window)
+		}
+		if maxWindow := windows[len(windows)-1]; duration > maxWindow {
+			return nil, fmt.Errorf("fee stats window %s is longer than the max of %s", duration, maxWindow)
+		}
+		windows = []time.Duration{duration}
+	}
+
+	result := make([]*Stats, 0, len(windows))
+	for _, w := range windows {
+		stats := api.tracker.Stats(w)
+		if stats == nil {
+			return nil, fmt.Errorf("fee stats are not available yet")
+		}
+		result = append(result, stats)
+	}
+	return result, nil
+}
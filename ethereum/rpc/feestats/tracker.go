@@ -0,0 +1,268 @@
+package feestats
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// pollInterval is the interval of the tracker checking for new blocks
+	pollInterval = time.Second
+	// MaxSyncBlocks is the max number of blocks loaded by the tracker at once, the tracker
+	// restarts from the latest blocks if it falls further behind
+	MaxSyncBlocks = 10000
+)
+
+// Backend defines the methods required by the fee stats tracker
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	FeeSample(height int64) (*Sample, error)
+}
+
+// Sample is the fee data of a block.
+type Sample struct {
+	Height       int64
+	Time         time.Time
+	BaseFee      *big.Int
+	MedianTip    *big.Int
+	GasUsedRatio float64
+}
+
+// Stats is the time-weighted fee stats of the blocks over a window, each block is weighted
+// by the time since its parent block.
+type Stats struct {
+	Window       string         `json:"window"`
+	FromBlock    hexutil.Uint64 `json:"fromBlock"`
+	ToBlock      hexutil.Uint64 `json:"toBlock"`
+	Blocks       hexutil.Uint64 `json:"blocks"`
+	BaseFee      *hexutil.Big   `json:"baseFee"`
+	MedianTip    *hexutil.Big   `json:"medianTip"`
+	GasUsedRatio float64        `json:"gasUsedRatio"`
+}
+
+// Tracker keeps the fee samples of the latest blocks covering the longest window, and
+// reports the stats of the windows over the telemetry after each new block.
+type Tracker struct {
+	logger  log.Logger
+	backend Backend
+	windows []time.Duration
+
+	mu      sync.RWMutex
+	samples []Sample
+
+	quit chan struct{}
+	once sync.Once
+}
+
+// NewTracker creates a new fee stats tracker of the windows.
+func NewTracker(logger log.Logger, backend Backend, windows []time.Duration) *Tracker {
+	windows = append([]time.Duration(nil), windows...)
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+	return &Tracker{
+		logger:  logger,
+		backend: backend,
+		windows: windows,
+		quit:    make(chan struct{}),
+	}
+}
+
+// Windows returns the windows of the tracker in ascending order.
+func (t *Tracker) Windows() []time.Duration {
+	return t.windows
+}
+
+// Start starts tracking the new blocks in the background until Stop is called.
+func (t *Tracker) Start() {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if t.sync() {
+					t.emitTelemetry()
+				}
+			case <-t.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops tracking the new blocks.
+func (t *Tracker) Stop() {
+	t.once.Do(func() { close(t.quit) })
+}
+
+// sync loads the samples of the blocks since the last sample, it returns true if any sample
+// is added. The tracker backfills the longest window on the first sync.
+func (t *Tracker) sync() bool {
+	latest, err := t.backend.BlockNumber()
+	if err != nil || latest == 0 {
+		return false
+	}
+
+	t.mu.RLock()
+	var next int64
+	if len(t.samples) > 0 {
+		next = t.samples[len(t.samples)-1].Height + 1
+	}
+	t.mu.RUnlock()
+
+	if next > int64(latest) {
+		return false
+	}
+	if next == 0 || int64(latest)-next >= MaxSyncBlocks {
+		return t.backfill(int64(latest))
+	}
+
+	added := false
+	for height := next; height <= int64(latest); height++ {
+		sample, err := t.backend.FeeSample(height)
+		if err != nil {
+			t.logger.Debug("failed to load the fee sample", "height", height, "error", err)
+			break
+		}
+		t.add(*sample)
+		added = true
+	}
+	return added
+}
+
+// backfill replaces the samples with the ones of the blocks covering the longest window up
+// to the latest block.
+func (t *Tracker) backfill(latest int64) bool {
+	maxWindow := t.windows[len(t.windows)-1]
+
+	var samples []Sample
+	for height := latest; height > 0 && latest-height < MaxSyncBlocks; height-- {
+		sample, err := t.backend.FeeSample(height)
+		if err != nil {
+			t.logger.Debug("failed to load the fee sample", "height", height, "error", err)
+			break
+		}
+		samples = append(samples, *sample)
+		// the parent of the oldest block in the window is kept to weight that block
+		if samples[0].Time.Sub(sample.Time) > maxWindow {
+			break
+		}
+	}
+	if len(samples) == 0 {
+		return false
+	}
+
+	for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+		samples[i], samples[j] = samples[j], samples[i]
+	}
+
+	t.mu.Lock()
+	t.samples = samples
+	t.mu.Unlock()
+	return true
+}
+
+// add appends the sample of the next block and prunes the samples out of the longest window.
+func (t *Tracker) add(sample Sample) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.samples = append(t.samples, sample)
+
+	start := sample.Time.Add(-t.windows[len(t.windows)-1])
+	pruned := 0
+	// keep the last sample before the window start as the parent of the oldest block
+	for pruned+1 < len(t.samples) && !t.samples[pruned+1].Time.After(start) {
+		pruned++
+	}
+	if pruned > 0 {
+		t.samples = append(t.samples[:0:0], t.samples[pruned:]...)
+	}
+}
+
+// Stats returns the stats of the blocks within the window up to the latest tracked block,
+// nil if no block is tracked.
+func (t *Tracker) Stats(window time.Duration) *Stats {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.samples) == 0 {
+		return nil
+	}
+
+	last := t.samples[len(t.samples)-1]
+	start := last.Time.Add(-window)
+
+	var (
+		from      = last.Height
+		blocks    uint64
+		weights   int64
+		baseFee   = new(big.Int)
+		medianTip = new(big.Int)
+		gasUsed   float64
+	)
+	for i := len(t.samples) - 1; i > 0; i-- {
+		sample := t.samples[i]
+		if !sample.Time.After(start) {
+			break
+		}
+
+		// the block is weighted by its time within the window since its parent
+		parentTime := t.samples[i-1].Time
+		if parentTime.Before(start) {
+			parentTime = start
+		}
+		weight := sample.Time.Sub(parentTime).Milliseconds()
+
+		from = sample.Height
+		blocks++
+		weights += weight
+		baseFee.Add(baseFee, new(big.Int).Mul(sample.BaseFee, big.NewInt(weight)))
+		medianTip.Add(medianTip, new(big.Int).Mul(sample.MedianTip, big.NewInt(weight)))
+		gasUsed += sample.GasUsedRatio * float64(weight)
+	}
+
+	// a single sample or the blocks of the same time have no weight, the stats are the ones
+	// of the latest block
+	if weights == 0 {
+		from, blocks = last.Height, 1
+		baseFee.Set(last.BaseFee)
+		medianTip.Set(last.MedianTip)
+		gasUsed = last.GasUsedRatio
+		weights = 1
+	}
+
+	return &Stats{
+		Window:       window.String(),
+		FromBlock:    hexutil.Uint64(from),
+		ToBlock:      hexutil.Uint64(last.Height),
+		Blocks:       hexutil.Uint64(blocks),
+		BaseFee:      (*hexutil.Big)(baseFee.Quo(baseFee, big.NewInt(weights))),
+		MedianTip:    (*hexutil.Big)(medianTip.Quo(medianTip, big.NewInt(weights))),
+		GasUsedRatio: gasUsed / float64(weights),
+	}
+}
+
+// emitTelemetry reports the stats of the windows as the gauges labeled with the window.
+func (t *Tracker) emitTelemetry() {
+	for _, window := range t.windows {
+		stats := t.Stats(window)
+		if stats == nil {
+			return
+		}
+
+		labels := []metrics.Label{telemetry.NewLabel("window", stats.Window)}
+		baseFee, _ := new(big.Float).SetInt(stats.BaseFee.ToInt()).Float32()
+		medianTip, _ := new(big.Float).SetInt(stats.MedianTip.ToInt()).Float32()
+		telemetry.SetGaugeWithLabels([]string{"fee_stats", "base_fee"}, baseFee, labels)
+		telemetry.SetGaugeWithLabels([]string{"fee_stats", "median_tip"}, medianTip, labels)
+		telemetry.SetGaugeWithLabels([]string{"fee_stats", "gas_used_ratio"}, float32(stats.GasUsedRatio), labels)
+	}
+}
@@ -0,0 +1,79 @@
+package feestats
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBackend struct {
+	samples map[int64]*Sample
+	latest  int64
+}
+
+func (b *mockBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.latest), nil
+}
+
+func (b *mockBackend) FeeSample(height int64) (*Sample, error) {
+	sample, ok := b.samples[height]
+	if !ok {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return sample, nil
+}
+
+func (b *mockBackend) addBlock(blockTime time.Time, baseFee, tip int64, gasUsedRatio float64) {
+	b.latest++
+	b.samples[b.latest] = &Sample{
+		Height:       b.latest,
+		Time:         blockTime,
+		BaseFee:      big.NewInt(baseFee),
+		MedianTip:    big.NewInt(tip),
+		GasUsedRatio: gasUsedRatio,
+	}
+}
+
+func TestTrackerStats(t *testing.T) {
+	genesis := time.Unix(1700000000, 0)
+	backend := &mockBackend{samples: make(map[int64]*Sample)}
+	backend.addBlock(genesis, 100, 1, 0)
+	backend.addBlock(genesis.Add(time.Second), 100, 1, 0.5)
+	backend.addBlock(genesis.Add(4*time.Second), 200, 4, 1)
+
+	tracker := NewTracker(log.Root(), backend, []time.Duration{10 * time.Second, 2 * time.Second})
+	require.Equal(t, []time.Duration{2 * time.Second, 10 * time.Second}, tracker.Windows())
+	require.Nil(t, tracker.Stats(time.Second))
+
+	require.True(t, tracker.sync())
+	require.False(t, tracker.sync())
+
+	// block 2 lasts 1s and block 3 lasts 3s
+	stats := tracker.Stats(10 * time.Second)
+	require.Equal(t, hexutil.Uint64(2), stats.FromBlock)
+	require.Equal(t, hexutil.Uint64(3), stats.ToBlock)
+	require.Equal(t, hexutil.Uint64(2), stats.Blocks)
+	require.Equal(t, big.NewInt(175), stats.BaseFee.ToInt())
+	require.Equal(t, big.NewInt(3), stats.MedianTip.ToInt())
+	require.InDelta(t, 0.875, stats.GasUsedRatio, 1e-9)
+
+	// only the last 2s of block 3 are in the window
+	stats = tracker.Stats(2 * time.Second)
+	require.Equal(t, hexutil.Uint64(3), stats.FromBlock)
+	require.Equal(t, hexutil.Uint64(1), stats.Blocks)
+	require.Equal(t, big.NewInt(200), stats.BaseFee.ToInt())
+
+	// the samples out of the longest window are pruned
+	backend.addBlock(genesis.Add(20*time.Second), 300, 5, 0)
+	require.True(t, tracker.sync())
+	require.Len(t, tracker.samples, 2)
+
+	stats = tracker.Stats(10 * time.Second)
+	require.Equal(t, hexutil.Uint64(4), stats.FromBlock)
+	require.Equal(t, big.NewInt(300), stats.BaseFee.ToInt())
+}
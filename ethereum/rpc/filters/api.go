@@ -12,14 +12,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
 	"github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/x/evm/txs"
-	"github.com/artela-network/artela/x/evm/txs/support"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
 
@@ -40,18 +43,28 @@ type Backend interface {
 	HeaderByNumber(ctx context.Context, blockNum rpc.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(ctx context.Context, blockHash common.Hash) (*ethtypes.Header, error)
 	CosmosBlockByHash(hash common.Hash) (*coretypes.ResultBlock, error)
+	BlockNumberByHash(hash common.Hash) (int64, error)
 	CosmosBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	// GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	// GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
+	BlocksByLogTopics(addresses []common.Address, topics0 []common.Hash, from, to int64) (heights []int64, indexedTo int64, ok bool, err error)
+	BlocksByBloomBits(addresses []common.Address, topics [][]common.Hash, from, to int64) (heights []int64, indexedTo int64, ok bool, err error)
 
 	BloomStatus() (uint64, uint64)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
 	RPCBlockRangeCap() int32
+
+	SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription
+	CurrentHeader() *ethtypes.Header
+	ChainConfig() *params.ChainConfig
 }
 
+// txChanSize is the buffer size of the new txs events of a pending txs subscription
+const txChanSize = 256
+
 // consider a filter inactive if it has not been polled for within deadline
 var deadline = 5 * time.Minute
 
@@ -92,6 +105,12 @@ func NewPublicFilterAPI(logger log.Logger, clientCtx client.Context, tmWSClient
 	return api
 }
 
+// Stop unsubscribes the filters from the cometbft events, it's called when the service is
+// shut down.
+func (api *PublicFilterAPI) Stop() {
+	api.events.Stop()
+}
+
 // timeoutLoop runs every 5 minutes and deletes filters that have not been recently used.
 // Tt is started when the api is created.
 func (api *PublicFilterAPI) timeoutLoop() {
@@ -181,6 +200,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 				api.filtersMu.Lock()
 				delete(api.filters, pendingTxSub.ID())
 				api.filtersMu.Unlock()
+				return
 			}
 		}
 	}(pendingTxSub.eventCh, pendingTxSub.Err())
@@ -189,8 +209,9 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 }
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
-// enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// passes the CheckTx of the node and enters the transaction pool. If fullTx is true the full
+// tx is sent to the client, otherwise the hash is sent.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
@@ -198,58 +219,37 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 	rpcSub := notifier.CreateSubscription()
 
-	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
-	defer cancelFn()
-
-	api.events.WithContext(ctx)
-
-	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
-	if err != nil {
-		return nil, err
-	}
+	txsCh := make(chan core.NewTxsEvent, txChanSize)
+	txsSub := api.backend.SubscribeNewTxsEvent(txsCh)
 
-	go func(txsCh <-chan coretypes.ResultEvent) {
-		defer cancelSubs()
+	go func() {
+		defer txsSub.Unsubscribe()
 
+		chainConfig := api.backend.ChainConfig()
 		for {
 			select {
-			case ev, ok := <-txsCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
-
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
-
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
-				if err != nil {
-					api.logger.Debug("fail to decode tx", "error", err.Error())
-					continue
-				}
-
-				for _, msg := range tx.GetMsgs() {
-					ethTx, ok := msg.(*txs.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
+			case ev := <-txsCh:
+				if fullTx != nil && *fullTx {
+					header := api.backend.CurrentHeader()
+					for _, tx := range ev.Txs {
+						_ = notifier.Notify(rpcSub.ID, ethapi.NewRPCPendingTransaction(tx, header, chainConfig))
+					}
+				} else {
+					for _, tx := range ev.Txs {
+						_ = notifier.Notify(rpcSub.ID, tx.Hash())
 					}
 				}
+			case <-txsSub.Err():
+				return
 			case <-rpcSub.Err():
-				pendingTxSub.Unsubscribe(api.events)
 				return
 			case <-notifier.Closed():
-				pendingTxSub.Unsubscribe(api.events)
 				return
 			}
 		}
-	}(pendingTxSub.eventCh)
+	}()
 
-	return rpcSub, err
+	return rpcSub, nil
 }
 
 // NewBlockFilter creates a filter that fetches blocks that are imported into the chain.
@@ -340,10 +340,16 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 					continue
 				}
 
-				baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
+				// the block is committed when the header event is fired, build the header
+				// from the block and its results so it matches eth_getBlockByNumber
+				header, err := api.backend.GetBlockByNumber(rpc.BlockNumber(data.Header.Height), false)
+				if err != nil {
+					api.logger.Debug("failed to build the header of the new block", "height", data.Header.Height, "error", err)
 
-				// TODO: fetch bloom from events
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+					baseFee := types.BaseFeeFromEvents(data.ResultBeginBlock.Events)
+					ethHeader := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
+					header = ethapi.RPCMarshalHeader(ethHeader, common.BytesToHash(data.Header.Hash()))
+				}
 				_ = notifier.Notify(rpcSub.ID, header)
 			case <-rpcSub.Err():
 				headersSub.Unsubscribe(api.events)
@@ -385,11 +391,11 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 				}
 
 				// filter only events from EVM module txs
-				_, isMsgEthereumTx := ev.Events[evmtypes.TypeMsgEthereumTx]
+				_, isMsgEthereumTx := ev.Events[fmt.Sprintf("%s.%s", evmtypes.EventTypeEthereumTx, evmtypes.AttributeKeyEthereumTxHash)]
 
 				if !isMsgEthereumTx {
 					// ignore transaction as it's not from the evm module
-					return
+					continue
 				}
 
 				// get transaction result data
@@ -399,13 +405,13 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 					continue
 				}
 
-				txResponse, err := txs.DecodeTxResponse(dataTx.TxResult.Result.Data)
+				txLogs, err := txLogsFromEventData(dataTx)
 				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
-					return
+					api.logger.Debug("fail to parse tx logs", "error", err)
+					continue
 				}
 
-				logs := FilterLogs(support.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+				logs := FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
 
 				for _, log := range logs {
 					_ = notifier.Notify(rpcSub.ID, log)
@@ -482,13 +488,13 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 					continue
 				}
 
-				txResponse, err := txs.DecodeTxResponse(dataTx.TxResult.Result.Data)
+				txLogs, err := txLogsFromEventData(dataTx)
 				if err != nil {
-					api.logger.Error("fail to decode tx response", "error", err)
-					return
+					api.logger.Debug("fail to parse tx logs", "error", err)
+					continue
 				}
 
-				logs := FilterLogs(support.LogsToEthereum(txResponse.Logs), criteria.FromBlock, criteria.ToBlock, criteria.Addresses, criteria.Topics)
+				logs := FilterLogs(txLogs, criteria.FromBlock, criteria.ToBlock, criteria.Addresses, criteria.Topics)
 
 				api.filtersMu.Lock()
 				if f, found := api.filters[filterID]; found {
@@ -511,10 +517,16 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	return QueryLogs(ctx, api.logger, api.backend, crit)
+}
+
+// QueryLogs returns the logs matching the criteria, it's the single-shot filter run by
+// eth_getLogs, the number of logs and the block range are capped by the backend config.
+func QueryLogs(ctx context.Context, logger log.Logger, backend Backend, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
 	var filter *Filter
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
+		filter = NewBlockFilter(logger, backend, crit)
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -526,11 +538,11 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 			end = crit.ToBlock.Int64()
 		}
 		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
+		filter = NewRangeFilter(logger, backend, begin, end, crit.Addresses, crit.Topics)
 	}
 
 	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	logs, err := filter.Logs(ctx, int(backend.RPCLogsCap()), int64(backend.RPCBlockRangeCap()))
 	if err != nil {
 		return nil, err
 	}
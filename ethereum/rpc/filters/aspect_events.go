@@ -0,0 +1,82 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+// AspectEventsAPI streams the aspect lifecycle events, it's served under the artela namespace.
+type AspectEventsAPI struct {
+	logger    log.Logger
+	clientCtx client.Context
+	events    *EventSystem
+}
+
+// NewAspectEventsAPI creates a new AspectEventsAPI sharing the event system of the filter API.
+func NewAspectEventsAPI(filterAPI *PublicFilterAPI) *AspectEventsAPI {
+	return &AspectEventsAPI{
+		logger:    filterAPI.logger,
+		clientCtx: filterAPI.clientCtx,
+		events:    filterAPI.events,
+	}
+}
+
+// Aspects notifies the deploy, upgrade, bind and unbind events of the aspects executed
+// in the new committed blocks.
+func (api *AspectEventsAPI) Aspects(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	subCtx, cancelFn := context.WithTimeout(context.Background(), deadline)
+	defer cancelFn()
+	api.events.WithContext(subCtx)
+
+	// the aspect operations are ethereum txs, share the subscription of the logs
+	evmSub, cancelSubs, err := api.events.SubscribeLogs(filters.FilterCriteria{})
+	if err != nil {
+		return nil, err
+	}
+
+	go func(eventCh <-chan coretypes.ResultEvent) {
+		defer cancelSubs()
+		defer evmSub.Unsubscribe(api.events)
+
+		for {
+			select {
+			case ev, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				data, ok := ev.Data.(tmtypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+					continue
+				}
+
+				for _, aspectEvent := range rpctypes.ParseAspectEvents(data.Result.Events, data.Height) {
+					_ = notifier.Notify(rpcSub.ID, aspectEvent)
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}(evmSub.eventCh)
+
+	return rpcSub, nil
+}
@@ -23,6 +23,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/artela-network/artela/ethereum/rpc/pubsub"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
 
@@ -36,6 +37,10 @@ var (
 	headerEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
 )
 
+// unsubscribeTimeout is the time the event system waits for the cometbft ws client to send
+// the unsubscription of the events on stop.
+const unsubscribeTimeout = 5 * time.Second
+
 // EventSystem creates subscriptions, processes events and broadcasts them to the
 // subscription which match the subscription criteria using the cometbft's RPC client.
 type EventSystem struct {
@@ -54,6 +59,9 @@ type EventSystem struct {
 	install   chan *Subscription // install filter for event notification
 	uninstall chan *Subscription // remove filter for event notification
 	eventBus  pubsub.EventBus
+
+	quit     chan struct{}
+	stopOnce sync.Once
 }
 
 // NewEventSystem creates a new manager that listens for event on the given mux,
@@ -79,6 +87,7 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 		install:    make(chan *Subscription),
 		uninstall:  make(chan *Subscription),
 		eventBus:   pubsub.NewEventBus(),
+		quit:       make(chan struct{}),
 	}
 
 	go es.eventLoop()
@@ -161,6 +170,13 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 	} else {
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
+	// the finalized and safe blocks are the latest block committed by CometBFT
+	if rpctypes.IsLatestBlockTag(from) {
+		from = rpc.LatestBlockNumber
+	}
+	if rpctypes.IsLatestBlockTag(to) {
+		to = rpc.LatestBlockNumber
+	}
 
 	switch {
 	// only interested in new mined logs, mined logs within a specific block range, or
@@ -192,7 +208,7 @@ func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription
 }
 
 // SubscribeNewHeads subscribes to new block headers events.
-func (es EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
 		id:        rpc.NewID(),
 		typ:       filters.BlocksSubscription,
@@ -206,7 +222,7 @@ func (es EventSystem) SubscribeNewHeads() (*Subscription, pubsub.UnsubscribeFunc
 }
 
 // SubscribePendingTxs subscribes to new pending transactions events from the mempool.
-func (es EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
 		id:        rpc.NewID(),
 		typ:       filters.PendingTransactionsSubscription,
@@ -267,6 +283,23 @@ func (es *EventSystem) eventLoop() {
 	}
 }
 
+// Stop unsubscribes the event system from all the cometbft events, the events are no longer
+// consumed once the cometbft ws client is stopped.
+func (es *EventSystem) Stop() {
+	es.stopOnce.Do(func() {
+		close(es.quit)
+		if es.tmWSClient == nil || !es.tmWSClient.IsActive() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(es.ctx, unsubscribeTimeout)
+		defer cancel()
+		if err := es.tmWSClient.UnsubscribeAll(ctx); err != nil {
+			es.logger.Error("failed to unsubscribe from the cometbft events", "error", err.Error())
+		}
+	})
+}
+
 func (es *EventSystem) consumeEvents() {
 	for {
 		for rpcResp := range es.tmWSClient.ResponsesCh {
@@ -303,6 +336,11 @@ func (es *EventSystem) consumeEvents() {
 			}
 		}
 
-		time.Sleep(time.Second)
+		// the responses channel is closed when the ws client is stopped
+		select {
+		case <-es.quit:
+			return
+		case <-time.After(time.Second):
+		}
 	}
 }
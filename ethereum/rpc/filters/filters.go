@@ -20,6 +20,9 @@ import (
 	"github.com/artela-network/artela/ethereum/rpc/utils"
 )
 
+// errUnknownBlock is returned for a block filter of the zero block hash.
+var errUnknownBlock = errors.New("unknown block")
+
 // BloomIV represents the bit indexes and value inside the bloom filter that belong
 // to some key.
 type BloomIV struct {
@@ -98,47 +101,18 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	var err error
 
 	// If we're doing singleton block filtering, execute and return
-	if f.criteria.BlockHash != nil && *f.criteria.BlockHash != (common.Hash{}) {
-		resBlock, err := f.backend.CosmosBlockByHash(*f.criteria.BlockHash)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch header by hash %s: %w", f.criteria.BlockHash, err)
-		}
-
-		blockRes, err := f.backend.CosmosBlockResultByNumber(&resBlock.Block.Height)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from cometbft", "height", resBlock.Block.Height, "error", err.Error())
-			return nil, nil
-		}
-
-		bloom, err := f.backend.BlockBloom(blockRes)
+	if f.criteria.BlockHash != nil {
+		height, err := f.blockHashHeight()
 		if err != nil {
 			return nil, err
 		}
-
-		return f.blockLogs(blockRes, bloom)
+		return f.heightLogs(height)
 	}
 
 	// Figure out the limits of the filter range
-	header, err := f.backend.HeaderByNumber(context.Background(), rpc.LatestBlockNumber)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch header by number (latest): %w", err)
-	}
-
-	if header == nil || header.Number == nil {
-		f.logger.Debug("header not found or has no number")
-		return nil, nil
-	}
-
-	head := header.Number.Int64()
-	if f.criteria.FromBlock.Int64() < 0 {
-		f.criteria.FromBlock = big.NewInt(head)
-	} else if f.criteria.FromBlock.Int64() == 0 {
-		f.criteria.FromBlock = big.NewInt(1)
-	}
-	if f.criteria.ToBlock.Int64() < 0 {
-		f.criteria.ToBlock = big.NewInt(head)
-	} else if f.criteria.ToBlock.Int64() == 0 {
-		f.criteria.ToBlock = big.NewInt(1)
+	head, ok, err := f.resolveRange()
+	if err != nil || !ok {
+		return nil, err
 	}
 
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
@@ -155,7 +129,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
-	for height := from; height <= to; height++ {
+	heights, err := f.rangeHeights(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, height := range heights {
 		blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from cometbft", "height", height, "error", err.Error())
@@ -181,6 +160,82 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// blockHashHeight resolves the block hash of a block filter to the height of the block,
+// through the block hash index rather than scanning the heights.
+func (f *Filter) blockHashHeight() (int64, error) {
+	hash := *f.criteria.BlockHash
+	if hash == (common.Hash{}) {
+		return 0, errUnknownBlock
+	}
+
+	height, err := f.backend.BlockNumberByHash(hash)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch header by hash %s: %w", hash, err)
+	}
+	return height, nil
+}
+
+// resolveRange replaces the latest and the genesis block numbers of the filter range with
+// the heights they stand for, it returns the latest height, false if it's not known.
+func (f *Filter) resolveRange() (int64, bool, error) {
+	header, err := f.backend.HeaderByNumber(context.Background(), rpc.LatestBlockNumber)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to fetch header by number (latest): %w", err)
+	}
+
+	if header == nil || header.Number == nil {
+		f.logger.Debug("header not found or has no number")
+		return 0, false, nil
+	}
+
+	head := header.Number.Int64()
+	if f.criteria.FromBlock.Int64() < 0 {
+		f.criteria.FromBlock = big.NewInt(head)
+	} else if f.criteria.FromBlock.Int64() == 0 {
+		f.criteria.FromBlock = big.NewInt(1)
+	}
+	if f.criteria.ToBlock.Int64() < 0 {
+		f.criteria.ToBlock = big.NewInt(head)
+	} else if f.criteria.ToBlock.Int64() == 0 {
+		f.criteria.ToBlock = big.NewInt(1)
+	}
+	return head, true, nil
+}
+
+// rangeHeights returns the heights of the blocks to scan in [from, to]. If the filter has both
+// addresses and topic0 criteria, the heights covered by the log index of the indexer are looked
+// up in the index. The heights covered by the bloom bits of the indexer are then looked up in
+// the bloom bits, the rest of the range is scanned with the blooms.
+func (f *Filter) rangeHeights(from, to int64) ([]int64, error) {
+	var heights []int64
+	if len(f.criteria.Addresses) > 0 && len(f.criteria.Topics) > 0 && len(f.criteria.Topics[0]) > 0 {
+		indexed, indexedTo, ok, err := f.backend.BlocksByLogTopics(f.criteria.Addresses, f.criteria.Topics[0], from, to)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heights = indexed
+			from = indexedTo + 1
+		}
+	}
+
+	if from <= to && (len(f.criteria.Addresses) > 0 || len(f.criteria.Topics) > 0) {
+		matched, indexedTo, ok, err := f.backend.BlocksByBloomBits(f.criteria.Addresses, f.criteria.Topics, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			heights = append(heights, matched...)
+			from = indexedTo + 1
+		}
+	}
+
+	for height := from; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,215 @@
+package filters
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// errInvalidLogCursor is returned for a cursor not issued by a previous page.
+var errInvalidLogCursor = errors.New("invalid logs cursor")
+
+// LogsPage is a page of the logs matching a filter, Cursor continues the query from the log
+// following the page, it's empty if the page is the last one.
+type LogsPage struct {
+	Logs   []*ethtypes.Log `json:"logs"`
+	Cursor string          `json:"cursor,omitempty"`
+}
+
+// LogsPageAPI pages the historical log queries exceeding the block range or the results cap
+// of eth_getLogs, it's served under the artela namespace.
+type LogsPageAPI struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewLogsPageAPI creates a new LogsPageAPI sharing the backend of the filter API.
+func NewLogsPageAPI(filterAPI *PublicFilterAPI) *LogsPageAPI {
+	return &LogsPageAPI{
+		logger:  filterAPI.logger,
+		backend: filterAPI.backend,
+	}
+}
+
+// GetLogsPage returns a page of the logs matching the given criteria, starting from the
+// cursor returned by the previous page, or from the start of the range if the cursor is
+// empty. A page scans at most the block range cap of eth_getLogs and holds at most limit
+// logs, capped by the results cap of eth_getLogs. The cursor must be passed with the same
+// criteria it's returned for.
+func (api *LogsPageAPI) GetLogsPage(ctx context.Context, crit filters.FilterCriteria, cursor string, limit *hexutil.Uint) (*LogsPage, error) {
+	logLimit := int(api.backend.RPCLogsCap())
+	if limit != nil && *limit > 0 && (logLimit <= 0 || int(*limit) < logLimit) {
+		logLimit = int(*limit)
+	}
+
+	var filter *Filter
+	if crit.BlockHash != nil {
+		filter = NewBlockFilter(api.logger, api.backend, crit)
+	} else {
+		begin := rpc.LatestBlockNumber.Int64()
+		if crit.FromBlock != nil {
+			begin = crit.FromBlock.Int64()
+		}
+		end := rpc.LatestBlockNumber.Int64()
+		if crit.ToBlock != nil {
+			end = crit.ToBlock.Int64()
+		}
+		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
+	}
+
+	logs, next, err := filter.LogsPage(ctx, cursor, logLimit, int64(api.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, err
+	}
+	return &LogsPage{Logs: returnLogs(logs), Cursor: next}, nil
+}
+
+// LogsPage searches the blocks of the filter from the given cursor, it returns at most
+// logLimit logs from at most blockLimit blocks, a non-positive limit isn't applied. The
+// returned cursor points to the log following the page, it's empty if the range is done.
+func (f *Filter) LogsPage(_ context.Context, cursor string, logLimit int, blockLimit int64) ([]*ethtypes.Log, string, error) {
+	var (
+		start int64
+		skip  uint
+	)
+	if cursor != "" {
+		var err error
+		if start, skip, err = decodeLogCursor(cursor); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// a block filter pages the logs of the single block
+	if f.criteria.BlockHash != nil {
+		height, err := f.blockHashHeight()
+		if err != nil {
+			return nil, "", err
+		}
+		if cursor != "" && start != height {
+			return nil, "", errInvalidLogCursor
+		}
+
+		logs, err := f.heightLogs(height)
+		if err != nil {
+			return nil, "", err
+		}
+		page, next := pageLogs(nil, skipLogs(logs, skip), height, logLimit)
+		return page, next, nil
+	}
+
+	head, ok, err := f.resolveRange()
+	if err != nil || !ok {
+		return nil, "", err
+	}
+
+	from := f.criteria.FromBlock.Int64()
+	to := f.criteria.ToBlock.Int64()
+	if to > head {
+		to = head
+	}
+	if cursor != "" {
+		if start < from || start > to {
+			return nil, "", errInvalidLogCursor
+		}
+		from = start
+	}
+	if from > to {
+		return []*ethtypes.Log{}, "", nil
+	}
+
+	end := to
+	if blockLimit > 0 && end-from+1 > blockLimit {
+		end = from + blockLimit - 1
+	}
+
+	heights, err := f.rangeHeights(from, end)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		filtered, err := f.heightLogs(height)
+		if err != nil {
+			return nil, "", err
+		}
+		if height == start {
+			filtered = skipLogs(filtered, skip)
+		}
+
+		var next string
+		if logs, next = pageLogs(logs, filtered, height, logLimit); next != "" {
+			return logs, next, nil
+		}
+	}
+
+	if end < to {
+		return logs, encodeLogCursor(end+1, 0), nil
+	}
+	return logs, "", nil
+}
+
+// heightLogs returns the logs of the block at the given height matching the filter.
+func (f *Filter) heightLogs(height int64) ([]*ethtypes.Log, error) {
+	blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block result at height %d: %w", height, err)
+	}
+
+	bloom, err := f.backend.BlockBloom(blockRes)
+	if err != nil {
+		return nil, err
+	}
+	return f.blockLogs(blockRes, bloom)
+}
+
+// pageLogs appends the logs of the block at the given height to the page, if the page
+// overflows the limit, the logs are cut and the cursor of the first one left is returned.
+func pageLogs(page, logs []*ethtypes.Log, height int64, limit int) ([]*ethtypes.Log, string) {
+	if limit > 0 && len(page)+len(logs) > limit {
+		n := limit - len(page)
+		return append(page, logs[:n]...), encodeLogCursor(height, logs[n].Index)
+	}
+	return append(page, logs...), ""
+}
+
+// skipLogs drops the logs before the given log index of the block.
+func skipLogs(logs []*ethtypes.Log, index uint) []*ethtypes.Log {
+	for i, l := range logs {
+		if l.Index >= index {
+			return logs[i:]
+		}
+	}
+	return []*ethtypes.Log{}
+}
+
+// encodeLogCursor encodes the height of a block and the index of a log in the block to
+// an opaque cursor.
+func encodeLogCursor(height int64, index uint) string {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(height)) // #nosec G701
+	binary.BigEndian.PutUint64(bz[8:], uint64(index))
+	return hexutil.Encode(bz)
+}
+
+// decodeLogCursor decodes a cursor returned by encodeLogCursor.
+func decodeLogCursor(cursor string) (int64, uint, error) {
+	bz, err := hexutil.Decode(cursor)
+	if err != nil || len(bz) != 16 {
+		return 0, 0, errInvalidLogCursor
+	}
+
+	height := binary.BigEndian.Uint64(bz)
+	if height == 0 || height > math.MaxInt64 {
+		return 0, 0, errInvalidLogCursor
+	}
+	return int64(height), uint(binary.BigEndian.Uint64(bz[8:])), nil // #nosec G701
+}
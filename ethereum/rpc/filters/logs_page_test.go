@@ -0,0 +1,35 @@
+package filters
+
+import (
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCursorRoundTrip(t *testing.T) {
+	height, index, err := decodeLogCursor(encodeLogCursor(1234, 56))
+	require.NoError(t, err)
+	require.Equal(t, int64(1234), height)
+	require.Equal(t, uint(56), index)
+
+	for _, cursor := range []string{"0x", "0x1234", "zz", encodeLogCursor(0, 1)} {
+		_, _, err := decodeLogCursor(cursor)
+		require.ErrorIs(t, err, errInvalidLogCursor, cursor)
+	}
+}
+
+func TestPageLogs(t *testing.T) {
+	logs := []*ethtypes.Log{{Index: 3}, {Index: 5}, {Index: 8}}
+
+	page, next := pageLogs([]*ethtypes.Log{{Index: 1}}, logs, 10, 2)
+	require.Len(t, page, 2)
+	require.Equal(t, encodeLogCursor(10, 5), next)
+
+	page, next = pageLogs(nil, logs, 10, 3)
+	require.Len(t, page, 3)
+	require.Empty(t, next)
+
+	require.Equal(t, logs[1:], skipLogs(logs, 4))
+	require.Empty(t, skipLogs(logs, 9))
+}
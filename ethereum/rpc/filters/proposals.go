@@ -0,0 +1,68 @@
+package filters
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+// proposalBuffer is the buffer size of the block proposals of a subscription
+const proposalBuffer = 4
+
+// ProposalBackend defines the methods required by the ProposalsAPI
+type ProposalBackend interface {
+	SubscribeProposals(ch chan<- rpctypes.BlockProposal) event.Subscription
+}
+
+// ProposalsAPI streams the block proposals being voted, it's served under the artela namespace.
+type ProposalsAPI struct {
+	logger  log.Logger
+	backend ProposalBackend
+}
+
+// NewProposalsAPI creates a new ProposalsAPI.
+func NewProposalsAPI(logger log.Logger, backend ProposalBackend) *ProposalsAPI {
+	return &ProposalsAPI{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// UnsafeProposals notifies the block proposals processed by the node while they are being
+// voted, with the hashes of their ethereum txs. The proposals are pre-confirmation signals
+// only, they may be rejected and never committed, consumers must wait for newHeads for
+// finality.
+func (api *ProposalsAPI) UnsafeProposals(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	proposalCh := make(chan rpctypes.BlockProposal, proposalBuffer)
+	proposalSub := api.backend.SubscribeProposals(proposalCh)
+
+	go func() {
+		defer proposalSub.Unsubscribe()
+
+		for {
+			select {
+			case proposal := <-proposalCh:
+				_ = notifier.Notify(rpcSub.ID, proposal)
+			case <-proposalSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -0,0 +1,93 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+// sendSyncTimeout is the default and the max time artela_sendRawTransactionSync waits for
+// the tx to be committed.
+const sendSyncTimeout = 30 * time.Second
+
+// SendSyncBackend defines the methods required by the SendSyncAPI
+type SendSyncBackend interface {
+	ethapi.Backend
+	TxStatusBackend
+}
+
+// SendSyncAPI submits the txs and waits for them to be committed, like the broadcast_tx_commit
+// of cometbft, it's served under the artela namespace.
+type SendSyncAPI struct {
+	status  *TransactionStatusAPI
+	backend SendSyncBackend
+}
+
+// NewSendSyncAPI creates a new SendSyncAPI sharing the event system of the filter API.
+func NewSendSyncAPI(filterAPI *PublicFilterAPI, backend SendSyncBackend) *SendSyncAPI {
+	return &SendSyncAPI{
+		status:  NewTransactionStatusAPI(filterAPI, backend),
+		backend: backend,
+	}
+}
+
+// SendRawTransactionSync submits the signed tx like eth_sendRawTransaction and waits until
+// it's committed, it returns the receipt of the tx, which has a failed status if the
+// execution reverted. The tx rejected by the mempool fails immediately. The timeout in
+// milliseconds can only shorten the default timeout of the node, the tx may still be
+// committed after the timeout.
+func (api *SendSyncAPI) SendRawTransactionSync(ctx context.Context, input hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return nil, err
+	}
+	hash := tx.Hash()
+
+	wait := sendSyncTimeout
+	if timeout != nil && time.Duration(*timeout)*time.Millisecond < wait {
+		wait = time.Duration(*timeout) * time.Millisecond
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	// subscribe before the submission, not to miss the execution of the tx
+	subCtx, cancelFn := context.WithTimeout(context.Background(), deadline)
+	defer cancelFn()
+	api.status.events.WithContext(subCtx)
+
+	txSub, cancelSubs, err := api.status.events.SubscribePendingTxs()
+	if err != nil {
+		return nil, err
+	}
+	defer cancelSubs()
+	defer txSub.Unsubscribe(api.status.events)
+
+	if _, err := ethapi.SubmitTransaction(ctx, api.status.logger, api.backend, tx); err != nil {
+		return nil, err
+	}
+
+	var executed *rpctypes.TxStatus
+	for executed == nil {
+		select {
+		case ev, ok := <-txSub.eventCh:
+			if !ok {
+				return nil, fmt.Errorf("tx %s is submitted, the subscription of its execution is closed", hash.Hex())
+			}
+			executed = api.status.executedStatus(ev, hash)
+		case <-waitCtx.Done():
+			return nil, fmt.Errorf("tx %s is submitted, but not committed within %s", hash.Hex(), wait)
+		}
+	}
+
+	committed := api.status.committedStatus(executed)
+	if committed.Receipt == nil {
+		return nil, fmt.Errorf("tx %s is committed at height %d, but its receipt is not available", hash.Hex(), *committed.BlockNumber)
+	}
+	return committed.Receipt, nil
+}
@@ -0,0 +1,181 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+const (
+	// txStatusBuffer is the buffer size of the lifecycle stages of a subscription
+	txStatusBuffer = 16
+	// receiptRetries is the number of attempts to fetch the receipt of an executed tx,
+	// the receipt is served once the block is indexed, which may lag behind the events
+	receiptRetries  = 10
+	receiptInterval = 500 * time.Millisecond
+)
+
+// TxStatusBackend defines the methods required by the TransactionStatusAPI
+type TxStatusBackend interface {
+	SubscribeTxStatus(ch chan<- rpctypes.TxStatus) event.Subscription
+	TxStatus(ctx context.Context, hash common.Hash) (*rpctypes.TxStatus, error)
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+}
+
+// TransactionStatusAPI streams the lifecycle stages of ethereum txs, it's served under the
+// artela namespace.
+type TransactionStatusAPI struct {
+	logger    log.Logger
+	clientCtx client.Context
+	events    *EventSystem
+	backend   TxStatusBackend
+}
+
+// NewTransactionStatusAPI creates a new TransactionStatusAPI sharing the event system of the filter API.
+func NewTransactionStatusAPI(filterAPI *PublicFilterAPI, backend TxStatusBackend) *TransactionStatusAPI {
+	return &TransactionStatusAPI{
+		logger:    filterAPI.logger,
+		clientCtx: filterAPI.clientCtx,
+		events:    filterAPI.events,
+		backend:   backend,
+	}
+}
+
+// TransactionStatus notifies the lifecycle stages of the tx: submitted, checked (or
+// rejected), proposed, executed and committed, the stages observed before the
+// subscription are skipped. The committed stage carries the receipt of the tx and ends
+// the notifications.
+func (api *TransactionStatusAPI) TransactionStatus(ctx context.Context, hash common.Hash) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	statusCh := make(chan rpctypes.TxStatus, txStatusBuffer)
+	statusSub := api.backend.SubscribeTxStatus(statusCh)
+
+	subCtx, cancelFn := context.WithTimeout(context.Background(), deadline)
+	defer cancelFn()
+	api.events.WithContext(subCtx)
+
+	txSub, cancelSubs, err := api.events.SubscribePendingTxs()
+	if err != nil {
+		statusSub.Unsubscribe()
+		return nil, err
+	}
+
+	current, err := api.backend.TxStatus(ctx, hash)
+	if err != nil {
+		api.logger.Debug("failed to query tx status", "hash", hash.Hex(), "error", err.Error())
+	}
+
+	go func(txsCh <-chan coretypes.ResultEvent) {
+		defer cancelSubs()
+		defer statusSub.Unsubscribe()
+		defer txSub.Unsubscribe(api.events)
+
+		if current != nil {
+			_ = notifier.Notify(rpcSub.ID, current)
+			if current.Final() {
+				return
+			}
+		}
+
+		for {
+			select {
+			case status := <-statusCh:
+				if status.Hash != hash {
+					continue
+				}
+				_ = notifier.Notify(rpcSub.ID, status)
+				if status.Final() {
+					return
+				}
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+				executed := api.executedStatus(ev, hash)
+				if executed == nil {
+					continue
+				}
+				_ = notifier.Notify(rpcSub.ID, executed)
+				// stop receiving the stages while waiting for the receipt, not to block the publishers
+				statusSub.Unsubscribe()
+				_ = notifier.Notify(rpcSub.ID, api.committedStatus(executed))
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}(txSub.eventCh)
+
+	return rpcSub, nil
+}
+
+// executedStatus returns the executed stage of the tx if the event is the result of it.
+func (api *TransactionStatusAPI) executedStatus(ev coretypes.ResultEvent, hash common.Hash) *rpctypes.TxStatus {
+	data, ok := ev.Data.(tmtypes.EventDataTx)
+	if !ok {
+		api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+		return nil
+	}
+
+	tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
+	if err != nil {
+		return nil
+	}
+	parsed, err := rpctypes.ParseTxResult(&data.Result, tx)
+	if err != nil {
+		api.logger.Debug("failed to parse tx result", "error", err.Error())
+		return nil
+	}
+	parsedTx := parsed.GetTxByHash(hash)
+	if parsedTx == nil {
+		return nil
+	}
+
+	blockNumber := hexutil.Uint64(data.Height)
+	gasUsed := hexutil.Uint64(parsedTx.GasUsed)
+	failed := parsedTx.Failed
+	return &rpctypes.TxStatus{
+		Hash:        hash,
+		Stage:       rpctypes.TxStageExecuted,
+		BlockNumber: &blockNumber,
+		Failed:      &failed,
+		GasUsed:     &gasUsed,
+	}
+}
+
+// committedStatus returns the committed stage of the executed tx, waiting for the receipt.
+func (api *TransactionStatusAPI) committedStatus(executed *rpctypes.TxStatus) *rpctypes.TxStatus {
+	committed := &rpctypes.TxStatus{
+		Hash:        executed.Hash,
+		Stage:       rpctypes.TxStageCommitted,
+		BlockNumber: executed.BlockNumber,
+	}
+	for i := 0; i < receiptRetries; i++ {
+		receipt, err := api.backend.GetTransactionReceipt(context.Background(), executed.Hash)
+		if err == nil && receipt != nil {
+			committed.Receipt = receipt
+			break
+		}
+		time.Sleep(receiptInterval)
+	}
+	return committed
+}
@@ -3,10 +3,28 @@ package filters
 import (
 	"math/big"
 
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/ethereum/rpc/utils"
 )
 
+// txLogsFromEventData returns the logs of all the ethereum msgs in a committed cosmos tx,
+// the logs are parsed from the tx log events so the txs with multiple msgs are covered.
+func txLogsFromEventData(data tmtypes.EventDataTx) ([]*ethtypes.Log, error) {
+	allLogs, err := utils.AllTxLogsFromEvents(data.Result.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*ethtypes.Log
+	for _, msgLogs := range allLogs {
+		logs = append(logs, msgLogs...)
+	}
+	return logs, nil
+}
+
 // FilterLogs creates a slice of logs matching the given criteria.
 // [] -> anything
 // [A] -> A in first position of log topics, anything after
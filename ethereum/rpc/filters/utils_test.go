@@ -0,0 +1,54 @@
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func txLogEvent(t *testing.T, logs ...*support.Log) abci.Event {
+	event := abci.Event{Type: evmtypes.EventTypeTxLog}
+	for _, log := range logs {
+		bz, err := json.Marshal(log)
+		require.NoError(t, err)
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)})
+	}
+	return event
+}
+
+func TestTxLogsFromEventDataFiltering(t *testing.T) {
+	addr1 := common.HexToAddress("0x1000")
+	addr2 := common.HexToAddress("0x2000")
+	topic1 := common.HexToHash("0x01")
+	topic2 := common.HexToHash("0x02")
+
+	data := tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 10,
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			txLogEvent(t,
+				&support.Log{Address: addr1.Hex(), Topics: []string{topic1.Hex()}, BlockNumber: 10},
+				&support.Log{Address: addr2.Hex(), Topics: []string{topic2.Hex()}, BlockNumber: 10},
+			),
+			{Type: evmtypes.EventTypeEthereumTx},
+			// logs of the second msg of the tx
+			txLogEvent(t, &support.Log{Address: addr1.Hex(), Topics: []string{topic2.Hex()}, BlockNumber: 10}),
+		}},
+	}}
+
+	logs, err := txLogsFromEventData(data)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	require.Len(t, FilterLogs(logs, nil, nil, []common.Address{addr1}, nil), 2)
+	require.Len(t, FilterLogs(logs, nil, nil, nil, [][]common.Hash{{topic2}}), 2)
+	require.Len(t, FilterLogs(logs, nil, nil, []common.Address{addr1}, [][]common.Hash{{topic2}}), 1)
+	require.Len(t, FilterLogs(logs, big.NewInt(11), nil, nil, nil), 0)
+}
@@ -0,0 +1,238 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// ServiceName is the name of the gRPC service serving the eth methods
+	ServiceName = "artela.ethereum.v1.Eth"
+	// RoutePrefix is the prefix of the REST routes serving the eth methods
+	RoutePrefix = "/artela/ethereum/v1"
+
+	// json-rpc error codes of go-ethereum/rpc
+	errCodeMethodNotFound = -32601
+	errCodeInvalidParams  = -32602
+)
+
+// Methods maps the gRPC method names of the bridge to the JSON-RPC methods they mirror,
+// only the methods reading the chain data are bridged.
+var Methods = map[string]string{
+	"ChainId":               "eth_chainId",
+	"BlockNumber":           "eth_blockNumber",
+	"GasPrice":              "eth_gasPrice",
+	"MaxPriorityFeePerGas":  "eth_maxPriorityFeePerGas",
+	"FeeHistory":            "eth_feeHistory",
+	"GetBalance":            "eth_getBalance",
+	"GetTransactionCount":   "eth_getTransactionCount",
+	"GetCode":               "eth_getCode",
+	"GetStorageAt":          "eth_getStorageAt",
+	"Call":                  "eth_call",
+	"EstimateGas":           "eth_estimateGas",
+	"GetBlockByNumber":      "eth_getBlockByNumber",
+	"GetBlockByHash":        "eth_getBlockByHash",
+	"GetTransactionByHash":  "eth_getTransactionByHash",
+	"GetTransactionReceipt": "eth_getTransactionReceipt",
+	"GetLogs":               "eth_getLogs",
+}
+
+// ErrNotReady is returned before the JSON-RPC server of the node is attached to the bridge.
+var ErrNotReady = errors.New("JSON-RPC server is not ready")
+
+// caller is the handler type of the gRPC service.
+type caller interface {
+	Call(ctx context.Context, method string, params []interface{}) (json.RawMessage, error)
+}
+
+// Bridge serves the eth methods over gRPC and REST with the same params and results
+// as JSON-RPC, so clients without JSON-RPC connectivity can read the chain data.
+// The gRPC requests carry the positional params as a google.protobuf.ListValue and
+// the responses carry the result as a google.protobuf.Value.
+type Bridge struct {
+	mu     sync.RWMutex
+	client *rpc.Client
+}
+
+// NewBridge creates a new bridge, the requests are rejected until a client is set.
+func NewBridge() *Bridge {
+	return &Bridge{}
+}
+
+// SetClient sets the client of the JSON-RPC server the requests are forwarded to.
+func (b *Bridge) SetClient(client *rpc.Client) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.client = client
+}
+
+// Call calls the JSON-RPC method with the positional params, the method must be bridged.
+func (b *Bridge) Call(ctx context.Context, method string, params []interface{}) (json.RawMessage, error) {
+	b.mu.RLock()
+	client := b.client
+	b.mu.RUnlock()
+	if client == nil {
+		return nil, ErrNotReady
+	}
+
+	var result json.RawMessage
+	if err := client.CallContext(ctx, &result, method, params...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RegisterGRPCServer registers the gRPC service of the bridge.
+func (b *Bridge) RegisterGRPCServer(server gogogrpc.Server) {
+	desc := &grpc.ServiceDesc{
+		ServiceName: ServiceName,
+		HandlerType: (*caller)(nil),
+		Methods:     make([]grpc.MethodDesc, 0, len(Methods)),
+		Streams:     []grpc.StreamDesc{},
+	}
+	for name, method := range Methods {
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: name,
+			Handler:    grpcHandler(name, method),
+		})
+	}
+	server.RegisterService(desc, b)
+}
+
+func grpcHandler(name, method string) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(structpb.ListValue)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			result, err := srv.(caller).Call(ctx, method, req.(*structpb.ListValue).AsSlice())
+			if err != nil {
+				return nil, toStatusError(err)
+			}
+
+			out := new(structpb.Value)
+			if err := protojson.Unmarshal(result, out); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return out, nil
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fmt.Sprintf("/%s/%s", ServiceName, name),
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+// RegisterRoutes registers the REST routes of the bridge, the params are posted as
+// a JSON array and the result is returned in the same envelope as JSON-RPC.
+func (b *Bridge) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc(RoutePrefix+"/{method}", b.handleREST).Methods(http.MethodGet, http.MethodPost)
+}
+
+func (b *Bridge) handleREST(w http.ResponseWriter, r *http.Request) {
+	method, ok := Methods[mux.Vars(r)["method"]]
+	if !ok {
+		writeREST(w, http.StatusNotFound, nil, errors.New("method not supported"))
+		return
+	}
+
+	var params []interface{}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeREST(w, http.StatusBadRequest, nil, err)
+		return
+	}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &params); err != nil {
+			writeREST(w, http.StatusBadRequest, nil, fmt.Errorf("params must be a JSON array: %w", err))
+			return
+		}
+	}
+
+	result, err := b.Call(r.Context(), method, params)
+	if err != nil {
+		writeREST(w, httpStatus(err), nil, err)
+		return
+	}
+	writeREST(w, http.StatusOK, result, nil)
+}
+
+type restError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type restResponse struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *restError      `json:"error,omitempty"`
+}
+
+func writeREST(w http.ResponseWriter, code int, result json.RawMessage, err error) {
+	res := restResponse{Result: result}
+	if err != nil {
+		res.Error = &restError{Message: err.Error()}
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			res.Error.Code = rpcErr.ErrorCode()
+		}
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			res.Error.Data = dataErr.ErrorData()
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, ErrNotReady) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case errCodeInvalidParams:
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errCodeMethodNotFound:
+			return status.Error(codes.Unimplemented, err.Error())
+		}
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func httpStatus(err error) int {
+	switch status.Code(toStatusError(err)) {
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type stubCaller struct {
+	method string
+	params []interface{}
+}
+
+func (s *stubCaller) Call(_ context.Context, method string, params []interface{}) (json.RawMessage, error) {
+	s.method, s.params = method, params
+	return json.RawMessage(`{"number":"0x10","transactions":[]}`), nil
+}
+
+func TestGRPCHandler(t *testing.T) {
+	params, err := structpb.NewList([]interface{}{"0x10", false})
+	require.NoError(t, err)
+
+	stub := new(stubCaller)
+	handler := grpcHandler("GetBlockByNumber", Methods["GetBlockByNumber"])
+	res, err := handler(stub, context.Background(), func(in interface{}) error {
+		proto.Merge(in.(*structpb.ListValue), params)
+		return nil
+	}, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "eth_getBlockByNumber", stub.method)
+	require.Equal(t, []interface{}{"0x10", false}, stub.params)
+	require.Equal(t, "0x10", res.(*structpb.Value).GetStructValue().GetFields()["number"].GetStringValue())
+}
+
+func TestBridgeNotReady(t *testing.T) {
+	_, err := NewBridge().Call(context.Background(), "eth_chainId", nil)
+	require.ErrorIs(t, err, ErrNotReady)
+	require.Equal(t, codes.Unavailable, status.Code(toStatusError(err)))
+}
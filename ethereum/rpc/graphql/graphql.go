@@ -0,0 +1,678 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	artfilters "github.com/artela-network/artela/ethereum/rpc/filters"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+var errTxNotFound = errors.New("transaction not found")
+
+// Backend defines the methods required by the GraphQL resolvers, they are the ones
+// serving the eth JSON-RPC methods.
+type Backend interface {
+	ethapi.Backend
+	artfilters.Backend
+
+	BlockNumber() (hexutil.Uint64, error)
+}
+
+// Long is a 64 bit unsigned integer input, it's accepted as a JSON number or as a
+// decimal or 0x-prefixed hexadecimal string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) // #nosec G701
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs is the optional block an account is read at.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOr returns the requested block, or the given one if no block is requested.
+func (a BlockNumberArgs) NumberOr(current rpc.BlockNumberOrHash) rpc.BlockNumberOrHash {
+	if a.Block != nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(*a.Block))
+	}
+	return current
+}
+
+// Account is an account at a particular block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+func (a *Account) Address(_ context.Context) (common.Address, error) {
+	return a.address, nil
+}
+
+func (a *Account) Balance(_ context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(a.address, a.blockNrOrHash)
+	if err != nil || balance == nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(_ context.Context) (hexutil.Uint64, error) {
+	nonce, err := a.r.backend.GetTransactionCount(a.address, a.blockNrOrHash)
+	if err != nil || nonce == nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(_ context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(_ context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log is an EVM log.
+type Log struct {
+	r   *Resolver
+	log *ethtypes.Log
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:             l.r,
+		address:       l.log.Address,
+		blockNrOrHash: args.NumberOr(blockNumber(l.log.BlockNumber)),
+	}
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return &Transaction{r: l.r, hash: l.log.TxHash}
+}
+
+// Transaction is an ethereum tx, the tx and its receipt are loaded on first access.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash
+
+	mu      sync.Mutex
+	tx      *ethapi.RPCTransaction
+	receipt map[string]interface{}
+}
+
+// resolve loads the tx, it's the pending tx if the tx is not included yet.
+func (t *Transaction) resolve(ctx context.Context) (*ethapi.RPCTransaction, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.tx != nil {
+		return t.tx, nil
+	}
+	tx, err := t.r.backend.GetTransaction(ctx, t.hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, errTxNotFound
+	}
+	t.tx = tx
+	return tx, nil
+}
+
+// resolveReceipt loads the receipt of the tx, nil if the tx is not included yet.
+func (t *Transaction) resolveReceipt(ctx context.Context) (map[string]interface{}, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.BlockHash == nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.receipt != nil {
+		return t.receipt, nil
+	}
+	receipt, err := t.r.backend.GetTransactionReceipt(ctx, t.hash)
+	if err != nil {
+		return nil, err
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Nonce, nil
+}
+
+func (t *Transaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.TransactionIndex, nil
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t.r.account(tx.From, args.NumberOr(txBlock(tx))), nil
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.To == nil {
+		return nil, err
+	}
+	return t.r.account(*tx.To, args.NumberOr(txBlock(tx))), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.Value == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.Value, nil
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.GasPrice == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.GasPrice, nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasFeeCap, nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GasTipCap, nil
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if price, ok := receipt["effectiveGasPrice"].(hexutil.Big); ok {
+		return &price, nil
+	}
+	// the gas price of the legacy txs is the effective gas price
+	return t.tx.GasPrice, nil
+}
+
+func (t *Transaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return tx.Gas, nil
+}
+
+func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Input, nil
+}
+
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.BlockHash == nil {
+		return nil, err
+	}
+	return t.r.blockByHash(ctx, *tx.BlockHash)
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status, ok := receipt["status"].(hexutil.Uint)
+	if !ok {
+		return nil, nil
+	}
+	ret := hexutil.Uint64(status)
+	return &ret, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return t.receiptUint64(ctx, "gasUsed")
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	return t.receiptUint64(ctx, "cumulativeGasUsed")
+}
+
+func (t *Transaction) receiptUint64(ctx context.Context, field string) (*hexutil.Uint64, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	value, ok := receipt[field].(hexutil.Uint64)
+	if !ok {
+		return nil, nil
+	}
+	return &value, nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	address, ok := receipt["contractAddress"].(common.Address)
+	if !ok {
+		return nil, nil
+	}
+	return t.r.account(address, args.NumberOr(txBlock(t.tx))), nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.resolveReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs, _ := receipt["logs"].([]*ethtypes.Log)
+	ret := t.r.logs(logs)
+	return &ret, nil
+}
+
+func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.R == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.R, nil
+}
+
+func (t *Transaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.S == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.S, nil
+}
+
+func (t *Transaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx.V == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.V, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx.Type, nil
+}
+
+// Block is a block, the hash is the cometbft block hash as served by eth_getBlockByNumber.
+type Block struct {
+	r     *Resolver
+	block *rpctypes.Block
+}
+
+func (b *Block) number() rpc.BlockNumberOrHash {
+	return blockNumber(b.block.Header().Number.Uint64())
+}
+
+func (b *Block) Number(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(b.block.Header().Number.Uint64())
+}
+
+func (b *Block) Hash(_ context.Context) common.Hash {
+	return b.block.Hash()
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	number := b.block.Header().Number.Int64()
+	if number <= 1 {
+		return nil, nil
+	}
+	return b.r.blockByNumber(ctx, rpc.BlockNumber(number-1))
+}
+
+func (b *Block) Nonce(_ context.Context) hexutil.Bytes {
+	return b.block.Header().Nonce[:]
+}
+
+func (b *Block) TransactionsRoot(_ context.Context) common.Hash {
+	return b.block.Header().TxHash
+}
+
+func (b *Block) TransactionCount(_ context.Context) *hexutil.Uint64 {
+	count := hexutil.Uint64(len(b.block.Transactions()))
+	return &count
+}
+
+func (b *Block) StateRoot(_ context.Context) common.Hash {
+	return b.block.Header().Root
+}
+
+func (b *Block) ReceiptsRoot(_ context.Context) common.Hash {
+	return b.block.Header().ReceiptHash
+}
+
+func (b *Block) Miner(_ context.Context, args BlockNumberArgs) *Account {
+	return b.r.account(b.block.Header().Coinbase, args.NumberOr(b.number()))
+}
+
+func (b *Block) ExtraData(_ context.Context) hexutil.Bytes {
+	return b.block.Header().Extra
+}
+
+func (b *Block) GasLimit(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(b.block.Header().GasLimit)
+}
+
+func (b *Block) GasUsed(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(b.block.Header().GasUsed)
+}
+
+func (b *Block) BaseFeePerGas(_ context.Context) *hexutil.Big {
+	return (*hexutil.Big)(b.block.Header().BaseFee)
+}
+
+func (b *Block) Timestamp(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(b.block.Header().Time)
+}
+
+func (b *Block) LogsBloom(_ context.Context) hexutil.Bytes {
+	return b.block.Header().Bloom.Bytes()
+}
+
+func (b *Block) Transactions(_ context.Context) *[]*Transaction {
+	txs := b.block.Transactions()
+	ret := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		ret = append(ret, &Transaction{r: b.r, hash: tx.Hash()})
+	}
+	return &ret
+}
+
+func (b *Block) TransactionAt(_ context.Context, args struct{ Index Long }) *Transaction {
+	txs := b.block.Transactions()
+	if args.Index < 0 || int(args.Index) >= len(txs) {
+		return nil
+	}
+	return &Transaction{r: b.r, hash: txs[args.Index].Hash()}
+}
+
+// BlockFilterCriteria is the log filter of a single block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash := b.block.Hash()
+	crit := filters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return b.r.filterLogs(ctx, crit)
+}
+
+func (b *Block) Account(_ context.Context, args struct{ Address common.Address }) *Account {
+	return b.r.account(args.Address, b.number())
+}
+
+// FilterCriteria is the log filter of a block range.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+// Resolver is the root resolver of the GraphQL queries and mutations.
+type Resolver struct {
+	logger  log.Logger
+	backend Backend
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	if args.Hash != nil {
+		return r.blockByHash(ctx, *args.Hash)
+	}
+	number := rpc.LatestBlockNumber
+	if args.Number != nil {
+		number = rpc.BlockNumber(*args.Number)
+	}
+	return r.blockByNumber(ctx, number)
+}
+
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+}) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	from := int64(*args.From)
+
+	var to int64
+	if args.To != nil {
+		to = int64(*args.To)
+	} else {
+		latest, err := r.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		to = int64(latest) // #nosec G701
+	}
+	if to < from {
+		return []*Block{}, nil
+	}
+	if limit := int64(r.backend.RPCBlockRangeCap()); limit > 0 && to-from+1 > limit {
+		return nil, fmt.Errorf("block range greater than %d", limit)
+	}
+
+	ret := make([]*Block, 0, to-from+1)
+	for number := from; number <= to; number++ {
+		block, err := r.blockByNumber(ctx, rpc.BlockNumber(number))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, block)
+	}
+	return ret, nil
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{r: r, hash: args.Hash}
+	if _, err := tx.resolve(ctx); err != nil {
+		if errors.Is(err, errTxNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	crit := filters.FilterCriteria{}
+	if args.Filter.FromBlock != nil {
+		crit.FromBlock = big.NewInt(int64(*args.Filter.FromBlock))
+	}
+	if args.Filter.ToBlock != nil {
+		crit.ToBlock = big.NewInt(int64(*args.Filter.ToBlock))
+	}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return r.filterLogs(ctx, crit)
+}
+
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	price, err := r.backend.GasPrice(ctx)
+	if err != nil || price == nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(_ context.Context) (hexutil.Big, error) {
+	head := r.backend.CurrentHeader()
+	if head == nil {
+		return hexutil.Big{}, errors.New("latest block not found")
+	}
+	tip, err := r.backend.SuggestGasTipCap(head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tip), nil
+}
+
+func (r *Resolver) ChainID(_ context.Context) (hexutil.Big, error) {
+	cfg := r.backend.ChainConfig()
+	if cfg == nil || cfg.ChainID == nil {
+		return hexutil.Big{}, errors.New("chain config not found")
+	}
+	return hexutil.Big(*cfg.ChainID), nil
+}
+
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(args.Data); err != nil {
+		return common.Hash{}, err
+	}
+	return ethapi.SubmitTransaction(ctx, r.logger, r.backend, tx)
+}
+
+func (r *Resolver) account(address common.Address, blockNrOrHash rpc.BlockNumberOrHash) *Account {
+	return &Account{r: r, address: address, blockNrOrHash: blockNrOrHash}
+}
+
+func (r *Resolver) blockByNumber(ctx context.Context, number rpc.BlockNumber) (*Block, error) {
+	block, err := r.backend.ArtBlockByNumber(ctx, number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return &Block{r: r, block: block}, nil
+}
+
+func (r *Resolver) blockByHash(ctx context.Context, hash common.Hash) (*Block, error) {
+	block, err := r.backend.BlockByHash(ctx, hash)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return &Block{r: r, block: block}, nil
+}
+
+func (r *Resolver) filterLogs(ctx context.Context, crit filters.FilterCriteria) ([]*Log, error) {
+	logs, err := artfilters.QueryLogs(ctx, r.logger, r.backend, crit)
+	if err != nil {
+		return nil, err
+	}
+	return r.logs(logs), nil
+}
+
+func (r *Resolver) logs(logs []*ethtypes.Log) []*Log {
+	ret := make([]*Log, 0, len(logs))
+	for _, l := range logs {
+		ret = append(ret, &Log{r: r, log: l})
+	}
+	return ret
+}
+
+func blockNumber(number uint64) rpc.BlockNumberOrHash {
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(number)) // #nosec G701
+}
+
+// txBlock returns the block of the tx, the pending block if it's not included yet.
+func txBlock(tx *ethapi.RPCTransaction) rpc.BlockNumberOrHash {
+	if tx.BlockNumber == nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber)
+	}
+	return blockNumber(tx.BlockNumber.ToInt().Uint64())
+}
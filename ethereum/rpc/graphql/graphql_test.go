@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"testing"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaResolvers(t *testing.T) {
+	_, err := graphqlgo.ParseSchema(schema, &Resolver{})
+	require.NoError(t, err)
+}
+
+func TestLongUnmarshalGraphQL(t *testing.T) {
+	testCases := []struct {
+		input    interface{}
+		expected Long
+		expErr   bool
+	}{
+		{"0x10", 16, false},
+		{"16", 16, false},
+		{int32(16), 16, false},
+		{int64(16), 16, false},
+		{float64(16), 16, false},
+		{"0xzz", 0, true},
+		{true, 0, true},
+	}
+
+	for _, tc := range testCases {
+		var l Long
+		err := l.UnmarshalGraphQL(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expected, l)
+	}
+}
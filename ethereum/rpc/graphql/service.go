@@ -0,0 +1,18 @@
+package graphql
+
+import (
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/log"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+// NewHandler returns the http handler answering the GraphQL queries over the backend.
+func NewHandler(logger log.Logger, backend Backend) (http.Handler, error) {
+	s, err := graphqlgo.ParseSchema(schema, &Resolver{logger: logger, backend: backend})
+	if err != nil {
+		return nil, err
+	}
+	return &relay.Handler{Schema: s}, nil
+}
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// healthCheckTimeout bounds the queries of a health check, so a stuck cometbft client
+// fails the probe instead of hanging it.
+const healthCheckTimeout = 3 * time.Second
+
+// HealthStatus is the report served on /health and /ready.
+type HealthStatus struct {
+	// Healthy is true if the cometbft node behind the RPC server is reachable.
+	Healthy bool `json:"healthy"`
+	// Ready is true if the node is in sync and its indexer keeps up with it.
+	Ready bool `json:"ready"`
+
+	CatchingUp     bool   `json:"catchingUp"`
+	LatestBlock    int64  `json:"latestBlock"`
+	LatestBlockAge string `json:"latestBlockAge,omitempty"`
+	PeersBlock     int64  `json:"peersBlock,omitempty"`
+	BlockLag       int64  `json:"blockLag"`
+
+	IndexerEnabled bool   `json:"indexerEnabled"`
+	IndexedBlock   int64  `json:"indexedBlock,omitempty"`
+	IndexerLag     int64  `json:"indexerLag,omitempty"`
+	IndexerError   string `json:"indexerError,omitempty"`
+
+	// Reason explains why the node isn't healthy or ready.
+	Reason string `json:"reason,omitempty"`
+}
+
+// HealthStatus checks the connectivity to the cometbft node, the lag of the latest block
+// behind the peers and the lag of the indexer behind the latest block.
+func (b *BackendImpl) HealthStatus(ctx context.Context) *HealthStatus {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	hs := &HealthStatus{}
+	status, err := b.clientCtx.Client.Status(ctx)
+	if err != nil {
+		hs.Reason = "cometbft node unreachable: " + err.Error()
+		return hs
+	}
+	hs.Healthy = true
+
+	syncInfo := status.SyncInfo
+	hs.CatchingUp = syncInfo.CatchingUp
+	hs.LatestBlock = syncInfo.LatestBlockHeight
+	if !syncInfo.LatestBlockTime.IsZero() {
+		hs.LatestBlockAge = time.Since(syncInfo.LatestBlockTime).Round(time.Millisecond).String()
+	}
+	if peers := b.peersHeight(); peers > hs.LatestBlock {
+		hs.PeersBlock = peers
+		hs.BlockLag = peers - hs.LatestBlock
+	}
+
+	if b.indexer != nil {
+		hs.IndexerEnabled = true
+		indexed, err := b.indexer.LastIndexedBlock()
+		if err != nil {
+			hs.IndexerError = err.Error()
+		} else {
+			hs.IndexedBlock = indexed
+			if lag := hs.LatestBlock - indexed; lag > 0 {
+				hs.IndexerLag = lag
+			}
+		}
+	}
+
+	jsonrpc := b.appConf.JSONRPC
+	switch {
+	case hs.CatchingUp:
+		hs.Reason = "node is catching up"
+	case hs.BlockLag > jsonrpc.HealthMaxBlockLag:
+		hs.Reason = "node is behind its peers"
+	case jsonrpc.HealthMaxBlockAge > 0 && time.Since(syncInfo.LatestBlockTime) > jsonrpc.HealthMaxBlockAge:
+		hs.Reason = "latest block is too old"
+	case hs.IndexerError != "":
+		hs.Reason = "indexer unavailable"
+	case hs.IndexerLag > jsonrpc.HealthMaxIndexerLag:
+		hs.Reason = "indexer is behind the node"
+	default:
+		hs.Ready = true
+	}
+	return hs
+}
+
+// healthHandler serves the HealthStatus, it responds with 503 if the node isn't healthy, or
+// isn't ready in case of the readiness check.
+type healthHandler struct {
+	backend   *BackendImpl
+	readiness bool
+}
+
+// newHealthHandler returns the liveness handler of /health, or the readiness handler of
+// /ready if readiness is set.
+func newHealthHandler(backend *BackendImpl, readiness bool) http.Handler {
+	return &healthHandler{backend: backend, readiness: readiness}
+}
+
+func (h *healthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	hs := h.backend.HealthStatus(r.Context())
+	code := http.StatusOK
+	if !hs.Healthy || (h.readiness && !hs.Ready) {
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(hs); err != nil {
+		h.backend.logger.Debug("failed to write the health status", "error", err.Error())
+	}
+}
@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/ethereum/server/config"
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
+)
+
+// healthClient is the cometbft client of the health checks, the other queries panic.
+type healthClient struct {
+	client.TendermintRPC
+	tmrpcclient.NetworkClient
+
+	err        error
+	catchingUp bool
+	latest     int64
+	peers      int64
+}
+
+func (c *healthClient) Status(context.Context) (*tmrpctypes.ResultStatus, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	status := &tmrpctypes.ResultStatus{}
+	status.SyncInfo.CatchingUp = c.catchingUp
+	status.SyncInfo.LatestBlockHeight = c.latest
+	status.SyncInfo.LatestBlockTime = time.Now()
+	return status, nil
+}
+
+func (c *healthClient) ConsensusParams(ctx context.Context, height *int64) (*tmrpctypes.ResultConsensusParams, error) {
+	return c.TendermintRPC.ConsensusParams(ctx, height)
+}
+
+func (c *healthClient) DumpConsensusState(context.Context) (*tmrpctypes.ResultDumpConsensusState, error) {
+	// the peers are working on the height after their latest block
+	peerState := fmt.Sprintf(`{"round_state":{"height":"%d"}}`, c.peers+1)
+	return &tmrpctypes.ResultDumpConsensusState{
+		Peers: []tmrpctypes.PeerStateInfo{{PeerState: json.RawMessage(peerState)}},
+	}, nil
+}
+
+// healthIndexer is the indexer of the health checks, the other methods panic.
+type healthIndexer struct {
+	ethereumtypes.EVMTxIndexer
+
+	indexed int64
+}
+
+func (i *healthIndexer) LastIndexedBlock() (int64, error) {
+	return i.indexed, nil
+}
+
+func TestHealthHandler(t *testing.T) {
+	appConf := config.DefaultConfig()
+	appConf.JSONRPC.HealthMaxBlockLag = 5
+	appConf.JSONRPC.HealthMaxIndexerLag = 20
+
+	testCases := []struct {
+		name      string
+		client    *healthClient
+		indexed   int64
+		expHealth int
+		expReady  int
+		expReason string
+	}{
+		{"in sync", &healthClient{latest: 100, peers: 100}, 100, http.StatusOK, http.StatusOK, ""},
+		{"node unreachable", &healthClient{err: errors.New("connection refused")}, 100, http.StatusServiceUnavailable, http.StatusServiceUnavailable, "cometbft node unreachable: connection refused"},
+		{"catching up", &healthClient{catchingUp: true, latest: 100, peers: 100}, 100, http.StatusOK, http.StatusServiceUnavailable, "node is catching up"},
+		{"block lag within the max", &healthClient{latest: 100, peers: 105}, 100, http.StatusOK, http.StatusOK, ""},
+		{"block lag over the max", &healthClient{latest: 100, peers: 106}, 100, http.StatusOK, http.StatusServiceUnavailable, "node is behind its peers"},
+		{"indexer lag within the max", &healthClient{latest: 100, peers: 100}, 80, http.StatusOK, http.StatusOK, ""},
+		{"indexer lag over the max", &healthClient{latest: 100, peers: 100}, 79, http.StatusOK, http.StatusServiceUnavailable, "indexer is behind the node"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &BackendImpl{
+				ctx:       context.Background(),
+				appConf:   *appConf,
+				clientCtx: client.Context{Client: tc.client},
+				indexer:   &healthIndexer{indexed: tc.indexed},
+				logger:    log.New(),
+			}
+			for _, check := range []struct {
+				handler http.Handler
+				expCode int
+			}{
+				{newHealthHandler(backend, false), tc.expHealth},
+				{newHealthHandler(backend, true), tc.expReady},
+			} {
+				rec := httptest.NewRecorder()
+				check.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+				require.Equal(t, check.expCode, rec.Code)
+				require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+				require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+				var hs HealthStatus
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &hs))
+				require.Equal(t, tc.expReason, hs.Reason)
+				require.Equal(t, tc.expReady == http.StatusOK, hs.Ready)
+
+				// HEAD responds with the same status without the body
+				rec = httptest.NewRecorder()
+				check.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodHead, "/", nil))
+				require.Equal(t, check.expCode, rec.Code)
+				require.Empty(t, rec.Body.Bytes())
+			}
+		})
+	}
+
+	// the other methods aren't allowed
+	rec := httptest.NewRecorder()
+	newHealthHandler(&BackendImpl{}, true).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	require.Equal(t, "GET, HEAD", rec.Header().Get("Allow"))
+}
@@ -0,0 +1,217 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// errcodeLimitExceeded is the json-rpc error code of the requests rejected by the limits.
+	errcodeLimitExceeded = -32600
+	// errcodeMethodDisabled is the json-rpc error code of the calls of the disabled methods,
+	// the same as the one of the methods not found.
+	errcodeMethodDisabled = -32601
+)
+
+// HTTPLimits bounds the json-rpc requests served over http, 0 for no limit.
+type HTTPLimits struct {
+	// MaxBatchItems is the max number of the calls of a batch request.
+	MaxBatchItems int
+	// MaxRequestSize is the max size in bytes of a request body.
+	MaxRequestSize int64
+	// MaxResponseSize is the max size in bytes of a response body.
+	MaxResponseSize int
+	// MaxConcurrentRequests is the max number of the requests served at once.
+	MaxConcurrentRequests int
+	// MethodRateLimits is the max number of calls per second of the methods, by method
+	// pattern (see matchMethod).
+	MethodRateLimits map[string]float64
+	// MethodTimeouts is the execution timeout of the methods, by method pattern.
+	MethodTimeouts map[string]time.Duration
+	// DisabledMethods are the methods rejected by the server, by method pattern.
+	DisabledMethods map[string]bool
+}
+
+// Enabled reports if any of the limits is set.
+func (l HTTPLimits) Enabled() bool {
+	return l.MaxBatchItems > 0 || l.MaxRequestSize > 0 || l.MaxResponseSize > 0 ||
+		l.MaxConcurrentRequests > 0 || len(l.MethodRateLimits) > 0 || len(l.MethodTimeouts) > 0 ||
+		len(l.DisabledMethods) > 0
+}
+
+// Handler wraps the json-rpc handler to enforce the limits, the requests and the responses
+// exceeding the limits are answered with a json-rpc error. The calls running over their
+// timeout are answered with the timeout error of the server.
+func (l HTTPLimits) Handler(next http.Handler) http.Handler {
+	var slots chan struct{}
+	if l.MaxConcurrentRequests > 0 {
+		slots = make(chan struct{}, l.MaxConcurrentRequests)
+	}
+	limiters := make(map[string]*rateLimiter, len(l.MethodRateLimits))
+	for pattern, rate := range l.MethodRateLimits {
+		limiters[pattern] = newRateLimiter(rate)
+	}
+	readBody := l.MaxBatchItems > 0 || len(limiters) > 0 || len(l.MethodTimeouts) > 0 || len(l.DisabledMethods) > 0
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if l.MaxRequestSize > 0 {
+			if r.ContentLength > l.MaxRequestSize {
+				writeLimitError(w, fmt.Sprintf("request of %d bytes exceeds the limit of %d bytes", r.ContentLength, l.MaxRequestSize))
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, l.MaxRequestSize)
+		}
+
+		if slots != nil {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			default:
+				writeLimitError(w, fmt.Sprintf("requests exceed the limit of %d concurrent requests", l.MaxConcurrentRequests))
+				return
+			}
+		}
+
+		if readBody {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeLimitError(w, fmt.Sprintf("failed to read the request, %v", err))
+				return
+			}
+			methods := requestMethods(body)
+			if l.MaxBatchItems > 0 && len(methods) > l.MaxBatchItems && isBatch(body) {
+				writeLimitError(w, fmt.Sprintf("batch of %d calls exceeds the limit of %d calls", len(methods), l.MaxBatchItems))
+				return
+			}
+
+			now := time.Now()
+			var timeout time.Duration
+			for _, method := range methods {
+				if matchMethod(l.DisabledMethods, method) != "" {
+					writeError(w, errcodeMethodDisabled, fmt.Sprintf("the method %s is disabled", method))
+					return
+				}
+				if limiter, ok := limiters[matchMethod(limiters, method)]; ok && !limiter.allow(now) {
+					writeLimitError(w, fmt.Sprintf("calls of %s exceed the rate limit", method))
+					return
+				}
+				// a batch runs with the shortest timeout of its calls
+				if d, ok := l.MethodTimeouts[matchMethod(l.MethodTimeouts, method)]; ok && d > 0 && (timeout == 0 || d < timeout) {
+					timeout = d
+				}
+			}
+			if timeout > 0 {
+				ctx, cancel := context.WithTimeout(r.Context(), timeout)
+				defer cancel()
+				r = r.WithContext(ctx)
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		if l.MaxResponseSize <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		buf := &bufferedResponse{header: w.Header(), max: l.MaxResponseSize}
+		next.ServeHTTP(buf, r)
+		if buf.overflow {
+			writeLimitError(w, fmt.Sprintf("response exceeds the limit of %d bytes", l.MaxResponseSize))
+			return
+		}
+		if buf.status != 0 {
+			w.WriteHeader(buf.status)
+		}
+		_, _ = w.Write(buf.body.Bytes())
+	})
+}
+
+// isBatch reports if the request is a batch.
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+// requestMethods returns the methods of the calls of a request, none if the request is
+// malformed, the server answers it then.
+func requestMethods(body []byte) []string {
+	type call struct {
+		Method string `json:"method"`
+	}
+
+	if !isBatch(body) {
+		var c call
+		if err := json.Unmarshal(body, &c); err != nil {
+			return nil
+		}
+		return []string{c.Method}
+	}
+
+	var calls []json.RawMessage
+	if err := json.Unmarshal(body, &calls); err != nil {
+		return nil
+	}
+	methods := make([]string, len(calls))
+	for i, raw := range calls {
+		// the server answers the malformed calls of the batch
+		var c call
+		_ = json.Unmarshal(raw, &c)
+		methods[i] = c.Method
+	}
+	return methods
+}
+
+func writeLimitError(w http.ResponseWriter, message string) {
+	writeError(w, errcodeLimitExceeded, message)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+// bufferedResponse holds the response until it's completed, so it can be replaced by an
+// error if it exceeds the max size.
+type bufferedResponse struct {
+	header   http.Header
+	status   int
+	body     bytes.Buffer
+	max      int
+	overflow bool
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	if b.overflow || b.body.Len()+len(p) > b.max {
+		b.overflow = true
+		b.body.Reset()
+		return len(p), nil
+	}
+	return b.body.Write(p)
+}
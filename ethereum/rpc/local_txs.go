@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+const (
+	// localTxLifetime is how long a tx submitted through the node is served as pending, if it's
+	// not included in a block in time it's likely dropped by the mempool.
+	localTxLifetime = 3 * time.Hour
+	// maxLocalTxs is the max number of the txs kept in the local journal, the oldest are dropped.
+	maxLocalTxs = 4096
+)
+
+// localTxs is the journal of the ethereum txs submitted through the node and accepted by its
+// mempool, so they can be served as pending even if they're not listed by the mempool query.
+type localTxs struct {
+	mu  sync.Mutex
+	txs map[common.Hash]localTx
+}
+
+type localTx struct {
+	msg   *txs.MsgEthereumTx
+	added time.Time
+}
+
+func newLocalTxs() *localTxs {
+	return &localTxs{txs: make(map[common.Hash]localTx)}
+}
+
+// add records a tx accepted by the mempool, dropping the expired txs and the oldest tx if the
+// journal is full.
+func (l *localTxs) add(hash common.Hash, msg *txs.MsgEthereumTx) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	for h, tx := range l.txs {
+		if now.Sub(tx.added) > localTxLifetime {
+			delete(l.txs, h)
+		}
+	}
+	if len(l.txs) >= maxLocalTxs {
+		var (
+			oldest     common.Hash
+			oldestTime time.Time
+		)
+		for h, tx := range l.txs {
+			if oldestTime.IsZero() || tx.added.Before(oldestTime) {
+				oldest, oldestTime = h, tx.added
+			}
+		}
+		delete(l.txs, oldest)
+	}
+	l.txs[hash] = localTx{msg: msg, added: now}
+}
+
+// get returns the tx of the hash, nil if it's not found or expired.
+func (l *localTxs) get(hash common.Hash) *txs.MsgEthereumTx {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	tx, ok := l.txs[hash]
+	if !ok {
+		return nil
+	}
+	if time.Since(tx.added) > localTxLifetime {
+		delete(l.txs, hash)
+		return nil
+	}
+	return tx.msg
+}
+
+// remove drops the tx once it's included in a block.
+func (l *localTxs) remove(hash common.Hash) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.txs, hash)
+}
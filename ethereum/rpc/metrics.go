@@ -0,0 +1,187 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// unknownMethod is the method label of the calls of the methods not served, so the
+	// clients can't blow up the number of the series.
+	unknownMethod = "unknown"
+	// maxCapturedResponse is the max size of a response inspected for the errors of its
+	// calls, the calls of the larger responses are recorded as succeeded.
+	maxCapturedResponse = 1 << 20
+)
+
+// MethodMetrics records the per-method calls, errors and latencies of the json-rpc requests
+// served over http, and the number of the requests in flight, to the telemetry of the node.
+type MethodMetrics struct {
+	methods  map[string]bool
+	inFlight int64
+}
+
+// NewMethodMetrics creates the metrics of the methods of the given APIs.
+func NewMethodMetrics(apis []rpc.API) *MethodMetrics {
+	methods := make(map[string]bool)
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			methods[api.Namespace+"_"+formatMethodName(typ.Method(i).Name)] = true
+		}
+	}
+	return &MethodMetrics{methods: methods}
+}
+
+// formatMethodName converts the name of a go method to the one of the json-rpc method, the
+// same as the rpc server does.
+func formatMethodName(name string) string {
+	ret := []rune(name)
+	if len(ret) > 0 {
+		ret[0] = unicode.ToLower(ret[0])
+	}
+	return string(ret)
+}
+
+// Handler wraps the json-rpc handler to record the metrics of the requests, the requests
+// rejected by the wrapped handler are recorded as failed calls.
+func (m *MethodMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		telemetry.SetGauge(float32(atomic.AddInt64(&m.inFlight, 1)), "jsonrpc", "in_flight")
+		defer func() {
+			telemetry.SetGauge(float32(atomic.AddInt64(&m.inFlight, -1)), "jsonrpc", "in_flight")
+		}()
+
+		start := time.Now()
+		capture := &capturedResponse{ResponseWriter: w}
+		next.ServeHTTP(capture, r)
+
+		failed := capture.failedCalls()
+		for _, c := range requestCalls(body) {
+			labels := []metrics.Label{telemetry.NewLabel("method", m.methodLabel(c.Method))}
+			telemetry.IncrCounterWithLabels([]string{"jsonrpc", "calls"}, 1, labels)
+			metrics.MeasureSinceWithLabels([]string{"jsonrpc", "latency"}, start, labels)
+			if failed.all || failed.ids[string(c.ID)] {
+				telemetry.IncrCounterWithLabels([]string{"jsonrpc", "errors"}, 1, labels)
+			}
+		}
+	})
+}
+
+func (m *MethodMetrics) methodLabel(method string) string {
+	if m.methods[method] {
+		return method
+	}
+	return unknownMethod
+}
+
+// jsonrpcCall is the method and the id of a call of a request.
+type jsonrpcCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// requestCalls returns the calls of a request, none if the request is malformed.
+func requestCalls(body []byte) []jsonrpcCall {
+	if !isBatch(body) {
+		var c jsonrpcCall
+		if err := json.Unmarshal(body, &c); err != nil {
+			return nil
+		}
+		return []jsonrpcCall{c}
+	}
+
+	var calls []jsonrpcCall
+	if err := json.Unmarshal(body, &calls); err != nil {
+		return nil
+	}
+	return calls
+}
+
+// failedCalls are the calls answered with an error.
+type failedCalls struct {
+	// all is true if the whole request is answered with an error
+	all bool
+	ids map[string]bool
+}
+
+// capturedResponse passes the response through, and keeps a copy of it up to
+// maxCapturedResponse bytes to inspect the errors of the calls.
+type capturedResponse struct {
+	http.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (c *capturedResponse) Write(p []byte) (int, error) {
+	if !c.overflow {
+		if c.body.Len()+len(p) > maxCapturedResponse {
+			c.overflow = true
+			c.body.Reset()
+		} else {
+			c.body.Write(p)
+		}
+	}
+	return c.ResponseWriter.Write(p)
+}
+
+// failedCalls returns the calls answered with an error. A single error answers the whole
+// request, e.g. the request rejected by the limits, so it fails all the calls of a batch.
+func (c *capturedResponse) failedCalls() failedCalls {
+	type response struct {
+		ID    json.RawMessage `json:"id"`
+		Error json.RawMessage `json:"error"`
+	}
+
+	failed := failedCalls{ids: make(map[string]bool)}
+	if c.overflow {
+		return failed
+	}
+
+	body := bytes.TrimSpace(c.body.Bytes())
+	if len(body) == 0 {
+		// the notifications are not answered
+		return failed
+	}
+	if !isBatch(body) {
+		var res response
+		if err := json.Unmarshal(body, &res); err != nil || len(res.Error) > 0 {
+			failed.all = true
+		}
+		return failed
+	}
+
+	var responses []response
+	if err := json.Unmarshal(body, &responses); err != nil {
+		failed.all = true
+		return failed
+	}
+	for _, res := range responses {
+		if len(res.Error) > 0 {
+			failed.ids[string(res.ID)] = true
+		}
+	}
+	return failed
+}
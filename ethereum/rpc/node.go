@@ -1,47 +1,232 @@
 package rpc
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/artela-network/artela/ethereum/rpc/api"
 	"github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // Node Wrapers Ethereum Node
 type Node struct {
 	*node.Node
+
+	limits        HTTPLimits
+	methodMetrics bool
+	authModules   []string
+	apis          []rpc.API
+
+	// tlsConfig serves the http and websocket endpoints over TLS if set, the endpoints are
+	// served by the listeners of the node otherwise.
+	tlsConfig       *tls.Config
+	tlsHTTPEndpoint string
+	tlsWSEndpoint   string
+	handlers        []registeredHandler
 }
 
 // Node is an implement of NetworkingStack
 var _ types.NetworkingStack = (*Node)(nil)
 
-// Node creates a new NetworkingStack instance.
-func NewNode(config *node.Config) (types.NetworkingStack, error) {
-	node, err := node.New(config)
-	if err != nil {
-		return nil, err
+// Node creates a new NetworkingStack instance, the namespaces of the auth modules are served
+// on the JWT authenticated endpoint of the auth address of the config, if any. The metrics
+// of the http json-rpc methods are recorded to the telemetry if methodMetrics is set. The
+// http and websocket endpoints are served over TLS if tlsConfig is set.
+func NewNode(
+	config *node.Config,
+	limits HTTPLimits,
+	methodMetrics bool,
+	tlsConfig *tls.Config,
+	authModules []string,
+) (types.NetworkingStack, error) {
+	n := &Node{
+		limits:        limits,
+		methodMetrics: methodMetrics,
+		authModules:   authModules,
+		tlsConfig:     tlsConfig,
+	}
+
+	if tlsConfig != nil {
+		// the listeners of the node are replaced by the TLS ones
+		cfg := *config
+		if cfg.HTTPHost != "" {
+			n.tlsHTTPEndpoint = cfg.HTTPEndpoint()
+		}
+		if cfg.WSHost != "" {
+			n.tlsWSEndpoint = cfg.WSEndpoint()
+		}
+		cfg.HTTPHost, cfg.WSHost = "", ""
+		config = &cfg
 	}
 
-	return &Node{
-		Node: node,
-	}, nil
+	var err error
+	if n.Node, err = node.New(config); err != nil {
+		return nil, err
+	}
+	return n, nil
 }
 
 // ExtRPCEnabled returns whether or not the external RPC service is enabled.
 func (n *Node) ExtRPCEnabled() bool {
-	return n.Node.Config().ExtRPCEnabled()
+	return n.Node.Config().ExtRPCEnabled() || n.tlsHTTPEndpoint != "" || n.tlsWSEndpoint != ""
+}
+
+// RegisterHandler registers a handler on the http endpoint, it's served on the TLS endpoint
+// if TLS is enabled.
+func (n *Node) RegisterHandler(name, path string, handler http.Handler) {
+	if n.tlsConfig == nil {
+		n.Node.RegisterHandler(name, path, handler)
+		return
+	}
+	n.handlers = append(n.handlers, registeredHandler{name: name, path: path, handler: handler})
+}
+
+// RegisterAPIs registers the JSON-RPC APIs of the networking stack.
+func (n *Node) RegisterAPIs(apis []rpc.API) {
+	n.apis = append(n.apis, apis...)
+	n.Node.RegisterAPIs(apis)
 }
 
 // Start starts the networking stack.
 func (n *Node) Start() error {
+	if n.tlsConfig != nil {
+		n.registerTLSRPC()
+	} else if (n.limits.Enabled() || n.methodMetrics) && n.Config().HTTPHost != "" {
+		n.registerWrappedHTTP()
+	}
+	if len(n.authModules) > 0 {
+		if err := n.registerAuthRPC(); err != nil {
+			return err
+		}
+	}
 	return n.Node.Start()
 }
 
+// ClientVersion returns the name of the node.
+func (n *Node) ClientVersion() string {
+	return n.Config().NodeName()
+}
+
+// registerWrappedHTTP serves the http json-rpc requests with a server of its own, as the
+// server of the node can't be wrapped to enforce the limits or record the method metrics.
+// The server has the registered APIs of the http modules and web3, the node APIs of the
+// admin and debug namespaces are not served over http then.
+func (n *Node) registerWrappedHTTP() {
+	cfg := n.Config()
+	handler, srv := n.newHTTPHandler()
+
+	path := cfg.HTTPPathPrefix
+	if path == "" {
+		path = "/"
+	}
+	// the pattern of the root path matches all the paths not registered
+	n.RegisterHandler("JSON-RPC", path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	n.RegisterLifecycle(&rpcServerLifecycle{srv})
+}
+
+// newHTTPHandler returns the handler of the http json-rpc requests, with a server of the
+// registered APIs of the http modules and web3, wrapped by the limits and the method metrics
+// if enabled.
+func (n *Node) newHTTPHandler() (http.Handler, *rpc.Server) {
+	cfg := n.Config()
+	apis := n.modulesAPIs(cfg.HTTPModules)
+	srv := newAPIsServer(apis)
+
+	var wrapped http.Handler = srv
+	if n.limits.Enabled() {
+		wrapped = n.limits.Handler(wrapped)
+	}
+	if n.methodMetrics {
+		// outermost, so the requests rejected by the limits are recorded too
+		wrapped = NewMethodMetrics(apis).Handler(wrapped)
+	}
+	return node.NewHTTPHandlerStack(wrapped, cfg.HTTPCors, cfg.HTTPVirtualHosts, nil), srv
+}
+
+// newModulesServer returns a rpc server with the registered APIs of the given modules and
+// web3.
+func (n *Node) newModulesServer(modules []string) *rpc.Server {
+	return newAPIsServer(n.modulesAPIs(modules))
+}
+
+// modulesAPIs returns the registered APIs of the given modules and web3.
+func (n *Node) modulesAPIs(modules []string) []rpc.API {
+	enabled := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		enabled[module] = true
+	}
+
+	var apis []rpc.API
+	for _, api := range n.apis {
+		if enabled[api.Namespace] {
+			apis = append(apis, api)
+		}
+	}
+	if enabled["web3"] {
+		apis = append(apis, rpc.API{Namespace: "web3", Service: api.NewWeb3API(n)})
+	}
+	return apis
+}
+
+// newAPIsServer returns a rpc server with the given APIs.
+func newAPIsServer(apis []rpc.API) *rpc.Server {
+	srv := rpc.NewServer()
+	for _, api := range apis {
+		if err := srv.RegisterName(api.Namespace, api.Service); err != nil {
+			panic(err)
+		}
+	}
+	return srv
+}
+
+// shutdownTimeout is the time the http servers are given to drain the in-flight requests
+// on shutdown, the same as the servers of the node.
+const shutdownTimeout = 5 * time.Second
+
+// shutdownHTTP stops the http server from accepting new connections and waits for the
+// in-flight requests to complete, the connections left are closed after shutdownTimeout.
+func shutdownHTTP(srv *http.Server) error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := srv.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return srv.Close()
+	}
+	return err
+}
+
+// rpcServerLifecycle stops the rpc server when the node is closed.
+type rpcServerLifecycle struct {
+	srv *rpc.Server
+}
+
+func (l *rpcServerLifecycle) Start() error { return nil }
+
+func (l *rpcServerLifecycle) Stop() error {
+	l.srv.Stop()
+	return nil
+}
+
 // DefaultConfig returns the default configuration for the provider.
 func DefaultGethNodeConfig() *node.Config {
 	nodeCfg := node.DefaultConfig
 	nodeCfg.P2P.NoDiscovery = true
 	nodeCfg.P2P.MaxPeers = 0
+	nodeCfg.P2P.ListenAddr = ""
 	nodeCfg.Name = clientIdentifier
-	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "txpool", "debug")
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "txpool", "debug", "trace")
 	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth")
 	nodeCfg.HTTPHost = "0.0.0.0"
 	nodeCfg.WSHost = "0.0.0.0"
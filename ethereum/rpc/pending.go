@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// pendingEthMsgs returns the ethereum txs in the mempool in the mempool order, the txs
+// wrapping other msgs are skipped.
+func (b *BackendImpl) pendingEthMsgs() ([]*txs.MsgEthereumTx, error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*txs.MsgEthereumTx
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*txs.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			result = append(result, ethMsg)
+		}
+	}
+	return result, nil
+}
+
+// pendingBlock assembles the synthetic pending block on top of the latest block, it includes
+// the mempool ethereum txs fitting in the block gas limit. The txs are not executed, so the
+// gas used, bloom and state root of the block are left empty, the base fee is the one of the
+// latest block.
+func (b *BackendImpl) pendingBlock() (*rpctypes.Block, []*txs.MsgEthereumTx, error) {
+	parent, parentHash, err := b.ArtHeaderByNumber(context.Background(), rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgs, err := b.pendingEthMsgs()
+	if err != nil {
+		b.logger.Debug("failed to fetch the pending txs", "error", err)
+	}
+
+	var (
+		included []*txs.MsgEthereumTx
+		ethTxs   ethtypes.Transactions
+		gas      uint64
+	)
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		if parent.GasLimit > 0 && gas+tx.Gas() > parent.GasLimit {
+			continue
+		}
+		gas += tx.Gas()
+		included = append(included, msg)
+		ethTxs = append(ethTxs, tx)
+	}
+
+	blockTime := uint64(time.Now().Unix())
+	if blockTime <= parent.Time {
+		blockTime = parent.Time + 1
+	}
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+		Difficulty: big.NewInt(0),
+		GasLimit:   parent.GasLimit,
+		Time:       blockTime,
+		BaseFee:    parent.BaseFee,
+	}
+
+	ethBlock := ethtypes.NewBlock(header, ethTxs, nil, nil, trie.NewStackTrie(nil))
+	block := rpctypes.EthBlockToBlock(ethBlock)
+	block.SetHash(ethBlock.Hash())
+	return block, included, nil
+}
+
+// pendingCallOverrides returns the block overrides of a call against the pending block, the
+// number and time of the block default to the ones of the pending block.
+func pendingCallOverrides(block *rpctypes.Block, overrides *ethapi.BlockOverrides) *ethapi.BlockOverrides {
+	res := ethapi.BlockOverrides{}
+	if overrides != nil {
+		res = *overrides
+	}
+	if res.Number == nil {
+		res.Number = (*hexutil.Big)(block.Number())
+	}
+	if res.Time == nil {
+		t := hexutil.Uint64(block.Header().Time)
+		res.Time = &t
+	}
+	return &res
+}
@@ -0,0 +1,92 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrRejected is returned for the txs rejected by the RPC policy of the node.
+var ErrRejected = errors.New("transaction rejected by the RPC policy of the node")
+
+// TxPolicy checks the txs submitted via the node's RPC against the allow and deny lists
+// of the operator. It's applied at the RPC edge only, the txs received from the p2p
+// network are not affected.
+type TxPolicy struct {
+	logger log.Logger
+	allow  map[common.Address]struct{}
+	deny   map[common.Address]struct{}
+}
+
+// NewTxPolicy creates a new TxPolicy from the hex addresses of the lists.
+func NewTxPolicy(logger log.Logger, allow, deny []string) (*TxPolicy, error) {
+	p := &TxPolicy{
+		logger: logger,
+		allow:  make(map[common.Address]struct{}, len(allow)),
+		deny:   make(map[common.Address]struct{}, len(deny)),
+	}
+	for _, list := range []struct {
+		addresses []string
+		set       map[common.Address]struct{}
+	}{{allow, p.allow}, {deny, p.deny}} {
+		for _, addr := range list.addresses {
+			if !common.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid policy address '%s'", addr)
+			}
+			list.set[common.HexToAddress(addr)] = struct{}{}
+		}
+	}
+	return p, nil
+}
+
+// Enabled returns true if any of the lists is set.
+func (p *TxPolicy) Enabled() bool {
+	return len(p.allow) > 0 || len(p.deny) > 0
+}
+
+// Check returns an error wrapping ErrRejected if the tx sent from the sender is rejected,
+// the rejections are logged for audit.
+func (p *TxPolicy) Check(from common.Address, tx *ethtypes.Transaction) error {
+	if !p.Enabled() {
+		return nil
+	}
+
+	// the deny list covers the sender, the recipient and the access list
+	interacted := []common.Address{from}
+	if tx.To() != nil {
+		interacted = append(interacted, *tx.To())
+	}
+	for _, tuple := range tx.AccessList() {
+		interacted = append(interacted, tuple.Address)
+	}
+	for _, addr := range interacted {
+		if _, denied := p.deny[addr]; denied {
+			return p.reject(from, tx, fmt.Sprintf("address %s is denied", addr.Hex()))
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return nil
+	}
+	if _, allowed := p.allow[from]; allowed {
+		return nil
+	}
+	if tx.To() != nil {
+		if _, allowed := p.allow[*tx.To()]; allowed {
+			return nil
+		}
+	}
+	return p.reject(from, tx, "neither the sender nor the recipient is allowed")
+}
+
+func (p *TxPolicy) reject(from common.Address, tx *ethtypes.Transaction, reason string) error {
+	to := "<contract creation>"
+	if tx.To() != nil {
+		to = tx.To().Hex()
+	}
+	p.logger.Warn("RPC policy rejected transaction", "hash", tx.Hash().Hex(), "from", from.Hex(), "to", to, "reason", reason)
+	return fmt.Errorf("%w: %s", ErrRejected, reason)
+}
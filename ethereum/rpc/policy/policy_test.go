@@ -0,0 +1,44 @@
+package policy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPolicy(t *testing.T) {
+	var (
+		sender     = common.HexToAddress("0x01")
+		sanctioned = common.HexToAddress("0x02")
+		dapp       = common.HexToAddress("0x03")
+		other      = common.HexToAddress("0x04")
+	)
+	newTx := func(to common.Address, accessList ethtypes.AccessList) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.AccessListTx{ChainID: big.NewInt(1), To: &to, AccessList: accessList})
+	}
+
+	p, err := NewTxPolicy(log.New(), nil, nil)
+	require.NoError(t, err)
+	require.False(t, p.Enabled())
+	require.NoError(t, p.Check(sender, newTx(sanctioned, nil)))
+
+	p, err = NewTxPolicy(log.New(), nil, []string{sanctioned.Hex()})
+	require.NoError(t, err)
+	require.ErrorIs(t, p.Check(sender, newTx(sanctioned, nil)), ErrRejected)
+	require.ErrorIs(t, p.Check(sanctioned, newTx(other, nil)), ErrRejected)
+	require.ErrorIs(t, p.Check(sender, newTx(other, ethtypes.AccessList{{Address: sanctioned}})), ErrRejected)
+	require.NoError(t, p.Check(sender, newTx(other, nil)))
+
+	p, err = NewTxPolicy(log.New(), []string{dapp.Hex(), sanctioned.Hex()}, []string{sanctioned.Hex()})
+	require.NoError(t, err)
+	require.NoError(t, p.Check(sender, newTx(dapp, nil)))
+	require.ErrorIs(t, p.Check(sender, newTx(other, nil)), ErrRejected)
+	require.ErrorIs(t, p.Check(sender, newTx(sanctioned, nil)), ErrRejected)
+
+	_, err = NewTxPolicy(log.New(), []string{"0xinvalid"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// matchMethod returns the pattern of the given patterns the method matches, the exact
+// method name (e.g. debug_traceTransaction) takes precedence over the namespace pattern
+// (e.g. debug_*), which takes precedence over the pattern of all the methods (i.e *).
+func matchMethod[T any](patterns map[string]T, method string) string {
+	if _, ok := patterns[method]; ok {
+		return method
+	}
+	if i := strings.Index(method, "_"); i > 0 {
+		if _, ok := patterns[method[:i]+"_*"]; ok {
+			return method[:i] + "_*"
+		}
+	}
+	if _, ok := patterns["*"]; ok {
+		return "*"
+	}
+	return ""
+}
+
+// rateLimiter is a token bucket refilled at the rate of the limit, it holds up to a
+// second of calls.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := rate
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// allow takes a token from the bucket, false if the bucket is empty.
+func (l *rateLimiter) allow(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"sync"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+)
+
+// responseCache caches the responses of the committed blocks in LRUs, the CometBFT blocks
+// and block results by height, the heights of the blocks by hash, and the eth txs and
+// receipts by hash. A committed block is final, so the entries never change, they're only
+// dropped once the block is pruned from the node.
+type responseCache struct {
+	blocks       *lru.Cache[int64, *tmrpctypes.ResultBlock]
+	blockResults *lru.Cache[int64, *tmrpctypes.ResultBlockResults]
+	heights      *lru.Cache[common.Hash, int64]
+	txs          *lru.Cache[common.Hash, *ethapi.RPCTransaction]
+	receipts     *lru.Cache[common.Hash, map[string]interface{}]
+
+	mu sync.Mutex
+	// earliest is the earliest block retained by the node when the cache was last pruned
+	earliest int64
+}
+
+func newResponseCache(size int) *responseCache {
+	return &responseCache{
+		blocks:       lru.NewCache[int64, *tmrpctypes.ResultBlock](size),
+		blockResults: lru.NewCache[int64, *tmrpctypes.ResultBlockResults](size),
+		heights:      lru.NewCache[common.Hash, int64](size),
+		txs:          lru.NewCache[common.Hash, *ethapi.RPCTransaction](size),
+		receipts:     lru.NewCache[common.Hash, map[string]interface{}](size),
+	}
+}
+
+// block returns the cached block at the height, the block is shared so it must not be modified.
+func (c *responseCache) block(height int64) (*tmrpctypes.ResultBlock, bool) {
+	return c.blocks.Get(height)
+}
+
+// blockByHash returns the cached block of the hash, the block is shared so it must not be
+// modified.
+func (c *responseCache) blockByHash(hash common.Hash) (*tmrpctypes.ResultBlock, bool) {
+	height, ok := c.heights.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	return c.blocks.Get(height)
+}
+
+// addBlock caches the block by its height and hash.
+func (c *responseCache) addBlock(block *tmrpctypes.ResultBlock) {
+	if block == nil || block.Block == nil {
+		return
+	}
+	c.blocks.Add(block.Block.Height, block)
+	c.heights.Add(common.BytesToHash(block.BlockID.Hash), block.Block.Height)
+}
+
+// blockResult returns the cached block results at the height, the results are shared so
+// they must not be modified.
+func (c *responseCache) blockResult(height int64) (*tmrpctypes.ResultBlockResults, bool) {
+	return c.blockResults.Get(height)
+}
+
+// addBlockResult caches the block results by their height.
+func (c *responseCache) addBlockResult(res *tmrpctypes.ResultBlockResults) {
+	if res == nil {
+		return
+	}
+	c.blockResults.Add(res.Height, res)
+}
+
+// tx returns a copy of the cached eth tx of the hash.
+func (c *responseCache) tx(hash common.Hash) (*ethapi.RPCTransaction, bool) {
+	tx, ok := c.txs.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	cpy := *tx
+	return &cpy, true
+}
+
+// addTx caches a copy of the eth tx by its hash, the pending txs aren't cached.
+func (c *responseCache) addTx(hash common.Hash, tx *ethapi.RPCTransaction) {
+	if tx == nil || tx.BlockNumber == nil {
+		return
+	}
+	cpy := *tx
+	c.txs.Add(hash, &cpy)
+}
+
+// receipt returns a copy of the cached receipt of the eth tx hash.
+func (c *responseCache) receipt(hash common.Hash) (map[string]interface{}, bool) {
+	receipt, ok := c.receipts.Get(hash)
+	if !ok {
+		return nil, false
+	}
+	return copyReceipt(receipt), true
+}
+
+// addReceipt caches a copy of the receipt by its eth tx hash.
+func (c *responseCache) addReceipt(hash common.Hash, receipt map[string]interface{}) {
+	if receipt == nil {
+		return
+	}
+	c.receipts.Add(hash, copyReceipt(receipt))
+}
+
+// copyReceipt returns a shallow copy of the receipt, so the fields set by the callers don't
+// leak into the cache.
+func copyReceipt(receipt map[string]interface{}) map[string]interface{} {
+	cpy := make(map[string]interface{}, len(receipt))
+	for k, v := range receipt {
+		cpy[k] = v
+	}
+	return cpy
+}
+
+// prune drops the entries of the blocks below the earliest block retained by the node, it's
+// a no-op unless the earliest block moved since the last prune.
+func (c *responseCache) prune(earliest int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if earliest <= c.earliest {
+		return
+	}
+	c.earliest = earliest
+
+	for _, height := range c.blocks.Keys() {
+		if height < earliest {
+			c.blocks.Remove(height)
+		}
+	}
+	for _, height := range c.blockResults.Keys() {
+		if height < earliest {
+			c.blockResults.Remove(height)
+		}
+	}
+	for _, hash := range c.heights.Keys() {
+		if height, ok := c.heights.Peek(hash); ok && height < earliest {
+			c.heights.Remove(hash)
+		}
+	}
+	for _, hash := range c.txs.Keys() {
+		if tx, ok := c.txs.Peek(hash); ok && tx.BlockNumber.ToInt().Int64() < earliest {
+			c.txs.Remove(hash)
+		}
+	}
+	for _, hash := range c.receipts.Keys() {
+		receipt, ok := c.receipts.Peek(hash)
+		if !ok {
+			continue
+		}
+		if height, ok := receipt["blockNumber"].(hexutil.Uint64); ok && int64(height) < earliest { // #nosec G701
+			c.receipts.Remove(hash)
+		}
+	}
+}
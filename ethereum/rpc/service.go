@@ -1,35 +1,33 @@
 package rpc
 
 import (
+	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/eth/ethconfig"
-	"github.com/ethereum/go-ethereum/eth/filters"
+	gethgraphql "github.com/ethereum/go-ethereum/graphql"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	"github.com/artela-network/artela/ethereum/rpc/filters"
+	"github.com/artela-network/artela/ethereum/rpc/graphql"
 	"github.com/artela-network/artela/ethereum/rpc/types"
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
 )
 
-// nolint:unused
-var defaultEthConfig = ethconfig.Config{
-	SyncMode:           0,
-	FilterLogCacheSize: 0,
-}
-
 type ArtelaService struct {
 	clientCtx client.Context
 	wsClient  *rpcclient.WSClient
 	cfg       *Config
 	stack     types.NetworkingStack
 	backend   *BackendImpl
-	// nolint:unused
-	filterSystem *filters.FilterSystem
-	logger       log.Logger
+	logger    log.Logger
+	// apis are the APIs registered on the networking stack
+	apis []rpc.API
 }
 
 func NewArtelaService(
@@ -40,6 +38,7 @@ func NewArtelaService(
 	stack types.NetworkingStack,
 	am *accounts.Manager,
 	logger log.Logger,
+	indexer ethereumtypes.EVMTxIndexer,
 ) *ArtelaService {
 	art := &ArtelaService{
 		cfg:       cfg,
@@ -49,7 +48,7 @@ func NewArtelaService(
 		logger:    logger,
 	}
 
-	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger)
+	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, indexer)
 	return art
 }
 
@@ -83,35 +82,96 @@ func (art *ArtelaService) Start() error {
 		return err
 	}
 
-	return art.stack.Start()
+	if err := art.stack.Start(); err != nil {
+		return err
+	}
+
+	if art.backend.feeStats != nil {
+		art.backend.feeStats.Start()
+	}
+	return nil
+}
+
+// NotifyProposal notifies the service of a block proposal being voted.
+func (art *ArtelaService) NotifyProposal(proposal *abci.RequestProcessProposal) {
+	art.backend.NotifyProposal(proposal)
+}
+
+// SetTraceSnapshots sets the runner of the debug traces against the state snapshots, it
+// must be set before the service is started.
+func (art *ArtelaService) SetTraceSnapshots(snapshots types.TraceSnapshots) {
+	art.backend.traceSnapshots = snapshots
+}
+
+// NotifyCheckedTx notifies the service of a new tx passing the CheckTx.
+func (art *ArtelaService) NotifyCheckedTx(tx []byte) {
+	art.backend.NotifyCheckedTx(tx)
+}
+
+// Attach creates an in-process client of the ethereum JsonRPC service.
+func (art *ArtelaService) Attach() (*rpc.Client, error) {
+	return art.stack.Attach()
 }
 
+// Shutdown stops the ethereum JsonRPC service gracefully. The networking stack stops taking
+// new requests and drains the in-flight ones first, which also closes the websocket
+// connections with their subscriptions, then the subscriptions to the backend feeds and the
+// cometbft events are closed.
 func (art *ArtelaService) Shutdown() error {
-	// TODO shut down
-	return nil
+	err := art.stack.Close()
+
+	art.backend.scope.Close()
+	for _, api := range art.apis {
+		if filterAPI, ok := api.Service.(*filters.PublicFilterAPI); ok {
+			filterAPI.Stop()
+		}
+	}
+	if art.wsClient != nil && art.wsClient.IsRunning() {
+		if stopErr := art.wsClient.Stop(); stopErr != nil {
+			art.logger.Error("failed to stop the cometbft ws client", "error", stopErr)
+		}
+	}
+
+	if art.backend.feeStats != nil {
+		art.backend.feeStats.Stop()
+	}
+	return err
 }
 
-// RegisterAPIs register apis and create graphql instance.
+// RegisterAPIs register apis, the health checks and the graphql handler if it's enabled.
+// The eth filter API is served by filters.PublicFilterAPI over the cometbft event
+// subscriptions, see GetAPIs.
 func (art *ArtelaService) registerAPIs() error {
-	art.stack.RegisterAPIs(art.APIs())
-	// art.filterSystem = RegisterFilterAPI(art.stack, art.backend, &defaultEthConfig)
+	art.apis = art.APIs()
+	art.stack.RegisterAPIs(art.apis)
 
-	// create graphql
-	// if err := graphql.New(art.stack, art.backend, art.filterSystem, []string{"*"}, []string{"*"}); err != nil {
-	// 	return err
-	// }
+	// liveness and readiness probes for the orchestrators and load balancers
+	art.stack.RegisterHandler("Health", "/health", newHealthHandler(art.backend, false))
+	art.stack.RegisterHandler("Readiness", "/ready", newHealthHandler(art.backend, true))
 
+	if art.cfg.AppCfg != nil && art.cfg.AppCfg.JSONRPC.EnableGraphQL {
+		return art.registerGraphQL()
+	}
 	return nil
 }
 
-// func RegisterFilterAPI(stack types.NetworkingStack, backend ethapi.Backend, ethcfg *ethconfig.Config) *filters.FilterSystem {
-// 	isLightClient := ethcfg.SyncMode == downloader.LightSync
-// 	filterSystem := filters.NewFilterSystem(backend, filters.Config{
-// 		LogCacheSize: ethcfg.FilterLogCacheSize,
-// 	})
-// 	stack.RegisterAPIs([]rpc.API{{
-// 		Namespace: "eth",
-// 		Service:   filters.NewFilterAPI(filterSystem, isLightClient),
-// 	}})
-// 	return filterSystem
-// }
+// registerGraphQL serves the GraphQL queries on /graphql and the GraphiQL query browser
+// on /graphql/ui of the http server.
+func (art *ArtelaService) registerGraphQL() error {
+	handler, err := graphql.NewHandler(art.logger, art.backend)
+	if err != nil {
+		return err
+	}
+
+	cors, vhosts := []string{"*"}, []string{"*"}
+	if n, ok := art.stack.(*Node); ok {
+		cors, vhosts = n.Config().GraphQLCors, n.Config().GraphQLVirtualHosts
+	}
+	h := node.NewHTTPHandlerStack(handler, cors, vhosts, nil)
+
+	art.stack.RegisterHandler("GraphQL UI", "/graphql/ui", gethgraphql.GraphiQL{})
+	art.stack.RegisterHandler("GraphQL UI", "/graphql/ui/", gethgraphql.GraphiQL{})
+	art.stack.RegisterHandler("GraphQL", "/graphql", h)
+	art.stack.RegisterHandler("GraphQL", "/graphql/", h)
+	return nil
+}
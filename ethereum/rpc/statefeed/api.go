@@ -0,0 +1,120 @@
+package statefeed
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/ethereum/replica"
+)
+
+// heightBuffer is the buffer size of the recorded heights of a subscription
+const heightBuffer = 16
+
+// Backend defines the methods required by the state feed API
+type Backend interface {
+	StateChangeSet(height int64) (*replica.ChangeSet, error)
+	LatestStateChangeSet() (int64, error)
+	SubscribeStateChangeSets(ch chan<- int64) event.Subscription
+}
+
+// API serves the state changes of the committed blocks to the read replicas, it's served
+// under the artela namespace only if the state feed is enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new state feed API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// ChangeSet returns the state changes committed in the block and the resulting app hash.
+func (api *API) ChangeSet(height hexutil.Uint64) (*replica.ChangeSet, error) {
+	api.logger.Debug("artela_changeSet", "height", height)
+
+	changeSet, err := api.backend.StateChangeSet(int64(height)) // #nosec G701
+	if err != nil {
+		return nil, err
+	}
+	if changeSet == nil {
+		return nil, fmt.Errorf("change set of block %d not found", height)
+	}
+	return changeSet, nil
+}
+
+// StateChanges streams the change sets of the blocks in order, starting from the block
+// from, the recorded ones first and then the newly committed ones. The subscription is
+// refused if the block from isn't retained, and closed if a recorded block is missing.
+func (api *API) StateChanges(ctx context.Context, from hexutil.Uint64) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	next := int64(from) // #nosec G701
+	latest, err := api.backend.LatestStateChangeSet()
+	if err != nil {
+		return nil, err
+	}
+	if next <= latest {
+		changeSet, err := api.backend.StateChangeSet(next)
+		if err != nil {
+			return nil, err
+		}
+		if changeSet == nil {
+			return nil, fmt.Errorf("change set of block %d is not retained", next)
+		}
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	heightCh := make(chan int64, heightBuffer)
+	heightSub := api.backend.SubscribeStateChangeSets(heightCh)
+
+	go func() {
+		defer heightSub.Unsubscribe()
+
+		for {
+			// send the change sets recorded up to the latest height
+			for {
+				changeSet, err := api.backend.StateChangeSet(next)
+				if err != nil {
+					api.logger.Error("failed to load state change set", "height", next, "error", err)
+					return
+				}
+				if changeSet == nil {
+					break
+				}
+				if err := notifier.Notify(rpcSub.ID, changeSet); err != nil {
+					return
+				}
+				next++
+			}
+			if latest, err := api.backend.LatestStateChangeSet(); err != nil || next <= latest {
+				// the change set of the block isn't recorded, the stream can't go on
+				return
+			}
+
+			select {
+			case <-heightCh:
+			case <-heightSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// LoadTLSConfig loads the TLS config of the json-rpc http and websocket endpoints from the pem
+// files of the certificate and its key, it returns nil if neither is set. The clients must
+// present a certificate signed by the client CA if its file is set.
+func LoadTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" {
+			return nil, errors.New("client CA of TLS is set without the certificate and key")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both the certificate and key of TLS must be set")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate, %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the TLS client CA, %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in the TLS client CA file %s", clientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// registeredHandler is a handler registered on the http endpoint.
+type registeredHandler struct {
+	name    string
+	path    string
+	handler http.Handler
+}
+
+// registerTLSRPC serves the http and websocket json-rpc over TLS on the endpoints of the
+// config, with the listeners of its own as the servers of the node can't serve TLS. The
+// http and websocket requests are served on the same listener if their endpoints are the
+// same, as the servers of the node do.
+func (n *Node) registerTLSRPC() {
+	cfg := n.Config()
+	servers := make(map[string]*tlsServer)
+	server := func(endpoint string) *tlsServer {
+		if servers[endpoint] == nil {
+			servers[endpoint] = &tlsServer{node: n, endpoint: endpoint, mux: http.NewServeMux()}
+			n.RegisterLifecycle(servers[endpoint])
+		}
+		return servers[endpoint]
+	}
+
+	if n.tlsHTTPEndpoint != "" {
+		s := server(n.tlsHTTPEndpoint)
+		handler, srv := n.newHTTPHandler()
+		s.http = handler
+		s.httpPrefix = cfg.HTTPPathPrefix
+		s.srvs = append(s.srvs, srv)
+		for _, h := range n.handlers {
+			s.mux.Handle(h.path, h.handler)
+		}
+	}
+	if n.tlsWSEndpoint != "" {
+		s := server(n.tlsWSEndpoint)
+		srv := n.newModulesServer(cfg.WSModules)
+		s.ws = node.NewWSHandlerStack(srv.WebsocketHandler(cfg.WSOrigins), nil)
+		s.wsPrefix = cfg.WSPathPrefix
+		s.srvs = append(s.srvs, srv)
+	}
+}
+
+// tlsServer is a TLS listener of the json-rpc, it's started and stopped along with the node.
+type tlsServer struct {
+	node     *Node
+	endpoint string
+	srvs     []*rpc.Server
+
+	// mux has the handlers registered on the http endpoint
+	mux        *http.ServeMux
+	http       http.Handler
+	httpPrefix string
+	ws         http.Handler
+	wsPrefix   string
+
+	httpSrv *http.Server
+}
+
+func (s *tlsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.ws != nil && isWebsocket(r) {
+		if checkPath(r, s.wsPrefix) {
+			s.ws.ServeHTTP(w, r)
+		}
+		return
+	}
+
+	if s.http != nil {
+		if handler, pattern := s.mux.Handler(r); pattern != "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		if checkPath(r, s.httpPrefix) {
+			s.http.ServeHTTP(w, r)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+// checkPath reports if the path of the request is served on the prefix, the same as the
+// servers of the node.
+func checkPath(r *http.Request, prefix string) bool {
+	if prefix == "" {
+		return r.URL.Path == "/"
+	}
+	return len(r.URL.Path) >= len(prefix) && r.URL.Path[:len(prefix)] == prefix
+}
+
+func (s *tlsServer) Start() error {
+	listener, err := net.Listen("tcp", s.endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the TLS endpoint %s, %w", s.endpoint, err)
+	}
+
+	timeouts := s.node.Config().HTTPTimeouts
+	s.httpSrv = &http.Server{
+		Handler:           s,
+		ReadTimeout:       timeouts.ReadTimeout,
+		ReadHeaderTimeout: timeouts.ReadHeaderTimeout,
+		WriteTimeout:      timeouts.WriteTimeout,
+		IdleTimeout:       timeouts.IdleTimeout,
+	}
+	go func() {
+		_ = s.httpSrv.Serve(tls.NewListener(listener, s.node.tlsConfig))
+	}()
+
+	if logger := s.node.Config().Logger; logger != nil {
+		logger.Info("TLS RPC server started", "endpoint", listener.Addr().String(),
+			"http", s.http != nil, "ws", s.ws != nil, "client-auth", s.node.tlsConfig.ClientCAs != nil)
+	}
+	return nil
+}
+
+// Stop drains the in-flight http requests, then stops the rpc servers, which closes the
+// websocket connections and their subscriptions.
+func (s *tlsServer) Stop() error {
+	var err error
+	if s.httpSrv != nil {
+		err = shutdownHTTP(s.httpSrv)
+	}
+	for _, srv := range s.srvs {
+		srv.Stop()
+	}
+	return err
+}
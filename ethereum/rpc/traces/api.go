@@ -0,0 +1,174 @@
+package traces
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/ethereum/rpc/ethapi"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+)
+
+// callTracer is the tracer the parity traces are converted from.
+const callTracer = "callTracer"
+
+// Backend defines the methods required by the trace API
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	RPCBlockRangeCap() int32
+	GetTransaction(ctx context.Context, txHash common.Hash) (*ethapi.RPCTransaction, error)
+	TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(ctx context.Context, number rpc.BlockNumber, config *rpctypes.TraceConfig) (*rpctypes.BlockTraceResult, error)
+}
+
+// FilterArgs is the criteria of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// API offers the flat call traces in the OpenEthereum (parity) format under the trace
+// namespace, the traces are converted from the results of the call tracer.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new trace API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// Transaction returns the traces of the calls made by the tx, nil if the tx is not found
+// or not included in a block yet.
+func (api *API) Transaction(ctx context.Context, hash common.Hash) ([]*Trace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	tx, err := api.backend.GetTransaction(ctx, hash)
+	if err != nil || tx == nil || tx.BlockHash == nil {
+		return nil, nil
+	}
+
+	result, err := api.backend.TraceTransaction(ctx, hash, &rpctypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenCallTrace(bz, txContext{
+		blockHash:   *tx.BlockHash,
+		blockNumber: tx.BlockNumber.ToInt().Uint64(),
+		txHash:      hash,
+		txPosition:  uint64(*tx.TransactionIndex),
+	})
+}
+
+// Block returns the traces of the calls made by the txs of the block.
+func (api *API) Block(ctx context.Context, blockNr rpc.BlockNumber) ([]*Trace, error) {
+	api.logger.Debug("trace_block", "block number", blockNr)
+
+	return api.blockTraces(ctx, blockNr)
+}
+
+// Filter returns the traces of the calls in the block range matching the from and to
+// addresses, paginated by after and count.
+func (api *API) Filter(ctx context.Context, args FilterArgs) ([]*Trace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from, to := int64(latest), int64(latest)
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if limit := int64(api.backend.RPCBlockRangeCap()); limit > 0 && to-from+1 > limit {
+		return nil, fmt.Errorf("block range exceeds the max of %d blocks", limit)
+	}
+
+	fromAddresses := make(map[common.Address]struct{}, len(args.FromAddress))
+	for _, address := range args.FromAddress {
+		fromAddresses[address] = struct{}{}
+	}
+	toAddresses := make(map[common.Address]struct{}, len(args.ToAddress))
+	for _, address := range args.ToAddress {
+		toAddresses[address] = struct{}{}
+	}
+
+	if args.Count != nil && *args.Count == 0 {
+		return []*Trace{}, nil
+	}
+	var after uint64
+	if args.After != nil {
+		after = *args.After
+	}
+
+	var result []*Trace
+	for height := from; height <= to; height++ {
+		traces, err := api.blockTraces(ctx, rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range traces {
+			if !trace.matches(fromAddresses, toAddresses) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			result = append(result, trace)
+			if args.Count != nil && uint64(len(result)) >= *args.Count {
+				return result, nil
+			}
+		}
+	}
+	return result, nil
+}
+
+func (api *API) blockTraces(ctx context.Context, blockNr rpc.BlockNumber) ([]*Trace, error) {
+	block, err := api.backend.TraceBlock(ctx, blockNr, &rpctypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*Trace
+	for i, tx := range block.Txs {
+		if tx.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s, %s", tx.TxHash.Hex(), tx.Error)
+		}
+		txTraces, err := flattenCallTrace(tx.Result, txContext{
+			blockHash:   block.Hash,
+			blockNumber: uint64(block.Number),
+			txHash:      tx.TxHash,
+			txPosition:  uint64(i),
+		})
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
@@ -0,0 +1,210 @@
+package traces
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// aspectFrameType is the type of the synthetic aspect frames of the call tracer, they are
+// not calls of the EVM, so they are left out of the traces.
+const aspectFrameType = "ASPECT"
+
+// callFrame is a frame of the result of the call tracer.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	To      *common.Address `json:"to"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output"`
+	Error   string          `json:"error"`
+	Calls   []callFrame     `json:"calls"`
+	Value   *hexutil.Big    `json:"value"`
+}
+
+// Action is the action of a trace, the fields set depend on the type of the trace:
+//   - call: callType, from, to, gas, input and value;
+//   - create: creationMethod, from, gas, init and value;
+//   - suicide: address, refundAddress and balance.
+type Action struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// Result is the result of a call or create trace, a create trace has the address and
+// the code of the created contract instead of the output.
+type Result struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// Trace is a flat call trace in the OpenEthereum (parity) format.
+type Trace struct {
+	Action              Action       `json:"action"`
+	BlockHash           common.Hash  `json:"blockHash"`
+	BlockNumber         uint64       `json:"blockNumber"`
+	Error               string       `json:"error,omitempty"`
+	Result              *Result      `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition *uint64      `json:"transactionPosition"`
+	Type                string       `json:"type"`
+}
+
+// txContext is the location of the traced tx.
+type txContext struct {
+	blockHash   common.Hash
+	blockNumber uint64
+	txHash      common.Hash
+	txPosition  uint64
+}
+
+// flattenCallTrace converts the result of the call tracer of a tx to the flat traces, in
+// the depth-first order of the calls.
+func flattenCallTrace(result json.RawMessage, tx txContext) ([]*Trace, error) {
+	var frame callFrame
+	if err := json.Unmarshal(result, &frame); err != nil {
+		return nil, err
+	}
+
+	var traces []*Trace
+	appendFrame(&traces, &frame, []int{}, tx)
+	return traces, nil
+}
+
+func appendFrame(traces *[]*Trace, frame *callFrame, traceAddress []int, tx txContext) {
+	trace := newTrace(frame, tx)
+	trace.TraceAddress = traceAddress
+	*traces = append(*traces, trace)
+
+	for i := range frame.Calls {
+		call := &frame.Calls[i]
+		if call.Type == aspectFrameType {
+			continue
+		}
+
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = trace.Subtraces
+		trace.Subtraces++
+
+		appendFrame(traces, call, childAddress, tx)
+	}
+}
+
+func newTrace(frame *callFrame, tx txContext) *Trace {
+	txHash, txPosition := tx.txHash, tx.txPosition
+	trace := &Trace{
+		BlockHash:           tx.blockHash,
+		BlockNumber:         tx.blockNumber,
+		TransactionHash:     &txHash,
+		TransactionPosition: &txPosition,
+		Error:               traceError(frame.Error),
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	from, gas := frame.From, frame.Gas
+
+	switch frame.Type {
+	case "CREATE", "CREATE2":
+		init := frame.Input
+		trace.Type = "create"
+		trace.Action = Action{
+			CreationMethod: strings.ToLower(frame.Type),
+			From:           &from,
+			Gas:            &gas,
+			Init:           &init,
+			Value:          value,
+		}
+		if trace.Error == "" {
+			code := frame.Output
+			trace.Result = &Result{
+				GasUsed: frame.GasUsed,
+				Address: frame.To,
+				Code:    &code,
+			}
+		}
+	case "SELFDESTRUCT":
+		trace.Type = "suicide"
+		trace.Action = Action{
+			Address:       &from,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		input := frame.Input
+		trace.Type = "call"
+		trace.Action = Action{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			To:       frame.To,
+			Gas:      &gas,
+			Input:    &input,
+			Value:    value,
+		}
+		if trace.Error == "" {
+			output := frame.Output
+			trace.Result = &Result{
+				GasUsed: frame.GasUsed,
+				Output:  &output,
+			}
+		}
+	}
+	return trace
+}
+
+// traceError converts the error of the call tracer to the one of the parity traces.
+func traceError(err string) string {
+	if err == "execution reverted" {
+		return "Reverted"
+	}
+	return err
+}
+
+// matches reports if the trace is sent from one of the from addresses and to one of the
+// to addresses, an empty set of addresses matches all.
+func (t *Trace) matches(from, to map[common.Address]struct{}) bool {
+	var sender, recipient *common.Address
+	switch t.Type {
+	case "create":
+		sender = t.Action.From
+		if t.Result != nil {
+			recipient = t.Result.Address
+		}
+	case "suicide":
+		sender, recipient = t.Action.Address, t.Action.RefundAddress
+	default:
+		sender, recipient = t.Action.From, t.Action.To
+	}
+	return containsAddress(from, sender) && containsAddress(to, recipient)
+}
+
+func containsAddress(set map[common.Address]struct{}, address *common.Address) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if address == nil {
+		return false
+	}
+	_, ok := set[*address]
+	return ok
+}
@@ -0,0 +1,61 @@
+package traces
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallTrace(t *testing.T) {
+	raw := `{
+		"type": "CALL", "from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002", "gas": "0x5208", "gasUsed": "0x5000",
+		"input": "0x", "value": "0x1",
+		"calls": [
+			{"type": "ASPECT", "joinPoint": "preTxExecute", "from": "0x0000000000000000000000000000000000000002"},
+			{"type": "DELEGATECALL", "from": "0x0000000000000000000000000000000000000002",
+			 "to": "0x0000000000000000000000000000000000000003", "gas": "0x100", "gasUsed": "0x10",
+			 "input": "0x01", "output": "0x02",
+			 "calls": [
+				{"type": "CREATE2", "from": "0x0000000000000000000000000000000000000002",
+				 "to": "0x0000000000000000000000000000000000000004", "gas": "0x50", "gasUsed": "0x5",
+				 "input": "0x6000", "output": "0x00", "value": "0x0"}
+			 ]},
+			{"type": "STATICCALL", "from": "0x0000000000000000000000000000000000000002",
+			 "to": "0x0000000000000000000000000000000000000005", "gas": "0x100", "gasUsed": "0x10",
+			 "input": "0x", "error": "execution reverted"}
+		]
+	}`
+
+	tx := txContext{blockNumber: 10, txHash: common.HexToHash("0xaa"), txPosition: 2}
+	traces, err := flattenCallTrace([]byte(raw), tx)
+	require.NoError(t, err)
+	require.Len(t, traces, 4)
+
+	require.Equal(t, "call", traces[0].Type)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+
+	require.Equal(t, "delegatecall", traces[1].Action.CallType)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+
+	require.Equal(t, "create", traces[2].Type)
+	require.Equal(t, "create2", traces[2].Action.CreationMethod)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, common.HexToAddress("0x4"), *traces[2].Result.Address)
+
+	require.Equal(t, "staticcall", traces[3].Action.CallType)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, "Reverted", traces[3].Error)
+	require.Nil(t, traces[3].Result)
+	require.Equal(t, uint64(2), *traces[3].TransactionPosition)
+
+	from := map[common.Address]struct{}{common.HexToAddress("0x2"): {}}
+	to := map[common.Address]struct{}{common.HexToAddress("0x4"): {}}
+	require.False(t, traces[1].matches(from, to))
+	require.True(t, traces[2].matches(from, to))
+	require.True(t, traces[3].matches(from, nil))
+}
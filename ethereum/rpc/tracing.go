@@ -0,0 +1,237 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// TraceTransaction re-executes the ethereum transaction against the state at the
+// beginning of its block, replaying the ethereum txs included before it, and returns
+// the result produced by the tracer selected in config.
+func (b *BackendImpl) TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if res.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	if res.SystemTx {
+		return nil, errors.New("system txs are not traceable")
+	}
+
+	blk, err := b.CosmosBlockByNumber(rpc.BlockNumber(res.Height))
+	if err != nil {
+		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+
+	blockRes, err := b.CosmosBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+
+	// the system txs are not replayed, the index of the tx in the msgs of the cosmos txs is
+	// its eth tx index minus the number of the system txs of the begin blockers
+	msgs, firstIndex := b.cosmosEthMsgs(blk, blockRes)
+	index := int(res.EthTxIndex) - firstIndex
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		for i := range msgs {
+			if msgs[i].Hash == hash.Hex() {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 || index >= len(msgs) {
+		return nil, fmt.Errorf("can't find index of ethereum tx %s", hash.Hex())
+	}
+
+	req := txs.QueryTraceTxRequest{
+		Msg:             msgs[index],
+		Predecessors:    msgs[:index],
+		TraceConfig:     config.ToTraceConfig(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdktypes.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := res.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	traceResult, err := b.traceTx(ctx, contextHeight, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
+// TraceBlock re-executes the ethereum txs of the block against the state at the beginning
+// of the block and returns the results produced by the tracer selected in config, in the
+// order of the txs.
+func (b *BackendImpl) TraceBlock(ctx context.Context, number rpc.BlockNumber, config *rpctypes.TraceConfig) (*rpctypes.BlockTraceResult, error) {
+	blk, err := b.CosmosBlockByNumber(number)
+	if err != nil || blk == nil {
+		return nil, fmt.Errorf("block not found for height %d, %w", number, err)
+	}
+
+	height := blk.Block.Height
+	if height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	blockRes, err := b.CosmosBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	result := &rpctypes.BlockTraceResult{
+		Number: height,
+		Hash:   common.BytesToHash(blk.BlockID.Hash.Bytes()),
+	}
+	// the system txs are not traced
+	msgs, _ := b.cosmosEthMsgs(blk, blockRes)
+	if len(msgs) == 0 {
+		return result, nil
+	}
+
+	req := txs.QueryTraceBlockRequest{
+		Txs:             msgs,
+		TraceConfig:     config.ToTraceConfig(),
+		BlockNumber:     height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdktypes.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	traceResult, err := b.traceBlock(ctx, contextHeight, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(traceResult.Data, &result.Txs); err != nil {
+		return nil, err
+	}
+	if len(result.Txs) != len(msgs) {
+		return nil, fmt.Errorf("unexpected number of tx traces, expected %d, got %d", len(msgs), len(result.Txs))
+	}
+	for i, msg := range msgs {
+		result.Txs[i].TxHash = common.HexToHash(msg.Hash)
+	}
+	return result, nil
+}
+
+// StorageRangeAt returns a range of the storage of the contract after the ethereum txs of the
+// block before the tx index, which are replayed against the state at the beginning of the block.
+func (b *BackendImpl) StorageRangeAt(ctx context.Context, blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (*txs.StorageRangeResult, error) {
+	if maxResult < 0 {
+		return nil, fmt.Errorf("max result cannot be negative, got %d", maxResult)
+	}
+
+	blk, err := b.CosmosBlockByHash(blockHash)
+	if err != nil || blk == nil {
+		return nil, fmt.Errorf("block not found for hash %s, %w", blockHash.Hex(), err)
+	}
+
+	height := blk.Block.Height
+	blockRes, err := b.CosmosBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", height, "error", err.Error())
+		return nil, err
+	}
+
+	if txIndex < 0 || txIndex > len(b.EthMsgsFromCosmosBlock(blk, blockRes)) {
+		return nil, fmt.Errorf("tx index %d out of range for block %s", txIndex, blockHash.Hex())
+	}
+
+	// the system txs are not replayed, only the eth txs of the cosmos txs before the tx index are
+	msgs, firstIndex := b.cosmosEthMsgs(blk, blockRes)
+	predecessors := txIndex - firstIndex
+	if predecessors < 0 {
+		predecessors = 0
+	} else if predecessors > len(msgs) {
+		predecessors = len(msgs)
+	}
+
+	req := txs.QueryStorageRangeAtRequest{
+		Address:         contractAddress.Hex(),
+		KeyStart:        keyStart,
+		MaxResult:       uint64(maxResult),
+		Predecessors:    msgs[:predecessors],
+		BlockNumber:     height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdktypes.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	res, err := b.queryClient.StorageRangeAt(rpctypes.ContextWithHeight(contextHeight), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var result txs.StorageRangeResult
+	if err := json.Unmarshal(res.Data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// traceTx runs the trace query against the state at the height, on a state snapshot if the
+// app supports it, otherwise over gRPC.
+func (b *BackendImpl) traceTx(ctx context.Context, height int64, req *txs.QueryTraceTxRequest) (*txs.QueryTraceTxResponse, error) {
+	if b.traceSnapshots != nil {
+		return b.traceSnapshots.TraceTx(ctx, height, req)
+	}
+	return b.queryClient.TraceTx(rpctypes.ContextWithHeight(height), req)
+}
+
+// traceBlock runs the block trace query against the state at the height, on a state snapshot
+// if the app supports it, otherwise over gRPC.
+func (b *BackendImpl) traceBlock(ctx context.Context, height int64, req *txs.QueryTraceBlockRequest) (*txs.QueryTraceBlockResponse, error) {
+	if b.traceSnapshots != nil {
+		return b.traceSnapshots.TraceBlock(ctx, height, req)
+	}
+	return b.queryClient.TraceBlock(rpctypes.ContextWithHeight(height), req)
+}
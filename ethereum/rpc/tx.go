@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 
-	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -32,7 +34,14 @@ import (
 
 // Transaction pool API
 
+// errReadOnly is returned by the methods sending or signing txs when the server is read-only.
+var errReadOnly = errors.New("the JSON-RPC server is read-only")
+
 func (b *BackendImpl) SendTx(ctx context.Context, signedTx *ethtypes.Transaction) error {
+	if b.appConf.JSONRPC.ReadOnly {
+		return errReadOnly
+	}
+
 	// verify the ethereum tx
 	ethereumTx := &txs.MsgEthereumTx{}
 	if err := ethereumTx.FromEthereumTx(signedTx); err != nil {
@@ -45,6 +54,16 @@ func (b *BackendImpl) SendTx(ctx context.Context, signedTx *ethtypes.Transaction
 		return err
 	}
 
+	if b.txPolicy.Enabled() {
+		from, err := ethtypes.LatestSignerForChainID(b.chainID).Sender(signedTx)
+		if err != nil {
+			return err
+		}
+		if err := b.txPolicy.Check(from, signedTx); err != nil {
+			return err
+		}
+	}
+
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &txs.QueryParamsRequest{})
 	if err != nil {
@@ -65,22 +84,32 @@ func (b *BackendImpl) SendTx(ctx context.Context, signedTx *ethtypes.Transaction
 		return err
 	}
 
-	// txHash := ethereumTx.AsTransaction().Hash()
+	txHash := signedTx.Hash()
+	b.publishTxStatus(rpctypes.TxStatus{Hash: txHash, Stage: rpctypes.TxStageSubmitted})
 
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
-		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+		err = rpctypes.NewABCIError(rsp)
 	}
 	if err != nil {
 		b.logger.Error("failed to broadcast tx", "error", err.Error())
+		b.publishTxStatus(rpctypes.TxStatus{Hash: txHash, Stage: rpctypes.TxStageRejected, Error: err.Error()})
 		return err
 	}
 
+	b.publishTxStatus(rpctypes.TxStatus{Hash: txHash, Stage: rpctypes.TxStageChecked})
+	b.localTxs.add(txHash, ethereumTx)
 	return nil
 }
 
 func (b *BackendImpl) GetTransaction(ctx context.Context, txHash common.Hash) (*ethapi.RPCTransaction, error) {
+	if b.respCache != nil {
+		if rpcTx, ok := b.respCache.tx(txHash); ok {
+			return rpcTx, nil
+		}
+	}
+
 	res, err := b.GetTxByEthHash(txHash)
 	hexTx := txHash.Hex()
 
@@ -88,29 +117,24 @@ func (b *BackendImpl) GetTransaction(ctx context.Context, txHash common.Hash) (*
 		b.logger.Debug("GetTxByEthHash failed, try to getTransactionByHashPending", "error", err)
 		return b.getTransactionByHashPending(txHash)
 	}
+	b.localTxs.remove(txHash)
 
 	block, err := b.CosmosBlockByNumber(rpc.BlockNumber(res.Height))
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
-	if err != nil {
-		return nil, err
-	}
-
-	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
-	msg, ok := tx.GetMsgs()[res.MsgIndex].(*txs.MsgEthereumTx)
-	if !ok {
-		return nil, errors.New("invalid ethereum tx")
-	}
-
 	blockRes, err := b.CosmosBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
 		return nil, nil
 	}
 
+	msg, tx, err := b.ethMsgFromTxResult(res, block, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
 	if res.EthTxIndex == -1 {
 		// Fallback to find tx index by iterating all valid eth transactions
 		msgs := b.EthMsgsFromCosmosBlock(block, blockRes)
@@ -132,14 +156,26 @@ func (b *BackendImpl) GetTransaction(ctx context.Context, txHash common.Hash) (*
 		b.logger.Error("failed to fetch Base Fee from prunned block. Check node prunning configuration", "height", blockRes.Height, "error", err)
 	}
 
-	return ethapi.NewTransactionFromMsg(
+	rpcTx := ethapi.NewTransactionFromMsg(
 		msg,
 		common.BytesToHash(block.BlockID.Hash.Bytes()),
 		uint64(res.Height),
 		uint64(res.EthTxIndex),
 		baseFee,
 		b.ChainConfig(),
-	), nil
+	)
+	if tx != nil {
+		res.SetTxMetadata(tx)
+	}
+	rpcTx.Memo = res.Memo
+	if res.TimeoutHeight != 0 {
+		timeoutHeight := hexutil.Uint64(res.TimeoutHeight)
+		rpcTx.TimeoutHeight = &timeoutHeight
+	}
+	if b.respCache != nil {
+		b.respCache.addTx(txHash, rpcTx)
+	}
+	return rpcTx, nil
 }
 
 func (b *BackendImpl) GetPoolTransactions() (ctypes.Transactions, error) {
@@ -156,28 +192,91 @@ func (b *BackendImpl) GetPoolNonce(_ context.Context, addr common.Address) (uint
 	return 0, errors.New("GetPoolNonce is not implemented")
 }
 
+// Stats returns the number of pending and queued ethereum txs in the mempool.
 func (b *BackendImpl) Stats() (int, int) {
-	b.logger.Error("Stats is not implemented")
-	return 0, 0
+	pending, queued := b.TxPoolContent()
+
+	var numPending, numQueued int
+	for _, list := range pending {
+		numPending += len(list)
+	}
+	for _, list := range queued {
+		numQueued += len(list)
+	}
+	return numPending, numQueued
 }
 
+// TxPoolContent returns the ethereum txs in the CometBFT mempool grouped by sender and
+// sorted by nonce. The mempool only keeps the txs passed CheckTx, which requires the
+// exact account nonce, so all of them are pending and none is queued.
 func (b *BackendImpl) TxPoolContent() (
 	map[common.Address]ctypes.Transactions, map[common.Address]ctypes.Transactions,
 ) {
-	b.logger.Error("TxPoolContent is not implemented")
-	return nil, nil
+	pending := make(map[common.Address]ctypes.Transactions)
+	queued := make(map[common.Address]ctypes.Transactions)
+
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Debug("failed to fetch pending txs", "error", err.Error())
+		return pending, queued
+	}
+
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*txs.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := b.GetSender(ethMsg, b.chainID)
+			if err != nil {
+				continue
+			}
+			pending[sender] = append(pending[sender], ethMsg.AsTransaction())
+		}
+	}
+
+	for _, list := range pending {
+		sort.Sort(ctypes.TxByNonce(list))
+	}
+	return pending, queued
 }
 
+// TxPoolContentFrom returns the pending and queued ethereum txs of the address in the mempool.
 func (b *BackendImpl) TxPoolContentFrom(addr common.Address) (
 	ctypes.Transactions, ctypes.Transactions,
 ) {
-	return nil, nil
+	pending, queued := b.TxPoolContent()
+	return pending[addr], queued[addr]
 }
 
+// SubscribeNewTxsEvent subscribes to the ethereum txs passing the CheckTx of the node.
 func (b *BackendImpl) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
 	return b.scope.Track(b.newTxsFeed.Subscribe(ch))
 }
 
+// NotifyCheckedTx publishes the ethereum txs of a cosmos tx passing the CheckTx, it doesn't
+// block the caller, which is the mempool of the node.
+func (b *BackendImpl) NotifyCheckedTx(txBytes []byte) {
+	go func() {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			return
+		}
+
+		var ethTxs []*ethtypes.Transaction
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*txs.MsgEthereumTx); ok {
+				ethTxs = append(ethTxs, ethMsg.AsTransaction())
+			}
+		}
+		if len(ethTxs) > 0 {
+			b.newTxsFeed.Send(core.NewTxsEvent{Txs: ethTxs})
+		}
+	}()
+}
+
 // Version returns the current ethereum protocol version.
 func (b *BackendImpl) Version() string {
 	chainID := b.ChainConfig().ChainID
@@ -194,9 +293,11 @@ func (b *BackendImpl) Engine() consensus.Engine {
 }
 
 func (b *BackendImpl) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
-	// if b.indexer != nil {
-	// 	return b.indexer.GetByTxHash(hash)
-	// }
+	if b.indexer != nil {
+		if res, err := b.indexer.GetByTxHash(hash); err == nil {
+			return res, nil
+		}
+	}
 
 	// fallback to tendermint tx indexer
 	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
@@ -211,6 +312,12 @@ func (b *BackendImpl) GetTxByEthHash(hash common.Hash) (*types.TxResult, error)
 
 // GetTransactionReceipt get receipt by transaction hash
 func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	if b.respCache != nil {
+		if receipt, ok := b.respCache.receipt(hash); ok {
+			return receipt, nil
+		}
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("GetTransactionReceipt failed", "error", err)
@@ -221,28 +328,346 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		b.logger.Debug("GetTransactionReceipt failed", "error", err)
 		return nil, nil
 	}
-	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	blockRes, err := b.CosmosBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("GetTransactionReceipt failed", "error", err)
+		return nil, nil
+	}
+	ethMsg, _, err := b.ethMsgFromTxResult(res, resBlock, blockRes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode tx: %w", err)
+		return nil, err
 	}
-	ethMsg := tx.GetMsgs()[res.MsgIndex].(*txs.MsgEthereumTx)
 
-	txData, err := txs.UnpackTxData(ethMsg.Data)
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hash.Hex() {
+				res.EthTxIndex = int32(i) // #nosec G701
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	baseFee, _ := b.BaseFee(blockRes)
+	receipt, err := b.formatTxReceipt(hash, ethMsg, res, resBlock, blockRes, baseFee)
+	if err == nil && b.respCache != nil {
+		b.respCache.addReceipt(hash, receipt)
+	}
+	return receipt, err
+}
+
+// GetBlockReceipts returns the receipts of all the eth txs of the block, they are assembled
+// from the CometBFT block results in one pass instead of a tx indexer lookup per tx.
+func (b *BackendImpl) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts failed", "error", err)
+		return nil, nil
+	}
+	resBlock, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts failed", "error", err)
+		return nil, nil
+	}
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts failed", "error", err)
+		return nil, nil
+	}
+	baseFee, _ := b.BaseFee(blockRes)
+
+	ethMsgs, results, err := b.ethTxResultsFromCosmosBlock(resBlock, blockRes)
 	if err != nil {
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.CosmosBlockResultByNumber(&res.Height)
+	receipts := make([]map[string]interface{}, 0, len(ethMsgs))
+	for i, ethMsg := range ethMsgs {
+		receipt, err := b.formatTxReceipt(ethMsg.AsTransaction().Hash(), ethMsg, results[i], resBlock, blockRes, baseFee)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// ethTxResultsFromCosmosBlock returns the eth msgs of the block and their results, the results
+// are built as by the custom tx indexer.
+func (b *BackendImpl) ethTxResultsFromCosmosBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) ([]*txs.MsgEthereumTx, []*types.TxResult, error) {
+	beginTxs, endTxs, err := rpctypes.BlockSystemTxs(blockRes)
 	if err != nil {
-		b.logger.Debug("GetTransactionReceipt failed", "error", err)
+		return nil, nil, fmt.Errorf("failed to parse system txs, block %d: %w", resBlock.Block.Height, err)
+	}
+
+	var (
+		ethMsgs    []*txs.MsgEthereumTx
+		results    []*types.TxResult
+		ethTxIndex int32
+		// the system txs are indexed by their index in the system txs of the block, their
+		// cumulative gas used is the one of the block, the same as the custom tx indexer
+		systemTxIndex uint32
+	)
+	addSystemTxs := func(systemTxs []*rpctypes.SystemTx, cumulativeGasUsed uint64) error {
+		for _, systemTx := range systemTxs {
+			ethMsg, err := systemTx.Msg()
+			if err != nil {
+				return err
+			}
+			cumulativeGasUsed += systemTx.GasUsed
+			ethMsgs = append(ethMsgs, ethMsg)
+			results = append(results, &types.TxResult{
+				Height:            resBlock.Block.Height,
+				MsgIndex:          systemTxIndex,
+				EthTxIndex:        ethTxIndex,
+				GasUsed:           systemTx.GasUsed,
+				Failed:            systemTx.Failed,
+				CumulativeGasUsed: cumulativeGasUsed,
+				SystemTx:          true,
+			})
+			systemTxIndex++
+			ethTxIndex++
+		}
+		return nil
+	}
+
+	if err := addSystemTxs(beginTxs, 0); err != nil {
+		return nil, nil, err
+	}
+
+	for txIndex, txBz := range resBlock.Block.Txs {
+		result := blockRes.TxsResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse tx events, block %d, tx %d: %w", resBlock.Block.Height, txIndex, err)
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*txs.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res := &types.TxResult{
+				Height:     resBlock.Block.Height,
+				TxIndex:    uint32(txIndex),  // #nosec G701
+				MsgIndex:   uint32(msgIndex), // #nosec G701
+				EthTxIndex: ethTxIndex,
+			}
+			res.SetTxMetadata(tx)
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					return nil, nil, fmt.Errorf("msg index %d not found in events, block %d, tx %d", msgIndex, resBlock.Block.Height, txIndex)
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+			}
+			cumulativeGasUsed += res.GasUsed
+			res.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethMsgs = append(ethMsgs, ethMsg)
+			results = append(results, res)
+		}
+	}
+	blockGasUsed := rpctypes.SystemTxsGasUsed(beginTxs)
+	for _, result := range blockRes.TxsResults {
+		blockGasUsed += uint64(result.GasUsed) // #nosec G701
+	}
+	if err := addSystemTxs(endTxs, blockGasUsed); err != nil {
+		return nil, nil, err
+	}
+	return ethMsgs, results, nil
+}
+
+// ethReceiptsFromCosmosBlock returns the receipts of the eth txs of the block, only the consensus
+// fields and the inclusion fields are set, which is enough for the encoding and the receipts root.
+func (b *BackendImpl) ethReceiptsFromCosmosBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (ethtypes.Receipts, error) {
+	ethMsgs, results, err := b.ethTxResultsFromCosmosBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash().Bytes())
+	receipts := make(ethtypes.Receipts, 0, len(ethMsgs))
+	for i, ethMsg := range ethMsgs {
+		res := results[i]
+		tx := ethMsg.AsTransaction()
+
+		// the cumulative gas used of the block, the same as the one of formatTxReceipt
+		logs, cumulativeGasUsed, err := txReceiptLogs(res, blockRes)
+		if err != nil {
+			return nil, err
+		}
+		if logs == nil {
+			logs = []*ethtypes.Log{}
+		}
+
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: cumulativeGasUsed,
+			Logs:              logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+			BlockHash:         blockHash,
+			BlockNumber:       big.NewInt(res.Height),
+			TransactionIndex:  uint(res.EthTxIndex), // #nosec G701
+		}
+		if res.Failed {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// GetRawTransaction returns the canonical encoding of the indexed eth tx, nil if it's not found.
+func (b *BackendImpl) GetRawTransaction(_ context.Context, txHash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		b.logger.Debug("GetRawTransaction failed, tx not found", "hash", txHash.Hex(), "error", err)
 		return nil, nil
 	}
+
+	block, err := b.CosmosBlockByNumber(rpc.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	var blockRes *tmrpctypes.ResultBlockResults
+	if res.SystemTx {
+		if blockRes, err = b.CosmosBlockResultByNumber(&block.Block.Height); err != nil {
+			return nil, err
+		}
+	}
+
+	msg, _, err := b.ethMsgFromTxResult(res, block, blockRes)
+	if err != nil {
+		return nil, err
+	}
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// ethMsgFromTxResult returns the eth msg of the indexed result and the cosmos tx it's in. The
+// system txs are not in the block, they're parsed from the block results and their cosmos tx
+// is nil.
+func (b *BackendImpl) ethMsgFromTxResult(
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (*txs.MsgEthereumTx, sdktypes.Tx, error) {
+	if res.SystemTx {
+		systemTx, err := systemTxFromTxResult(res, blockRes)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg, err := systemTx.Msg()
+		return msg, nil, err
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*txs.MsgEthereumTx)
+	if !ok {
+		return nil, nil, errors.New("invalid ethereum tx")
+	}
+	return msg, tx, nil
+}
+
+// systemTxFromTxResult returns the system tx of the indexed result, the msg index of a system
+// tx is its index in the system txs of the begin and the end blockers.
+func systemTxFromTxResult(res *types.TxResult, blockRes *tmrpctypes.ResultBlockResults) (*rpctypes.SystemTx, error) {
+	beginTxs, endTxs, err := rpctypes.BlockSystemTxs(blockRes)
+	if err != nil {
+		return nil, err
+	}
+	systemTxs := append(beginTxs, endTxs...)
+	if int(res.MsgIndex) >= len(systemTxs) {
+		return nil, fmt.Errorf("system tx %d not found in block %d", res.MsgIndex, res.Height)
+	}
+	return systemTxs[res.MsgIndex], nil
+}
+
+// txReceiptLogs returns the logs of the indexed eth tx and the gas used by the block up to
+// and including it.
+func txReceiptLogs(res *types.TxResult, blockRes *tmrpctypes.ResultBlockResults) ([]*ethtypes.Log, uint64, error) {
+	if res.SystemTx {
+		systemTx, err := systemTxFromTxResult(res, blockRes)
+		if err != nil {
+			return nil, 0, err
+		}
+		// the cumulative gas used of the system txs is the one of the block
+		return systemTx.Logs, res.CumulativeGasUsed, nil
+	}
+
+	beginTxs, err := rpctypes.ParseSystemTxs(blockRes.BeginBlockEvents)
+	if err != nil {
+		return nil, 0, err
+	}
+	cumulativeGasUsed := rpctypes.SystemTxsGasUsed(beginTxs)
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed)
+		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
+	logs, _ := utils.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
+	return logs, cumulativeGasUsed, nil
+}
+
+// formatTxReceipt returns the receipt of the eth tx from its indexed result and its block.
+func (b *BackendImpl) formatTxReceipt(
+	hash common.Hash,
+	ethMsg *txs.MsgEthereumTx,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := txs.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse tx logs from events
+	logs, cumulativeGasUsed, err := txReceiptLogs(res, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
 	var status hexutil.Uint
 	if res.Failed {
 		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
@@ -255,25 +680,6 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		return nil, err
 	}
 
-	// parse tx logs from events
-	msgIndex := int(res.MsgIndex)
-	logs, _ := utils.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
-
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hash.Hex() {
-				res.EthTxIndex = int32(i) // #nosec G701
-				break
-			}
-		}
-	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
-	}
-
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -308,11 +714,16 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*txs.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err == nil {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*txs.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+	}
+
+	// the memo and timeout height of the cosmos tx, for the deposit-tagging of exchanges
+	if res.Memo != "" {
+		receipt["memo"] = res.Memo
+	}
+	if res.TimeoutHeight != 0 {
+		receipt["timeoutHeight"] = hexutil.Uint64(res.TimeoutHeight)
 	}
 
 	return receipt, nil
@@ -331,16 +742,42 @@ func (b *BackendImpl) queryCosmosTxIndexer(query string, txGetter func(*rpctypes
 		return nil, errors.New("invalid ethereum tx")
 	}
 
-	var tx sdktypes.Tx
-	if txResult.TxResult.Code != 0 {
-		// it's only needed when the tx exceeds block gas limit
-		tx, err = b.clientCtx.TxConfig.TxDecoder()(txResult.Tx)
-		if err != nil {
-			return nil, fmt.Errorf("invalid ethereum tx, %w", err)
-		}
+	// the tx is needed for its memo and timeout height, and when it exceeds block gas limit
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(txResult.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ethereum tx, %w", err)
+	}
+
+	res, err := rpctypes.ParseTxIndexerResult(txResult, tx, txGetter)
+	if err != nil {
+		return nil, err
+	}
+	res.SetTxMetadata(tx)
+	return res, nil
+}
+
+// TxsByAddress returns at most limit txs of the address before the (height, eth tx index)
+// position, looked up in the address index of the custom indexer, and the first block
+// covered by the index.
+func (b *BackendImpl) TxsByAddress(address common.Address, beforeHeight int64, beforeIndex int32, limit int) ([]types.AddressTx, int64, error) {
+	addressIndexer, ok := b.indexer.(types.EVMAddressIndexer)
+	if !ok {
+		return nil, 0, errors.New("the address index requires the custom indexer")
+	}
+
+	start, err := addressIndexer.AddressIndexStart()
+	if err != nil {
+		return nil, 0, err
+	}
+	if start == -1 {
+		return nil, 0, errors.New("the address index is not enabled")
 	}
 
-	return rpctypes.ParseTxIndexerResult(txResult, tx, txGetter)
+	addressTxs, err := addressIndexer.TxsByAddress(address, beforeHeight, beforeIndex, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return addressTxs, start, nil
 }
 
 // nolint:unused
@@ -348,9 +785,14 @@ func (b *BackendImpl) txResult(ctx context.Context, hash common.Hash, prove bool
 	return b.clientCtx.Client.Tx(ctx, hash.Bytes(), prove)
 }
 
-// getTransactionByHashPending find pending tx from mempool
+// getTransactionByHashPending find pending tx from the local journal and the mempool, the tx
+// is returned with no block values as geth does.
 func (b *BackendImpl) getTransactionByHashPending(txHash common.Hash) (*ethapi.RPCTransaction, error) {
 	hexTx := txHash.Hex()
+	// try to find tx in the txs submitted through the node first
+	if msg := b.localTxs.get(txHash); msg != nil {
+		return ethapi.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, b.ChainConfig()), nil
+	}
 	// try to find tx in mempool
 	ptxs, err := b.PendingTransactions()
 	if err != nil {
@@ -383,7 +825,7 @@ func (b *BackendImpl) getTransactionByHashPending(txHash common.Hash) (*ethapi.R
 	return nil, nil
 }
 
-func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *ethapi.StateOverride) (hexutil.Uint64, error) {
 	blockNum := rpc.LatestBlockNumber
 	if blockNrOrHash != nil {
 		blockNum, _ = b.blockNumberFromCosmos(*blockNrOrHash)
@@ -393,6 +835,10 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionAr
 	if err != nil {
 		return 0, err
 	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return 0, err
+	}
 
 	header, err := b.CosmosBlockByNumber(blockNum)
 	if err != nil {
@@ -405,6 +851,7 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionAr
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -412,7 +859,45 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args ethapi.TransactionAr
 	// the latest block height for querying.
 	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNum.Int64()), &req)
 	if err != nil {
-		return 0, err
+		return 0, queryError(err)
+	}
+	if res.Failed() {
+		return 0, execError(res.VmError, res.Revert())
 	}
 	return hexutil.Uint64(res.Gas), nil
 }
+
+func (b *BackendImpl) CreateAccessList(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*ethapi.AccessListResult, error) {
+	blockNum := rpc.LatestBlockNumber
+	if blockNrOrHash != nil {
+		blockNum, _ = b.blockNumberFromCosmos(*blockNrOrHash)
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := txs.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	res, err := b.queryClient.CreateAccessList(rpctypes.ContextWithHeight(blockNum.Int64()), &req)
+	if err != nil {
+		return nil, queryError(err)
+	}
+	return &ethapi.AccessListResult{
+		Accesslist: res.AccessList.ToEthAccessList(),
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
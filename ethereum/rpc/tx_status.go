@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/event"
+
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+// SubscribeTxStatus subscribes to the lifecycle stages of the ethereum txs observed by the
+// node before they are committed, i.e. the submitted, checked, rejected and proposed stages.
+func (b *BackendImpl) SubscribeTxStatus(ch chan<- rpctypes.TxStatus) event.Subscription {
+	return b.scope.Track(b.txStatusFeed.Subscribe(ch))
+}
+
+func (b *BackendImpl) publishTxStatus(status rpctypes.TxStatus) {
+	b.txStatusFeed.Send(status)
+}
+
+// SubscribeProposals subscribes to the block proposals processed by the node while they
+// are being voted, the proposals may never be committed.
+func (b *BackendImpl) SubscribeProposals(ch chan<- rpctypes.BlockProposal) event.Subscription {
+	return b.scope.Track(b.proposalFeed.Subscribe(ch))
+}
+
+// NotifyProposal publishes a block proposal and the proposed stage of its ethereum txs, it
+// doesn't block the caller, which is the consensus of the node.
+func (b *BackendImpl) NotifyProposal(proposal *abci.RequestProcessProposal) {
+	var (
+		blockNumber = hexutil.Uint64(proposal.Height)
+		hash        = common.BytesToHash(proposal.Hash)
+		proposer    = common.BytesToAddress(proposal.ProposerAddress)
+		timestamp   = proposal.Time.UTC().Unix()
+		proposedTxs = proposal.Txs
+	)
+
+	go func() {
+		proposalTxs := make([]common.Hash, 0, len(proposedTxs))
+		for _, txBytes := range proposedTxs {
+			tx, err := b.clientCtx.TxConfig.TxDecoder()(txBytes)
+			if err != nil {
+				continue
+			}
+			for _, msg := range tx.GetMsgs() {
+				ethMsg, ok := msg.(*txs.MsgEthereumTx)
+				if !ok {
+					continue
+				}
+				txHash := ethMsg.AsTransaction().Hash()
+				proposalTxs = append(proposalTxs, txHash)
+				b.publishTxStatus(rpctypes.TxStatus{
+					Hash:        txHash,
+					Stage:       rpctypes.TxStageProposed,
+					BlockNumber: &blockNumber,
+				})
+			}
+		}
+
+		b.proposalFeed.Send(rpctypes.BlockProposal{
+			Unsafe:       true,
+			BlockNumber:  blockNumber,
+			Hash:         hash,
+			Proposer:     proposer,
+			Timestamp:    hexutil.Uint64(timestamp), // #nosec G701
+			Transactions: proposalTxs,
+		})
+	}()
+}
+
+// TxStatus returns the current stage of the ethereum tx, it's the committed stage with the
+// receipt if the tx is in a block, the checked stage if the tx is in the mempool, or nil.
+func (b *BackendImpl) TxStatus(ctx context.Context, hash common.Hash) (*rpctypes.TxStatus, error) {
+	receipt, err := b.GetTransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt != nil {
+		status := &rpctypes.TxStatus{Hash: hash, Stage: rpctypes.TxStageCommitted, Receipt: receipt}
+		if blockNumber, ok := receipt["blockNumber"].(hexutil.Uint64); ok {
+			status.BlockNumber = &blockNumber
+		}
+		return status, nil
+	}
+
+	pending, err := b.getTransactionByHashPending(hash)
+	if err != nil || pending == nil {
+		return nil, err
+	}
+	return &rpctypes.TxStatus{Hash: hash, Stage: rpctypes.TxStageChecked}, nil
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"math/big"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// AspectEventType is the type of an aspect lifecycle operation.
+type AspectEventType string
+
+const (
+	AspectEventDeploy  AspectEventType = "deploy"
+	AspectEventUpgrade AspectEventType = "upgrade"
+	AspectEventBind    AspectEventType = "bind"
+	AspectEventUnbind  AspectEventType = "unbind"
+)
+
+var aspectEventTypes = map[string]AspectEventType{
+	evmtypes.EventTypeAspectDeploy:  AspectEventDeploy,
+	evmtypes.EventTypeAspectUpgrade: AspectEventUpgrade,
+	evmtypes.EventTypeAspectBind:    AspectEventBind,
+	evmtypes.EventTypeAspectUnbind:  AspectEventUnbind,
+}
+
+// AspectEvent is a notification of artela_aspects subscriptions.
+type AspectEvent struct {
+	Type     AspectEventType `json:"type"`
+	AspectId common.Address  `json:"aspectId"`
+	// Account is the bound or unbound account, set in the bind and unbind events
+	Account *common.Address `json:"account,omitempty"`
+	// Version is the deployed version, or the bound version in the bind events
+	Version *hexutil.Uint64 `json:"version,omitempty"`
+	// Priority is the binding priority, set in the bind events
+	Priority *int8 `json:"priority,omitempty"`
+	// JoinPoint is the join points of the deployed version
+	JoinPoint *hexutil.Big `json:"joinPoint,omitempty"`
+
+	TxHash      common.Hash    `json:"transactionHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+}
+
+// ParseAspectEvents parses the aspect lifecycle events from the events of a cosmos tx.
+// The events of an aspect operation are emitted before the ethereum_tx event of the
+// message, so they take the ethereum tx hash of the next complete ethereum_tx event.
+func ParseAspectEvents(events []abci.Event, height int64) []*AspectEvent {
+	var (
+		result  []*AspectEvent
+		pending []*AspectEvent
+	)
+
+	for _, event := range events {
+		if event.Type == evmtypes.EventTypeEthereumTx {
+			hash, complete := parseCompleteEthTxHash(event.Attributes)
+			if !complete {
+				continue
+			}
+			for _, aspectEvent := range pending {
+				aspectEvent.TxHash = hash
+			}
+			result = append(result, pending...)
+			pending = nil
+			continue
+		}
+
+		typ, ok := aspectEventTypes[event.Type]
+		if !ok {
+			continue
+		}
+
+		aspectEvent := &AspectEvent{
+			Type:        typ,
+			BlockNumber: hexutil.Uint64(height),
+		}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyAspectId:
+				aspectEvent.AspectId = common.HexToAddress(attr.Value)
+			case evmtypes.AttributeKeyAspectAccount:
+				account := common.HexToAddress(attr.Value)
+				aspectEvent.Account = &account
+			case evmtypes.AttributeKeyAspectVersion:
+				if version, err := strconv.ParseUint(attr.Value, 10, 64); err == nil {
+					aspectEvent.Version = (*hexutil.Uint64)(&version)
+				}
+			case evmtypes.AttributeKeyAspectPriority:
+				if priority, err := strconv.ParseInt(attr.Value, 10, 8); err == nil {
+					p := int8(priority)
+					aspectEvent.Priority = &p
+				}
+			case evmtypes.AttributeKeyAspectJoinPoint:
+				if joinPoint, ok := new(big.Int).SetString(attr.Value, 10); ok {
+					aspectEvent.JoinPoint = (*hexutil.Big)(joinPoint)
+				}
+			}
+		}
+		pending = append(pending, aspectEvent)
+	}
+
+	return result
+}
+
+// parseCompleteEthTxHash returns the ethereum tx hash of an ethereum_tx event emitted
+// after the execution, the events emitted by the ante handler don't have the gas used.
+func parseCompleteEthTxHash(attrs []abci.EventAttribute) (common.Hash, bool) {
+	var (
+		hash     common.Hash
+		complete bool
+	)
+	for _, attr := range attrs {
+		switch attr.Key {
+		case evmtypes.AttributeKeyEthereumTxHash:
+			hash = common.HexToHash(attr.Value)
+		case evmtypes.AttributeKeyTxGasUsed:
+			complete = true
+		}
+	}
+	return hash, complete
+}
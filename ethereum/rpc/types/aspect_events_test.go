@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestParseAspectEvents(t *testing.T) {
+	aspectId := common.HexToAddress("0x1000")
+	account := common.HexToAddress("0x2000")
+	txHash := common.HexToHash("0x3000")
+
+	events := []abci.Event{
+		{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+			{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+		}},
+		{Type: evmtypes.EventTypeAspectBind, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyAspectId, Value: aspectId.Hex()},
+			{Key: evmtypes.AttributeKeyAspectAccount, Value: account.Hex()},
+			{Key: evmtypes.AttributeKeyAspectVersion, Value: "2"},
+			{Key: evmtypes.AttributeKeyAspectPriority, Value: "-1"},
+		}},
+		{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeyEthereumTxHash, Value: txHash.Hex()},
+			{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+			{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+		}},
+	}
+
+	parsed := ParseAspectEvents(events, 10)
+	require.Len(t, parsed, 1)
+	require.Equal(t, AspectEventBind, parsed[0].Type)
+	require.Equal(t, aspectId, parsed[0].AspectId)
+	require.Equal(t, account, *parsed[0].Account)
+	require.EqualValues(t, 2, *parsed[0].Version)
+	require.EqualValues(t, -1, *parsed[0].Priority)
+	require.Equal(t, txHash, parsed[0].TxHash)
+	require.EqualValues(t, 10, parsed[0].BlockNumber)
+}
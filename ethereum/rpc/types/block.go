@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
@@ -24,12 +25,28 @@ import (
 type Block struct {
 	ethBlock *ethtypes.Block
 	hash     common.Hash
+	// senders are the senders of the unsigned txs of the block, e.g. the system txs
+	senders map[common.Hash]common.Address
 }
 
 func (b *Block) SetHash(hash common.Hash) {
 	b.hash = hash
 }
 
+// SetSender sets the sender of an unsigned txs of the block.
+func (b *Block) SetSender(txHash common.Hash, sender common.Address) {
+	if b.senders == nil {
+		b.senders = make(map[common.Hash]common.Address)
+	}
+	b.senders[txHash] = sender
+}
+
+// Sender returns the sender of an unsigned txs of the block set by SetSender.
+func (b *Block) Sender(txHash common.Hash) (common.Address, bool) {
+	sender, ok := b.senders[txHash]
+	return sender, ok
+}
+
 func (b *Block) Hash() common.Hash {
 	return b.hash
 }
@@ -101,6 +118,12 @@ func NewBlockNumber(n *big.Int) BlockNumber {
 	return BlockNumber(n.Int64())
 }
 
+// IsLatestBlockTag reports whether the block number is resolved to the latest block, the
+// blocks committed by CometBFT are final, so the finalized and safe blocks are the latest.
+func IsLatestBlockTag(number rpc.BlockNumber) bool {
+	return number == rpc.LatestBlockNumber || number == rpc.FinalizedBlockNumber || number == rpc.SafeBlockNumber
+}
+
 // ContextWithHeight wraps a context with the a gRPC block height header. If the provided height is
 // 0, it will return an empty context and the gRPC query will use the latest block height for querying.
 // Note that all metadata are processed and removed by tendermint layer, so it wont be accessible at gRPC server level.
@@ -214,7 +237,7 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 	case BlockParamEarliest:
 		bn := EthEarliestBlockNumber
 		bnh.BlockNumber = &bn
-	case BlockParamLatest, BlockParamFinalized:
+	case BlockParamLatest, BlockParamFinalized, BlockParamSafe:
 		bn := EthLatestBlockNumber
 		bnh.BlockNumber = &bn
 	case BlockParamPending:
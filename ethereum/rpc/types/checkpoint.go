@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// CheckpointMagic prefixes the binary encoding of a checkpoint range
+	CheckpointMagic = "ARTCKPT"
+	// CheckpointVersion is the version of the binary encoding of a checkpoint range
+	CheckpointVersion byte = 1
+	// CheckpointSize is the size of an encoded checkpoint record:
+	// number(8) | hash(32) | receiptHash(32) | bloom(256)
+	CheckpointSize = 8 + common.HashLength + common.HashLength + ethtypes.BloomByteLength
+
+	// checkpointHeaderSize is the size of the encoding header: magic | version | count(8)
+	checkpointHeaderSize = len(CheckpointMagic) + 1 + 8
+)
+
+// Checkpoint is the compact summary of a block, external indexers use the bloom
+// to pre-filter the blocks before requesting the logs from the node.
+type Checkpoint struct {
+	Number      uint64
+	Hash        common.Hash
+	ReceiptHash common.Hash
+	Bloom       ethtypes.Bloom
+}
+
+// ExecutionDigest is the digest of the execution of the ethereum txs of a block, the nodes
+// executing the block the same way report the same digest.
+type ExecutionDigest struct {
+	BlockNumber  hexutil.Uint64 `json:"blockNumber"`
+	BlockHash    common.Hash    `json:"blockHash"`
+	Digest       common.Hash    `json:"digest"`
+	Transactions hexutil.Uint64 `json:"transactions"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+}
+
+// EncodeCheckpoints encodes the checkpoints into the binary format, the records
+// have a fixed size and follow the header in the given order.
+func EncodeCheckpoints(checkpoints []Checkpoint) []byte {
+	bz := make([]byte, 0, checkpointHeaderSize+len(checkpoints)*CheckpointSize)
+	bz = append(bz, CheckpointMagic...)
+	bz = append(bz, CheckpointVersion)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(len(checkpoints)))
+	for _, checkpoint := range checkpoints {
+		bz = binary.BigEndian.AppendUint64(bz, checkpoint.Number)
+		bz = append(bz, checkpoint.Hash.Bytes()...)
+		bz = append(bz, checkpoint.ReceiptHash.Bytes()...)
+		bz = append(bz, checkpoint.Bloom.Bytes()...)
+	}
+	return bz
+}
+
+// DecodeCheckpoints decodes the checkpoints from the binary format.
+func DecodeCheckpoints(bz []byte) ([]Checkpoint, error) {
+	if len(bz) < checkpointHeaderSize || !bytes.Equal(bz[:len(CheckpointMagic)], []byte(CheckpointMagic)) {
+		return nil, fmt.Errorf("invalid checkpoints header")
+	}
+	if version := bz[len(CheckpointMagic)]; version != CheckpointVersion {
+		return nil, fmt.Errorf("unsupported checkpoints version %d", version)
+	}
+
+	count := binary.BigEndian.Uint64(bz[len(CheckpointMagic)+1:])
+	records := bz[checkpointHeaderSize:]
+	if uint64(len(records))%CheckpointSize != 0 || uint64(len(records))/CheckpointSize != count {
+		return nil, fmt.Errorf("invalid checkpoints length %d, expected %d records", len(records), count)
+	}
+
+	checkpoints := make([]Checkpoint, count)
+	for i := range checkpoints {
+		record := records[i*CheckpointSize : (i+1)*CheckpointSize]
+		checkpoints[i] = Checkpoint{
+			Number:      binary.BigEndian.Uint64(record[:8]),
+			Hash:        common.BytesToHash(record[8 : 8+common.HashLength]),
+			ReceiptHash: common.BytesToHash(record[8+common.HashLength : 8+2*common.HashLength]),
+			Bloom:       ethtypes.BytesToBloom(record[8+2*common.HashLength:]),
+		}
+	}
+	return checkpoints, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointsEncoding(t *testing.T) {
+	var bloom ethtypes.Bloom
+	bloom.Add(common.HexToAddress("0x01").Bytes())
+
+	checkpoints := []Checkpoint{
+		{Number: 7, Hash: common.HexToHash("0xaa"), ReceiptHash: ethtypes.EmptyRootHash, Bloom: bloom},
+		{Number: 8, Hash: common.HexToHash("0xbb"), ReceiptHash: ethtypes.EmptyRootHash},
+	}
+
+	bz := EncodeCheckpoints(checkpoints)
+	require.Len(t, bz, checkpointHeaderSize+2*CheckpointSize)
+
+	decoded, err := DecodeCheckpoints(bz)
+	require.NoError(t, err)
+	require.Equal(t, checkpoints, decoded)
+
+	_, err = DecodeCheckpoints(bz[:len(bz)-1])
+	require.Error(t, err)
+
+	bz[len(CheckpointMagic)] = CheckpointVersion + 1
+	_, err = DecodeCheckpoints(bz)
+	require.Error(t, err)
+}
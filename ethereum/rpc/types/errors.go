@@ -0,0 +1,123 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/status"
+
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+// PrunedErrorCode is the JSON-RPC error code returned for pruned history.
+const PrunedErrorCode = -32000
+
+// PrunedError is returned when the requested block history is no longer
+// available on the node because of the history retention policy.
+type PrunedError struct {
+	Height   int64
+	Earliest int64
+	// State defines if the block is retained but its state has been pruned, the earliest
+	// available state is unknown.
+	State bool
+}
+
+// NewPrunedError creates a PrunedError for the given height.
+func NewPrunedError(height, earliest int64) *PrunedError {
+	return &PrunedError{Height: height, Earliest: earliest}
+}
+
+// NewStatePrunedError creates a PrunedError for the state of the given height pruned by
+// the state pruning of the node.
+func NewStatePrunedError(height int64) *PrunedError {
+	return &PrunedError{Height: height, State: true}
+}
+
+func (e *PrunedError) Error() string {
+	if e.State {
+		return fmt.Sprintf("state at block %d has been pruned, query an archive node for the historical state", e.Height)
+	}
+	return fmt.Sprintf("block %d has been pruned, earliest available height is %d", e.Height, e.Earliest)
+}
+
+// ErrorCode returns the JSON-RPC error code of a pruned error.
+func (e *PrunedError) ErrorCode() int {
+	return PrunedErrorCode
+}
+
+// ErrorData returns the earliest available height.
+func (e *PrunedError) ErrorData() interface{} {
+	if e.State {
+		return nil
+	}
+	return map[string]int64{"earliestHeight": e.Earliest}
+}
+
+// ABCIErrorCode is the JSON-RPC error code returned for the errors of the tx checks and the
+// queries of the node's application.
+const ABCIErrorCode = -32000
+
+// ABCIError is an error of a tx check or a query of the node's application, it's served with
+// the codespace, code and data of the error so the clients can branch on them.
+type ABCIError struct {
+	err  error
+	msg  string
+	info evmtypes.ErrorInfo
+}
+
+// NewABCIError creates an ABCIError from the response of a failed tx broadcast, the data of
+// the error is decoded from the data of the CheckTx response if it carries one.
+func NewABCIError(rsp *cosmos.TxResponse) *ABCIError {
+	e := &ABCIError{
+		err:  errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog),
+		info: evmtypes.ErrorInfo{Codespace: rsp.Codespace, Code: rsp.Code},
+	}
+	e.msg = e.err.Error()
+
+	bz, err := hex.DecodeString(rsp.Data)
+	if err != nil || len(bz) == 0 {
+		return e
+	}
+	var info evmtypes.ErrorInfo
+	if err := json.Unmarshal(bz, &info); err == nil && info.Codespace == rsp.Codespace && info.Code == rsp.Code {
+		e.info.Data = info.Data
+	}
+	return e
+}
+
+// NewQueryError creates an ABCIError from the gRPC status of a failed query if it carries
+// the codespace, code and data of the error, otherwise a plain error with its message.
+func NewQueryError(st *status.Status) error {
+	info, ok := evmtypes.StatusErrorInfo(st)
+	if !ok {
+		return errors.New(st.Message())
+	}
+	return &ABCIError{
+		err:  errors.New(st.Message()),
+		msg:  st.Message(),
+		info: info,
+	}
+}
+
+func (e *ABCIError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the underlying error.
+func (e *ABCIError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the JSON-RPC error code of an ABCI error.
+func (e *ABCIError) ErrorCode() int {
+	return ABCIErrorCode
+}
+
+// ErrorData returns the codespace, code and data of the error.
+func (e *ABCIError) ErrorData() interface{} {
+	return e.info
+}
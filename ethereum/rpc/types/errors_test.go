@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evmtypes "github.com/artela-network/artela/x/evm/types"
+)
+
+func TestNewQueryError(t *testing.T) {
+	dataErr := evmtypes.WrapWithData(evmtypes.ErrGasCapExceeded, evmtypes.ErrorData{
+		evmtypes.ErrorDataProvided: "200",
+		evmtypes.ErrorDataLimit:    "100",
+	}, "gas %d, cap %d", 200, 100)
+
+	// the status is sent over the wire as its proto
+	st := status.FromProto(status.Convert(evmtypes.StatusError(codes.InvalidArgument, dataErr)).Proto())
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, dataErr.Error(), st.Message())
+
+	err := NewQueryError(st)
+	require.Equal(t, "gas 200, cap 100: gas cap exceeded", err.Error())
+	var abciErr *ABCIError
+	require.ErrorAs(t, err, &abciErr)
+	require.Equal(t, evmtypes.ErrorInfo{
+		Codespace: evmtypes.ModuleName,
+		Code:      evmtypes.ErrGasCapExceeded.ABCICode(),
+		Data:      evmtypes.ErrorData{evmtypes.ErrorDataProvided: "200", evmtypes.ErrorDataLimit: "100"},
+	}, abciErr.ErrorData())
+
+	// the data error returned as is by a query handler carries the same details
+	info, ok := evmtypes.StatusErrorInfo(status.Convert(dataErr))
+	require.True(t, ok)
+	require.Equal(t, abciErr.ErrorData(), info)
+
+	// the statuses without details are plain errors
+	err = NewQueryError(status.New(codes.InvalidArgument, "empty request"))
+	require.EqualError(t, err, "empty request")
+	_, ok = err.(*ABCIError)
+	require.False(t, ok)
+}
+
+func TestNewABCIError(t *testing.T) {
+	info := evmtypes.ErrorInfo{
+		Codespace: evmtypes.ModuleName,
+		Code:      evmtypes.ErrEVMPaused.ABCICode(),
+		Data:      evmtypes.ErrorData{evmtypes.ErrorDataHeight: "10"},
+	}
+	bz, err := json.Marshal(info)
+	require.NoError(t, err)
+
+	rsp := &cosmos.TxResponse{
+		Codespace: info.Codespace,
+		Code:      info.Code,
+		RawLog:    "paused until 10: evm is paused",
+		Data:      hex.EncodeToString(bz),
+	}
+	abciErr := NewABCIError(rsp)
+	require.Equal(t, info, abciErr.ErrorData())
+	require.ErrorIs(t, abciErr, evmtypes.ErrEVMPaused)
+
+	// the data of another error is ignored
+	rsp.Code = evmtypes.ErrGasCapExceeded.ABCICode()
+	require.Equal(t, evmtypes.ErrorInfo{Codespace: info.Codespace, Code: rsp.Code}, NewABCIError(rsp).ErrorData())
+
+	// the responses without data carry the codespace and code only
+	rsp.Data = ""
+	require.Equal(t, evmtypes.ErrorInfo{Codespace: info.Codespace, Code: rsp.Code}, NewABCIError(rsp).ErrorData())
+}
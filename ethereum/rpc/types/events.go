@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/artela-network/artela/ethereum/rpc/utils"
 	"github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
 
@@ -11,6 +12,8 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	evmtypes "github.com/artela-network/artela/x/evm/types"
 )
@@ -261,3 +264,125 @@ func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	}
 	return nil
 }
+
+// SystemTx is a system txs of a block parsed from the begin or end block events, the system
+// txs are applied by the blockers, so they're not in the block and their raw txs are in the
+// events.
+type SystemTx struct {
+	Tx         *ethtypes.Transaction
+	From       common.Address
+	EthTxIndex int32
+	GasUsed    uint64
+	Failed     bool
+	Logs       []*ethtypes.Log
+}
+
+// Msg returns the system txs as an ethereum txs message with its sender set.
+func (s *SystemTx) Msg() (*txs.MsgEthereumTx, error) {
+	msg := &txs.MsgEthereumTx{From: s.From.Hex()}
+	if err := msg.FromEthereumTx(s.Tx); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ParseSystemTxs parses the system txs from the begin or end block events, in the order
+// they're applied. The logs of a system txs are in the tx_log event following its
+// ethereum_tx event.
+func ParseSystemTxs(events []abci.Event) ([]*SystemTx, error) {
+	var (
+		systemTxs []*SystemTx
+		last      *SystemTx
+	)
+	for _, event := range events {
+		switch event.Type {
+		case evmtypes.EventTypeEthereumTx:
+			last = nil
+			if !isSystemTxEvent(event) {
+				continue
+			}
+			systemTx, err := parseSystemTx(event.Attributes)
+			if err != nil {
+				return nil, err
+			}
+			systemTxs = append(systemTxs, systemTx)
+			last = systemTx
+		case evmtypes.EventTypeTxLog:
+			if last == nil {
+				continue
+			}
+			logs, err := utils.ParseTxLogsFromEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			last.Logs, last = logs, nil
+		}
+	}
+	return systemTxs, nil
+}
+
+func isSystemTxEvent(event abci.Event) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == evmtypes.AttributeKeySystemTx {
+			return attr.Value == "true"
+		}
+	}
+	return false
+}
+
+func parseSystemTx(attrs []abci.EventAttribute) (*SystemTx, error) {
+	parsed := NewParsedTx(0)
+	if err := fillTxAttributes(&parsed, attrs); err != nil {
+		return nil, err
+	}
+
+	var rawTx []byte
+	for _, attr := range attrs {
+		if attr.Key == evmtypes.AttributeKeyTxData {
+			var err error
+			if rawTx, err = hexutil.Decode(attr.Value); err != nil {
+				return nil, fmt.Errorf("invalid system tx data: %w", err)
+			}
+		}
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return nil, fmt.Errorf("invalid system tx: %w", err)
+	}
+	if tx.Hash() != parsed.Hash {
+		return nil, fmt.Errorf("system tx hash mismatch, expect %s, got %s", parsed.Hash.Hex(), tx.Hash().Hex())
+	}
+	if parsed.EthTxIndex < 0 {
+		return nil, fmt.Errorf("system tx %s has no tx index", parsed.Hash.Hex())
+	}
+
+	return &SystemTx{
+		Tx:         tx,
+		From:       txs.SystemTxSender(tx),
+		EthTxIndex: parsed.EthTxIndex,
+		GasUsed:    parsed.GasUsed,
+		Failed:     parsed.Failed,
+	}, nil
+}
+
+// BlockSystemTxs returns the system txs applied by the begin blockers and by the end blockers
+// of the block.
+func BlockSystemTxs(blockRes *tmrpctypes.ResultBlockResults) (beginTxs, endTxs []*SystemTx, err error) {
+	if beginTxs, err = ParseSystemTxs(blockRes.BeginBlockEvents); err != nil {
+		return nil, nil, err
+	}
+	if endTxs, err = ParseSystemTxs(blockRes.EndBlockEvents); err != nil {
+		return nil, nil, err
+	}
+	return beginTxs, endTxs, nil
+}
+
+// SystemTxsGasUsed returns the total gas used by the system txs.
+func SystemTxsGasUsed(systemTxs []*SystemTx) uint64 {
+	var gasUsed uint64
+	for _, systemTx := range systemTxs {
+		gasUsed += systemTx.GasUsed
+	}
+	return gasUsed
+}
@@ -19,4 +19,10 @@ type NetworkingStack interface {
 
 	// Start starts the networking stack.
 	Start() error
+
+	// Close stops the networking stack and releases its resources.
+	Close() error
+
+	// Attach creates an in-process client of the JSON-RPC APIs of the networking stack.
+	Attach() (*rpc.Client, error)
 }
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+// TraceSnapshots runs the debug traces against dedicated read-only snapshots of the committed
+// state at a height, taken from the versioned readers of the multistore. The traces don't go
+// through the ABCI queries, so they neither serialize with each other nor with the commits.
+type TraceSnapshots interface {
+	TraceTx(ctx context.Context, height int64, req *txs.QueryTraceTxRequest) (*txs.QueryTraceTxResponse, error)
+	TraceBlock(ctx context.Context, height int64, req *txs.QueryTraceBlockRequest) (*txs.QueryTraceBlockResponse, error)
+}
+
+// TraceConfig holds the extra parameters to trace functions, it follows the
+// layout of geth's tracers.TraceConfig so that the tracer config can be given
+// as a JSON object instead of the string used by the gRPC query.
+type TraceConfig struct {
+	Tracer           string          `json:"tracer"`
+	Timeout          string          `json:"timeout"`
+	Reexec           uint64          `json:"reexec"`
+	DisableStack     bool            `json:"disableStack"`
+	DisableStorage   bool            `json:"disableStorage"`
+	EnableMemory     bool            `json:"enableMemory"`
+	EnableReturnData bool            `json:"enableReturnData"`
+	Debug            bool            `json:"debug"`
+	Limit            int32           `json:"limit"`
+	TracerConfig     json.RawMessage `json:"tracerConfig"`
+}
+
+// ToTraceConfig converts the rpc trace config to the one of the evm trace query.
+func (c *TraceConfig) ToTraceConfig() *support.TraceConfig {
+	if c == nil {
+		return nil
+	}
+
+	return &support.TraceConfig{
+		Tracer:           c.Tracer,
+		Timeout:          c.Timeout,
+		Reexec:           c.Reexec,
+		DisableStack:     c.DisableStack,
+		DisableStorage:   c.DisableStorage,
+		EnableMemory:     c.EnableMemory,
+		EnableReturnData: c.EnableReturnData,
+		Debug:            c.Debug,
+		Limit:            c.Limit,
+		TracerJsonConfig: string(c.TracerConfig),
+	}
+}
+
+// TxTraceResult is the trace of a tx in a traced block.
+type TxTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// BlockTraceResult is the traces of the ethereum txs of a block, in the order of the txs.
+type BlockTraceResult struct {
+	Number int64
+	Hash   common.Hash
+	Txs    []*TxTraceResult
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceConfigConversion(t *testing.T) {
+	var config *TraceConfig
+	require.Nil(t, config.ToTraceConfig())
+
+	raw := `{"tracer":"callTracer","timeout":"10s","tracerConfig":{"onlyTopCall":true}}`
+	require.NoError(t, json.Unmarshal([]byte(raw), &config))
+
+	converted := config.ToTraceConfig()
+	require.Equal(t, "callTracer", converted.Tracer)
+	require.Equal(t, "10s", converted.Timeout)
+	require.JSONEq(t, `{"onlyTopCall":true}`, converted.TracerJsonConfig)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TxStage is a stage of the lifecycle of an ethereum tx.
+type TxStage string
+
+const (
+	// TxStageSubmitted means the tx is received by eth_sendRawTransaction of the node
+	TxStageSubmitted TxStage = "submitted"
+	// TxStageChecked means the tx passed the CheckTx and is accepted by the mempool
+	TxStageChecked TxStage = "checked"
+	// TxStageRejected means the tx failed the CheckTx, it is final
+	TxStageRejected TxStage = "rejected"
+	// TxStageProposed means the tx is included in a block proposal
+	TxStageProposed TxStage = "proposed"
+	// TxStageExecuted means the tx is executed in a committed block
+	TxStageExecuted TxStage = "executed"
+	// TxStageCommitted means the receipt of the tx is available, it is final
+	TxStageCommitted TxStage = "committed"
+)
+
+// TxStatus is a notification of artela_transactionStatus subscriptions.
+type TxStatus struct {
+	Hash        common.Hash     `json:"hash"`
+	Stage       TxStage         `json:"stage"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"`
+	// Failed and GasUsed are the execution result, set in the executed stage
+	Failed  *bool           `json:"failed,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	// Error is the reason of the rejected stage
+	Error   string                 `json:"error,omitempty"`
+	Receipt map[string]interface{} `json:"receipt,omitempty"`
+}
+
+// Final returns true if no more stage follows the stage of the status.
+func (s TxStatus) Final() bool {
+	return s.Stage == TxStageRejected || s.Stage == TxStageCommitted
+}
+
+// BlockProposal is a notification of artela_unsafeProposals subscriptions, it's a block
+// proposal being voted by the validators. The proposal is not final, it may be rejected
+// and replaced by another proposal of the same height in a later round.
+type BlockProposal struct {
+	// Unsafe is always true, it marks the proposal as a pre-confirmation signal
+	Unsafe      bool           `json:"unsafe"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// Hash is the cometbft hash of the proposed block
+	Hash      common.Hash    `json:"hash"`
+	Proposer  common.Address `json:"proposer"`
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+	// Transactions are the hashes of the ethereum txs of the proposal
+	Transactions []common.Hash `json:"transactions"`
+}
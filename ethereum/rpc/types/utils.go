@@ -10,6 +10,7 @@ import (
 	evmtypes "github.com/artela-network/artela/x/evm/txs"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -266,3 +267,19 @@ func TxExceedBlockGasLimit(res *abci.ResponseDeliverTx) bool {
 func TxSuccessOrExceedsBlockGasLimit(res *abci.ResponseDeliverTx) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res)
 }
+
+// HexProofs returns the hex encoded data of the proof ops.
+func HexProofs(proof *crypto.ProofOps) []string {
+	if proof == nil {
+		return []string{""}
+	}
+	proofs := make([]string, 0, len(proof.Ops))
+	for _, p := range proof.Ops {
+		proof := ""
+		if len(p.Data) > 0 {
+			proof = hexutil.Encode(p.Data)
+		}
+		proofs = append(proofs, proof)
+	}
+	return proofs
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	support "github.com/artela-network/artela/x/evm/txs/support"
@@ -12,6 +13,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -74,9 +76,60 @@ func ShouldIgnoreGasUsed(res *abci.ResponseDeliverTx) bool {
 	return res.GetCode() == 11 && strings.Contains(res.GetLog(), "no block gas left to run tx: out of gas")
 }
 
+// BlockBloomFromBlockResults returns the bloom of the block emitted by the evm end blocker, false
+// if the block doesn't have the bloom event.
+func BlockBloomFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, bool) {
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
+				return ethtypes.BytesToBloom([]byte(attr.Value)), true
+			}
+		}
+	}
+	return ethtypes.Bloom{}, false
+}
+
+// ExecutionDigestFromBlockResults returns the execution digest of the block emitted by the evm
+// end blocker, false if the block doesn't have the digest event.
+func ExecutionDigestFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) (evmtypes.ExecutionDigest, bool, error) {
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != evmtypes.EventTypeExecutionDigest {
+			continue
+		}
+
+		var (
+			digest evmtypes.ExecutionDigest
+			err    error
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case evmtypes.AttributeKeyDigest:
+				digest.Digest = common.HexToHash(attr.Value)
+			case evmtypes.AttributeKeyDigestTxs:
+				digest.Txs, err = strconv.ParseUint(attr.Value, 10, 64)
+			case evmtypes.AttributeKeyDigestGasUsed:
+				digest.GasUsed, err = strconv.ParseUint(attr.Value, 10, 64)
+			}
+			if err != nil {
+				return evmtypes.ExecutionDigest{}, false, fmt.Errorf("invalid execution digest attribute %s: %w", attr.Key, err)
+			}
+		}
+		return digest, true, nil
+	}
+	return evmtypes.ExecutionDigest{}, false, nil
+}
+
 // GetLogsFromBlockResults returns the list of event logs from the tendermint block result response
 func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
-	blockLogs := [][]*ethtypes.Log{}
+	// the logs of the system txs of the begin blockers come first, the ones of the end blockers last
+	blockLogs, err := AllTxLogsFromEvents(blockRes.BeginBlockEvents)
+	if err != nil {
+		return nil, err
+	}
 	for _, txResult := range blockRes.TxsResults {
 		logs, err := AllTxLogsFromEvents(txResult.Events)
 		if err != nil {
@@ -85,7 +138,11 @@ func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*etht
 
 		blockLogs = append(blockLogs, logs...)
 	}
-	return blockLogs, nil
+	endLogs, err := AllTxLogsFromEvents(blockRes.EndBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	return append(blockLogs, endLogs...), nil
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
@@ -0,0 +1,67 @@
+package witness
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+// Backend defines the methods required by the witness API
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	BlockWitness(height int64) (*states.Witness, error)
+}
+
+// ExecutionWitness is the result of debug_executionWitness.
+type ExecutionWitness struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	*states.Witness
+}
+
+// API offers the execution witnesses recorded by the node, it is served under the
+// debug namespace only if the witness recording is enabled in the app config.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new witness API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger,
+		backend: backend,
+	}
+}
+
+// ExecutionWitness returns the accounts, storage slots and codes read by the EVM in the
+// block, with their values before they were first written in the block. Only the blocks
+// executed after the recording is enabled have a witness.
+func (api *API) ExecutionWitness(blockNr rpc.BlockNumber) (*ExecutionWitness, error) {
+	api.logger.Debug("debug_executionWitness", "block number", blockNr)
+
+	height := blockNr.Int64()
+	if height < 0 {
+		latest, err := api.backend.BlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = int64(latest)
+	}
+
+	witness, err := api.backend.BlockWitness(height)
+	if err != nil {
+		return nil, err
+	}
+	if witness == nil {
+		return nil, fmt.Errorf("witness of block %d not found", height)
+	}
+
+	return &ExecutionWitness{
+		BlockNumber: hexutil.Uint64(height),
+		Witness:     witness,
+	}, nil
+}
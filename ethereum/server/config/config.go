@@ -3,18 +3,21 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
 	"time"
 
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
+	"github.com/ethereum/go-ethereum/common"
 
 	errorsmod "cosmossdk.io/errors"
 	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	aspecttypes "github.com/artela-network/aspect-core/types"
@@ -50,14 +53,48 @@ const (
 
 	DefaultGasCap uint64 = 25000000
 
+	// DefaultRPCGasCap is the default gas cap of the eth_call and eth_estimateGas queries
+	// enforced by the query server.
+	DefaultRPCGasCap uint64 = 50000000
+
 	DefaultFilterCap int32 = 200
 
 	DefaultFeeHistoryCap int32 = 100
 
+	// MaxFeeStatsWindow is the max window of the rolling fee stats.
+	MaxFeeStatsWindow = 24 * time.Hour
+
+	// DefaultGPOBlocks is the default number of recent blocks sampled by the gas price oracle.
+	DefaultGPOBlocks int32 = 20
+
+	// DefaultGPOPercentile is the default percentile of the sampled priority fees suggested
+	// by the gas price oracle.
+	DefaultGPOPercentile int32 = 60
+
+	// DefaultGPOMaxPrice is the default cap of the priority fee suggested by the gas price
+	// oracle, 500 gwei.
+	DefaultGPOMaxPrice uint64 = 500_000_000_000
+
 	DefaultLogsCap int32 = 10000
 
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultHealthMaxBlockLag is the max number of blocks the node can be behind its peers
+	// while /ready reports the node as ready.
+	DefaultHealthMaxBlockLag int64 = 10
+
+	// DefaultHealthMaxIndexerLag is the max number of blocks the indexer can be behind the
+	// node while /ready reports the node as ready.
+	DefaultHealthMaxIndexerLag int64 = 10
+
+	// DefaultStateFeedRetainBlocks is the number of the latest blocks whose state changes are
+	// kept by the state feed served to the read replicas.
+	DefaultStateFeedRetainBlocks uint64 = 10000
+
+	// DefaultHealthMaxBlockAge is the max age of the latest block while /ready reports the
+	// node as ready, 0 disables the check.
+	DefaultHealthMaxBlockAge time.Duration = 0
+
 	DefaultEVMTimeout = 5 * time.Second
 
 	// default 1.0 eth
@@ -72,6 +109,24 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultHistoryEpochLength is the default number of blocks of a history retention epoch
+	DefaultHistoryEpochLength uint64 = 10000
+
+	// DefaultFaucetKeyName is the default keyring entry of the faucet operator signing the drips
+	DefaultFaucetKeyName = "faucet"
+
+	// DefaultFaucetAmount is the default amount sent per faucet request, 1 art
+	DefaultFaucetAmount = "1000000000000000000uart"
+
+	// DefaultFaucetGasLimit is the default gas limit of a faucet transfer
+	DefaultFaucetGasLimit uint64 = 200000
+
+	// DefaultFaucetAddressInterval is the default duration between two requests for the same address
+	DefaultFaucetAddressInterval = 24 * time.Hour
+
+	// DefaultFaucetIPInterval is the default duration between two requests from the same IP
+	DefaultFaucetIPInterval = time.Hour
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -85,6 +140,7 @@ type Config struct {
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
 	Aspect  AspectConfig  `mapstructure:"aspect"`
+	Faucet  FaucetConfig  `mapstructure:"faucet"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -94,6 +150,34 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth txs returned in ante handler in check txs mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-txs-gas-wanted"`
+	// HistoryRetentionEpochs defines how many epochs of block headers and receipts are kept
+	// on a non-archive node, 0 keeps the whole history.
+	HistoryRetentionEpochs uint64 `mapstructure:"history-retention-epochs"`
+	// HistoryEpochLength defines the number of blocks of an epoch, history is compacted
+	// once per epoch.
+	HistoryEpochLength uint64 `mapstructure:"history-epoch-length"`
+	// SimulationNoBaseFee forces the base fee to 0 for the simulated executions, e.g. eth_call
+	// and eth_estimateGas, so they can be run with a zero gas price.
+	SimulationNoBaseFee bool `mapstructure:"simulation-no-base-fee"`
+	// EnablePreimageRecording enables the recording of the SHA3/keccak preimages by the EVM.
+	EnablePreimageRecording bool `mapstructure:"enable-preimage-recording"`
+	// EnableAccessStats enables the recording of the per block storage access counters of the
+	// contracts, which are served by artela_hotContracts.
+	EnableAccessStats bool `mapstructure:"enable-access-stats"`
+	// EnableContractIndex enables the indexing of the creator, creation tx and init code hash
+	// of the contracts, which are served by artela_getContractCreation.
+	EnableContractIndex bool `mapstructure:"enable-contract-index"`
+	// EnableWitness enables the recording of the per block execution witnesses, the state
+	// read by the EVM, which are served by debug_executionWitness.
+	EnableWitness bool `mapstructure:"enable-witness"`
+	// RPCGasCap defines the max gas of the eth_call and eth_estimateGas queries enforced by the
+	// query server, and the total gas of the calls of eth_simulateV1, independent from the
+	// block gas limit. 0 means no cap.
+	RPCGasCap uint64 `mapstructure:"rpc-gas-cap"`
+	// MinPriorityFee defines the minimum priority fee per gas, the tip on top of the base fee,
+	// of the eth txs accepted into the local mempool, on top of the min_priority_fee param of
+	// the fee module. 0 disables the local check.
+	MinPriorityFee uint64 `mapstructure:"min-priority-fee"`
 }
 
 // AspectConfig defines the application configuration values for Aspect.
@@ -116,18 +200,42 @@ type JSONRPCConfig struct {
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// EVMTimeout is the global timeout for eth-call.
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
+	// CallCacheSize is the number of the eth_call results cached for the calls at fixed
+	// heights, 0 disables the cache.
+	CallCacheSize int `mapstructure:"call-cache-size"`
+	// ResponseCacheSize is the number of the blocks, block results, txs and receipts of the
+	// committed blocks cached for the RPC queries, 0 disables the cache.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
 	// TxFeeCap is the global txs-fee cap for send txs
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
 	// FilterCap is the global cap for total number of filters that can be created.
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// FeeStatsWindows are the windows of the rolling fee stats served by artela_feeStats and
+	// reported over the telemetry, the fee stats are disabled if empty.
+	FeeStatsWindows []string `mapstructure:"fee-stats-windows"`
+	// GPOBlocks is the number of recent blocks the gas price oracle samples the priority fees of.
+	GPOBlocks int32 `mapstructure:"gpo-blocks"`
+	// GPOPercentile is the percentile of the sampled priority fees the gas price oracle suggests.
+	GPOPercentile int32 `mapstructure:"gpo-percentile"`
+	// GPOMaxPrice is the cap in wei of the priority fee suggested by the gas price oracle.
+	GPOMaxPrice uint64 `mapstructure:"gpo-max-price"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// HealthMaxBlockLag is the max number of blocks the node can be behind its peers while
+	// /ready reports the node as ready.
+	HealthMaxBlockLag int64 `mapstructure:"health-max-block-lag"`
+	// HealthMaxIndexerLag is the max number of blocks the indexer can be behind the node while
+	// /ready reports the node as ready.
+	HealthMaxIndexerLag int64 `mapstructure:"health-max-indexer-lag"`
+	// HealthMaxBlockAge is the max age of the latest block while /ready reports the node as
+	// ready, 0 disables the check.
+	HealthMaxBlockAge time.Duration `mapstructure:"health-max-block-age"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -135,23 +243,111 @@ type JSONRPCConfig struct {
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
+	// DenyAddresses rejects the txs submitted via the node's RPC which are sent from, sent to
+	// or access the listed addresses. It's an RPC policy only, it doesn't affect the consensus.
+	DenyAddresses []string `mapstructure:"deny-addresses"`
+	// AllowAddresses, if not empty, rejects the txs submitted via the node's RPC which are
+	// neither sent from nor sent to the listed addresses, the deny list takes precedence.
+	AllowAddresses []string `mapstructure:"allow-addresses"`
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndex defines if the indexer maintains the (address, topic0) to blocks
+	// inverted index of the EVM logs, it requires the custom indexer.
+	EnableLogIndex bool `mapstructure:"enable-log-index"`
+	// EnableBloomIndex defines if the indexer maintains the bloom bits of the blocks, the
+	// bit-transposed blooms by section, it requires the custom indexer.
+	EnableBloomIndex bool `mapstructure:"enable-bloom-index"`
+	// EnableAddressIndex defines if the indexer maintains the address to txs index of the
+	// senders and the recipients of the eth txs, it requires the custom indexer.
+	EnableAddressIndex bool `mapstructure:"enable-address-index"`
+	// IndexInternalCalls defines if the address index also covers the callers and the callees
+	// of the internal calls, traced with the call tracer, it requires the address index.
+	IndexInternalCalls bool `mapstructure:"index-internal-calls"`
+	// EnableStateFeed defines if the state changes of the committed blocks are recorded and
+	// streamed to the read replicas by artela_subscribe("stateChanges").
+	EnableStateFeed bool `mapstructure:"enable-state-feed"`
+	// StateFeedRetainBlocks defines the number of the latest blocks whose state changes are
+	// kept by the state feed, 0 keeps all of them.
+	StateFeedRetainBlocks uint64 `mapstructure:"state-feed-retain-blocks"`
+	// EnableGRPCBridge defines if the common read-only eth methods are also served over
+	// the gRPC server and the REST API server.
+	EnableGRPCBridge bool `mapstructure:"enable-grpc-bridge"`
+	// EnableGraphQL defines if the GraphQL queries are served on /graphql of the HTTP server.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// UnsafePersonal defines if the personal namespace managing the keys of the node keyring
+	// is served, it's meant for the dev environments only.
+	UnsafePersonal bool `mapstructure:"unsafe-personal"`
+	// ReadOnly defines if the server rejects all the methods sending or signing txs, for the
+	// data-only public endpoints.
+	ReadOnly bool `mapstructure:"read-only"`
+	// DisabledMethods are the methods rejected by the server served over http, by method name
+	// or namespace pattern.
+	DisabledMethods []string `mapstructure:"disabled-methods"`
+	// MaxBatchItems is the max number of the calls of a batch request served over http, 0 for no limit.
+	MaxBatchItems int `mapstructure:"max-batch-items"`
+	// MaxRequestSize is the max size in bytes of a request body served over http, 0 for no limit.
+	MaxRequestSize int64 `mapstructure:"max-request-size"`
+	// MaxResponseSize is the max size in bytes of a response body served over http, 0 for no limit.
+	MaxResponseSize int `mapstructure:"max-response-size"`
+	// MaxConcurrentRequests is the max number of the requests served over http at once, 0 for no limit.
+	MaxConcurrentRequests int `mapstructure:"max-concurrent-requests"`
+	// MethodRateLimits are the "method=calls per second" rate limits of the methods served over http.
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// MethodTimeouts are the "method=timeout" execution timeouts of the methods served over http.
+	MethodTimeouts []string `mapstructure:"method-timeouts"`
+	// MethodMetrics defines if the per-method metrics of the requests served over http are
+	// recorded to the telemetry, it requires the telemetry to be enabled.
+	MethodMetrics bool `mapstructure:"method-metrics"`
+	// AuthAddress defines the JWT authenticated HTTP and WebSocket server to listen on, it's
+	// disabled if empty.
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthJWTSecret is the path of the file of the hex encoded JWT secret of the authenticated
+	// server, it defaults to config/jwtsecret of the node home.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// AuthAPI defines the list of the JSON-RPC namespaces served by the authenticated server.
+	AuthAPI []string `mapstructure:"auth-api"`
+	// IPCPath defines the path of the IPC endpoint, a file name is placed in the data
+	// directory of the JSON-RPC server. It's disabled if empty.
+	IPCPath string `mapstructure:"ipc-path"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when txs reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 }
 
+// FaucetConfig defines the development faucet served by the JSON-RPC server.
+type FaucetConfig struct {
+	// Enable defines if the artela_requestFunds RPC method should be served.
+	Enable bool `mapstructure:"enable"`
+	// KeyName is the name of the key in the node's keyring of the faucet operator set in the
+	// evm params, it signs the drips of the faucet module account and pays their fees.
+	KeyName string `mapstructure:"key-name"`
+	// Amount defines the coins sent for each accepted request.
+	Amount string `mapstructure:"amount"`
+	// Fees defines the fees paid by the faucet transfer, empty for no fees.
+	Fees string `mapstructure:"fees"`
+	// GasLimit is the gas limit of the faucet transfer.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+	// AddressInterval is the minimum duration between two requests for the same address.
+	AddressInterval time.Duration `mapstructure:"address-interval"`
+	// IPInterval is the minimum duration between two requests from the same IP.
+	IPInterval time.Duration `mapstructure:"ip-interval"`
+	// CaptchaWebhook is an optional URL called to verify the captcha token of a request.
+	CaptchaWebhook string `mapstructure:"captcha-webhook"`
+}
+
 // TLSConfig defines the certificate and matching private key for the server.
 type TLSConfig struct {
 	// CertificatePath the file path for the certificate .pem file
 	CertificatePath string `mapstructure:"certificate-path"`
 	// KeyPath the file path for the key .pem file
 	KeyPath string `mapstructure:"key-path"`
+	// ClientCAPath the file path for the .pem file of the CA certificates verifying the
+	// client certificates, the clients are not authenticated if empty
+	ClientCAPath string `mapstructure:"client-ca-path"`
 }
 
 // AppConfig helps to override default appConfig template and configs.
@@ -182,6 +378,7 @@ func AppConfig(denom string) (string, interface{}) {
 		EVM:     *DefaultEVMConfig(),
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
+		Faucet:  *DefaultFaucetConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -197,6 +394,7 @@ func DefaultConfig() *Config {
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
 		Aspect:  *DefaultAspectConfig(),
+		Faucet:  *DefaultFaucetConfig(),
 	}
 }
 
@@ -276,8 +474,17 @@ func DefaultServerConfig() *config.Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                  DefaultEVMTracer,
+		MaxTxGasWanted:          DefaultMaxTxGasWanted,
+		HistoryRetentionEpochs:  0,
+		HistoryEpochLength:      DefaultHistoryEpochLength,
+		SimulationNoBaseFee:     false,
+		EnablePreimageRecording: false,
+		EnableAccessStats:       false,
+		EnableContractIndex:     false,
+		EnableWitness:           false,
+		RPCGasCap:               DefaultRPCGasCap,
+		MinPriorityFee:          0,
 	}
 }
 
@@ -287,6 +494,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.HistoryRetentionEpochs > 0 && c.HistoryEpochLength == 0 {
+		return errors.New("history-epoch-length cannot be 0 when history-retention-epochs is set")
+	}
+
 	return nil
 }
 
@@ -318,7 +529,18 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "trace", "miner", "admin"}
+}
+
+// GetDefaultAuthAPINamespaces returns the default list of JSON-RPC namespaces served by the
+// authenticated server, it includes the privileged namespaces.
+func GetDefaultAuthAPINamespaces() []string {
+	return []string{"eth", "net", "web3", "debug", "personal", "admin"}
+}
+
+// GetDefaultFeeStatsWindows returns the default windows of the rolling fee stats.
+func GetDefaultFeeStatsWindows() []string {
+	return []string{"1m", "10m", "1h"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -330,16 +552,47 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		WsAddress:                DefaultJSONRPCWsAddress,
 		GasCap:                   DefaultGasCap,
 		EVMTimeout:               DefaultEVMTimeout,
+		CallCacheSize:            0,
+		ResponseCacheSize:        0,
 		TxFeeCap:                 DefaultTxFeeCap,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
+		FeeStatsWindows:          GetDefaultFeeStatsWindows(),
+		GPOBlocks:                DefaultGPOBlocks,
+		GPOPercentile:            DefaultGPOPercentile,
+		GPOMaxPrice:              DefaultGPOMaxPrice,
 		BlockRangeCap:            DefaultBlockRangeCap,
+		HealthMaxBlockLag:        DefaultHealthMaxBlockLag,
+		HealthMaxIndexerLag:      DefaultHealthMaxIndexerLag,
+		HealthMaxBlockAge:        DefaultHealthMaxBlockAge,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndex:           false,
+		EnableBloomIndex:         false,
+		EnableAddressIndex:       false,
+		IndexInternalCalls:       false,
+		EnableStateFeed:          false,
+		StateFeedRetainBlocks:    DefaultStateFeedRetainBlocks,
+		EnableGRPCBridge:         false,
+		EnableGraphQL:            false,
+		UnsafePersonal:           false,
+		ReadOnly:                 false,
+		DisabledMethods:          nil,
+		MaxBatchItems:            0,
+		MaxRequestSize:           0,
+		MaxResponseSize:          0,
+		MaxConcurrentRequests:    0,
+		MethodRateLimits:         nil,
+		MethodTimeouts:           nil,
+		MethodMetrics:            false,
+		AuthAddress:              "",
+		AuthJWTSecret:            "",
+		AuthAPI:                  GetDefaultAuthAPINamespaces(),
+		IPCPath:                  "",
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -359,6 +612,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.GPOBlocks <= 0 {
+		return errors.New("JSON-RPC gpo-blocks cannot be negative or 0")
+	}
+
+	if c.GPOPercentile < 0 || c.GPOPercentile > 100 {
+		return fmt.Errorf("JSON-RPC gpo-percentile must be within [0, 100], got %d", c.GPOPercentile)
+	}
+
+	if c.GPOMaxPrice == 0 {
+		return errors.New("JSON-RPC gpo-max-price cannot be 0")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC txs fee cap cannot be negative")
 	}
@@ -367,6 +632,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.CallCacheSize < 0 {
+		return errors.New("JSON-RPC call cache size cannot be negative")
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -375,6 +648,46 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.HealthMaxBlockLag < 0 {
+		return errors.New("JSON-RPC health max block lag cannot be negative")
+	}
+
+	if c.HealthMaxIndexerLag < 0 {
+		return errors.New("JSON-RPC health max indexer lag cannot be negative")
+	}
+
+	if c.HealthMaxBlockAge < 0 {
+		return errors.New("JSON-RPC health max block age cannot be negative")
+	}
+
+	for _, addresses := range [][]string{c.DenyAddresses, c.AllowAddresses} {
+		for _, addr := range addresses {
+			if !common.IsHexAddress(addr) {
+				return fmt.Errorf("invalid JSON-RPC policy address '%s'", addr)
+			}
+		}
+	}
+
+	if c.EnableLogIndex && !c.EnableIndexer {
+		return errors.New("JSON-RPC log index requires the custom indexer to be enabled")
+	}
+
+	if c.EnableBloomIndex && !c.EnableIndexer {
+		return errors.New("JSON-RPC bloom index requires the custom indexer to be enabled")
+	}
+
+	if c.EnableAddressIndex && !c.EnableIndexer {
+		return errors.New("JSON-RPC address index requires the custom indexer to be enabled")
+	}
+
+	if c.IndexInternalCalls && !c.EnableAddressIndex {
+		return errors.New("JSON-RPC index of the internal calls requires the address index to be enabled")
+	}
+
+	if c.EnableStateFeed && !c.Enable {
+		return errors.New("JSON-RPC state feed requires the JSON-RPC server to be enabled")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -383,6 +696,51 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.MaxBatchItems < 0 {
+		return errors.New("JSON-RPC max batch items cannot be negative")
+	}
+
+	if c.MaxRequestSize < 0 {
+		return errors.New("JSON-RPC max request size cannot be negative")
+	}
+
+	if c.MaxResponseSize < 0 {
+		return errors.New("JSON-RPC max response size cannot be negative")
+	}
+
+	if c.MaxConcurrentRequests < 0 {
+		return errors.New("JSON-RPC max concurrent requests cannot be negative")
+	}
+
+	if _, err := c.ParseMethodRateLimits(); err != nil {
+		return err
+	}
+
+	if _, err := c.ParseMethodTimeouts(); err != nil {
+		return err
+	}
+
+	if _, err := c.ParseDisabledMethods(); err != nil {
+		return err
+	}
+
+	if _, err := c.ParseFeeStatsWindows(); err != nil {
+		return err
+	}
+
+	if c.ReadOnly && c.UnsafePersonal {
+		return errors.New("cannot serve the JSON-RPC personal namespace in the read-only mode")
+	}
+
+	if c.AuthAddress != "" {
+		if _, _, err := net.SplitHostPort(c.AuthAddress); err != nil {
+			return fmt.Errorf("invalid JSON-RPC auth address '%s', %w", c.AuthAddress, err)
+		}
+		if len(c.AuthNamespaces()) == 0 {
+			return errors.New("cannot enable the JSON-RPC authenticated server without defining any API namespace")
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -401,6 +759,7 @@ func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
 		CertificatePath: "",
 		KeyPath:         "",
+		ClientCAPath:    "",
 	}
 }
 
@@ -418,6 +777,63 @@ func (c TLSConfig) Validate() error {
 		return fmt.Errorf("invalid extension %s for key path %s, expected '.pem'", keyExt, c.KeyPath)
 	}
 
+	if (c.CertificatePath == "") != (c.KeyPath == "") {
+		return errors.New("both the certificate path and key path must be set to enable TLS")
+	}
+
+	caExt := path.Ext(c.ClientCAPath)
+
+	if c.ClientCAPath != "" && caExt != ".pem" {
+		return fmt.Errorf("invalid extension %s for client CA path %s, expected '.pem'", caExt, c.ClientCAPath)
+	}
+
+	if c.ClientCAPath != "" && c.CertificatePath == "" {
+		return errors.New("client CA path is set without enabling TLS")
+	}
+
+	return nil
+}
+
+// DefaultFaucetConfig returns the default faucet configuration, the faucet is disabled by default.
+func DefaultFaucetConfig() *FaucetConfig {
+	return &FaucetConfig{
+		Enable:          false,
+		KeyName:         DefaultFaucetKeyName,
+		Amount:          DefaultFaucetAmount,
+		Fees:            "",
+		GasLimit:        DefaultFaucetGasLimit,
+		AddressInterval: DefaultFaucetAddressInterval,
+		IPInterval:      DefaultFaucetIPInterval,
+		CaptchaWebhook:  "",
+	}
+}
+
+// Validate returns an error if the faucet configuration fields are invalid.
+func (c FaucetConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.KeyName == "" {
+		return errors.New("faucet key-name cannot be empty")
+	}
+
+	if _, err := sdk.ParseCoinsNormalized(c.Amount); err != nil {
+		return fmt.Errorf("invalid faucet amount %s: %w", c.Amount, err)
+	}
+
+	if _, err := sdk.ParseCoinsNormalized(c.Fees); err != nil {
+		return fmt.Errorf("invalid faucet fees %s: %w", c.Fees, err)
+	}
+
+	if c.GasLimit == 0 {
+		return errors.New("faucet gas-limit cannot be 0")
+	}
+
+	if c.AddressInterval < 0 || c.IPInterval < 0 {
+		return errors.New("faucet request intervals cannot be negative")
+	}
+
 	return nil
 }
 
@@ -431,8 +847,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
-			Tracer:         v.GetString("evm.tracer"),
-			MaxTxGasWanted: v.GetUint64("evm.max-txs-gas-wanted"),
+			Tracer:                  v.GetString("evm.tracer"),
+			MaxTxGasWanted:          v.GetUint64("evm.max-txs-gas-wanted"),
+			HistoryRetentionEpochs:  v.GetUint64("evm.history-retention-epochs"),
+			HistoryEpochLength:      v.GetUint64("evm.history-epoch-length"),
+			SimulationNoBaseFee:     v.GetBool("evm.simulation-no-base-fee"),
+			EnablePreimageRecording: v.GetBool("evm.enable-preimage-recording"),
+			EnableAccessStats:       v.GetBool("evm.enable-access-stats"),
+			EnableContractIndex:     v.GetBool("evm.enable-contract-index"),
+			EnableWitness:           v.GetBool("evm.enable-witness"),
+			RPCGasCap:               v.GetUint64("evm.rpc-gas-cap"),
+			MinPriorityFee:          v.GetUint64("evm.min-priority-fee"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -442,26 +867,70 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
 			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
 			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
+			FeeStatsWindows:          v.GetStringSlice("json-rpc.fee-stats-windows"),
+			GPOBlocks:                v.GetInt32("json-rpc.gpo-blocks"),
+			GPOPercentile:            v.GetInt32("json-rpc.gpo-percentile"),
+			GPOMaxPrice:              v.GetUint64("json-rpc.gpo-max-price"),
 			TxFeeCap:                 v.GetFloat64("json-rpc.txfee-cap"),
 			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
+			CallCacheSize:            v.GetInt("json-rpc.call-cache-size"),
+			ResponseCacheSize:        v.GetInt("json-rpc.response-cache-size"),
 			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
+			HealthMaxBlockLag:        v.GetInt64("json-rpc.health-max-block-lag"),
+			HealthMaxIndexerLag:      v.GetInt64("json-rpc.health-max-indexer-lag"),
+			HealthMaxBlockAge:        v.GetDuration("json-rpc.health-max-block-age"),
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			EnableLogIndex:           v.GetBool("json-rpc.enable-log-index"),
+			EnableBloomIndex:         v.GetBool("json-rpc.enable-bloom-index"),
+			EnableAddressIndex:       v.GetBool("json-rpc.enable-address-index"),
+			IndexInternalCalls:       v.GetBool("json-rpc.index-internal-calls"),
+			EnableStateFeed:          v.GetBool("json-rpc.enable-state-feed"),
+			StateFeedRetainBlocks:    v.GetUint64("json-rpc.state-feed-retain-blocks"),
+			EnableGRPCBridge:         v.GetBool("json-rpc.enable-grpc-bridge"),
+			EnableGraphQL:            v.GetBool("json-rpc.enable-graphql"),
+			UnsafePersonal:           v.GetBool("json-rpc.unsafe-personal"),
+			ReadOnly:                 v.GetBool("json-rpc.read-only"),
+			DisabledMethods:          v.GetStringSlice("json-rpc.disabled-methods"),
+			MaxBatchItems:            v.GetInt("json-rpc.max-batch-items"),
+			MaxRequestSize:           v.GetInt64("json-rpc.max-request-size"),
+			MaxResponseSize:          v.GetInt("json-rpc.max-response-size"),
+			MaxConcurrentRequests:    v.GetInt("json-rpc.max-concurrent-requests"),
+			MethodRateLimits:         v.GetStringSlice("json-rpc.method-rate-limits"),
+			MethodTimeouts:           v.GetStringSlice("json-rpc.method-timeouts"),
+			MethodMetrics:            v.GetBool("json-rpc.method-metrics"),
+			AuthAddress:              v.GetString("json-rpc.auth-address"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
+			AuthAPI:                  v.GetStringSlice("json-rpc.auth-api"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			AllowUnprotectedTxs:      v.GetBool("json-rpc.allow-unprotected-txs"),
+			DenyAddresses:            v.GetStringSlice("json-rpc.deny-addresses"),
+			AllowAddresses:           v.GetStringSlice("json-rpc.allow-addresses"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
+			ClientCAPath:    v.GetString("tls.client-ca-path"),
 		},
 		Aspect: AspectConfig{
 			ApplyPoolSize: v.GetInt32("aspect.apply-pool-size"),
 			QueryPoolSize: v.GetInt32("aspect.query-pool-size"),
 		},
+		Faucet: FaucetConfig{
+			Enable:          v.GetBool("faucet.enable"),
+			KeyName:         v.GetString("faucet.key-name"),
+			Amount:          v.GetString("faucet.amount"),
+			Fees:            v.GetString("faucet.fees"),
+			GasLimit:        v.GetUint64("faucet.gas-limit"),
+			AddressInterval: v.GetDuration("faucet.address-interval"),
+			IPInterval:      v.GetDuration("faucet.ip-interval"),
+			CaptchaWebhook:  v.GetString("faucet.captcha-webhook"),
+		},
 	}, nil
 }
 
@@ -474,6 +943,12 @@ func ParseConfig(v *viper.Viper) (*Config, error) {
 	return conf, err
 }
 
+// HistoryRetention returns true if the history retention policy of the EVM prunes the block
+// history, it's disabled on the archive nodes (i.e pruning = "nothing").
+func (c Config) HistoryRetention() bool {
+	return c.EVM.HistoryRetentionEpochs > 0 && c.Pruning != pruningtypes.PruningOptionNothing
+}
+
 // ValidateBasic returns an error any of the application configuration fields are invalid
 func (c Config) ValidateBasic() error {
 	if err := c.EVM.Validate(); err != nil {
@@ -492,5 +967,9 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid aspect config value: %s", err.Error())
 	}
 
+	if err := c.Faucet.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid faucet config value: %s", err.Error())
+	}
+
 	return c.Config.ValidateBasic()
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -11,4 +12,96 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, cfg.JSONRPC.Enable)
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
+	require.Equal(t, DefaultRPCGasCap, cfg.EVM.RPCGasCap)
+}
+
+func TestFaucetConfigValidate(t *testing.T) {
+	cfg := DefaultFaucetConfig()
+	require.False(t, cfg.Enable)
+	require.NoError(t, cfg.Validate())
+
+	cfg.Enable = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.Amount = "invalid"
+	require.Error(t, cfg.Validate())
+}
+
+func TestTLSConfigValidate(t *testing.T) {
+	cfg := DefaultTLSConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.ClientCAPath = "ca.pem"
+	require.Error(t, cfg.Validate())
+
+	cfg.CertificatePath = "cert.pem"
+	require.Error(t, cfg.Validate())
+
+	cfg.KeyPath = "key.pem"
+	require.NoError(t, cfg.Validate())
+
+	cfg.ClientCAPath = "ca.crt"
+	require.Error(t, cfg.Validate())
+}
+
+func TestParseMethodLimits(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	cfg.MethodRateLimits = []string{"debug_*=2,eth_call=0.5"}
+	cfg.MethodTimeouts = []string{"debug_*=30s", "*=10s"}
+	require.NoError(t, cfg.Validate())
+
+	limits, err := cfg.ParseMethodRateLimits()
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"debug_*": 2, "eth_call": 0.5}, limits)
+
+	timeouts, err := cfg.ParseMethodTimeouts()
+	require.NoError(t, err)
+	require.Len(t, timeouts, 2)
+
+	cfg.MethodRateLimits = []string{"debug_*=2,debug_*=3"}
+	require.Error(t, cfg.Validate())
+
+	cfg.MethodRateLimits = nil
+	cfg.MethodTimeouts = []string{"debug_*"}
+	require.Error(t, cfg.Validate())
+}
+
+func TestParseDisabledMethods(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	cfg.DisabledMethods = []string{"debug_*, eth_getProof"}
+	require.NoError(t, cfg.Validate())
+
+	disabled, err := cfg.ParseDisabledMethods()
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"debug_*": true, "eth_getProof": true}, disabled)
+
+	cfg.ReadOnly = true
+	disabled, err = cfg.ParseDisabledMethods()
+	require.NoError(t, err)
+	require.True(t, disabled["eth_sendRawTransaction"])
+	require.True(t, disabled["personal_*"])
+
+	cfg.UnsafePersonal = true
+	require.Error(t, cfg.Validate())
+
+	cfg.UnsafePersonal = false
+	cfg.DisabledMethods = []string{"*"}
+	require.Error(t, cfg.Validate())
+}
+
+func TestParseFeeStatsWindows(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	windows, err := cfg.ParseFeeStatsWindows()
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{time.Minute, 10 * time.Minute, time.Hour}, windows)
+
+	cfg.FeeStatsWindows = nil
+	windows, err = cfg.ParseFeeStatsWindows()
+	require.NoError(t, err)
+	require.Empty(t, windows)
+
+	for _, invalid := range []string{"1x", "-1m", "25h", "1m,60s"} {
+		cfg.FeeStatsWindows = []string{invalid}
+		require.Error(t, cfg.Validate(), invalid)
+	}
 }
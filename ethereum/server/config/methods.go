@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuthNamespaces returns the JSON-RPC namespaces served by the authenticated server, none if
+// the server is disabled.
+func (c JSONRPCConfig) AuthNamespaces() []string {
+	if c.AuthAddress == "" {
+		return nil
+	}
+
+	var namespaces []string
+	for _, api := range c.AuthAPI {
+		for _, name := range strings.Split(api, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				namespaces = append(namespaces, name)
+			}
+		}
+	}
+	return namespaces
+}
+
+// ParseMethodRateLimits parses the "method=calls per second" entries of the method rate limits.
+func (c JSONRPCConfig) ParseMethodRateLimits() (map[string]float64, error) {
+	limits := make(map[string]float64)
+	err := parseMethodEntries(c.MethodRateLimits, func(method, value string) error {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate <= 0 {
+			return fmt.Errorf("invalid JSON-RPC rate limit '%s' of method '%s'", value, method)
+		}
+		limits[method] = rate
+		return nil
+	})
+	return limits, err
+}
+
+// ParseMethodTimeouts parses the "method=timeout" entries of the method timeouts.
+func (c JSONRPCConfig) ParseMethodTimeouts() (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	err := parseMethodEntries(c.MethodTimeouts, func(method, value string) error {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid JSON-RPC timeout '%s' of method '%s'", value, method)
+		}
+		timeouts[method] = timeout
+		return nil
+	})
+	return timeouts, err
+}
+
+// readOnlyMethods are the methods sending or signing txs, which are disabled in the read-only mode.
+var readOnlyMethods = []string{
+	"eth_sendTransaction",
+	"eth_sendRawTransaction",
+	"eth_sign",
+	"eth_signTransaction",
+	"eth_signTypedData",
+	"personal_*",
+	"artela_requestFunds",
+}
+
+// ParseDisabledMethods parses the disabled methods, including the methods sending or signing
+// txs in the read-only mode. A method is a method name or a namespace pattern, disabling all
+// the methods with * is not allowed.
+func (c JSONRPCConfig) ParseDisabledMethods() (map[string]bool, error) {
+	disabled := make(map[string]bool)
+	for _, entry := range c.DisabledMethods {
+		for _, method := range strings.Split(entry, ",") {
+			method = strings.TrimSpace(method)
+			if method == "" {
+				continue
+			}
+			if method == "*" || !strings.Contains(method, "_") || strings.Contains(method, "=") {
+				return nil, fmt.Errorf("invalid JSON-RPC disabled method '%s', expect a method name or a namespace pattern", method)
+			}
+			disabled[method] = true
+		}
+	}
+
+	if c.ReadOnly {
+		for _, method := range readOnlyMethods {
+			disabled[method] = true
+		}
+	}
+	return disabled, nil
+}
+
+// ParseFeeStatsWindows parses the windows of the rolling fee stats, none if the fee stats
+// are disabled.
+func (c JSONRPCConfig) ParseFeeStatsWindows() ([]time.Duration, error) {
+	var windows []time.Duration
+	seen := make(map[time.Duration]bool)
+	for _, entry := range c.FeeStatsWindows {
+		for _, item := range strings.Split(entry, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			window, err := time.ParseDuration(item)
+			if err != nil || window <= 0 || window > MaxFeeStatsWindow {
+				return nil, fmt.Errorf("invalid JSON-RPC fee stats window '%s', expect a duration up to %s", item, MaxFeeStatsWindow)
+			}
+			if seen[window] {
+				return nil, fmt.Errorf("repeated JSON-RPC fee stats window '%s'", item)
+			}
+			seen[window] = true
+			windows = append(windows, window)
+		}
+	}
+	return windows, nil
+}
+
+// parseMethodEntries splits the comma separated "method=value" entries, the method is a
+// method name (e.g. debug_traceTransaction), a namespace pattern (e.g. debug_*) or * for
+// all the methods.
+func parseMethodEntries(entries []string, parse func(method, value string) error) error {
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, item := range strings.Split(entry, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+
+			method, value, ok := strings.Cut(item, "=")
+			method, value = strings.TrimSpace(method), strings.TrimSpace(value)
+			if !ok || method == "" {
+				return fmt.Errorf("invalid JSON-RPC method entry '%s', expect method=value", item)
+			}
+			if seen[method] {
+				return fmt.Errorf("repeated JSON-RPC method '%s'", method)
+			}
+			seen[method] = true
+
+			if err := parse(method, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
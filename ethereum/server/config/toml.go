@@ -16,6 +16,46 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth txs returned in ante handler in check txs mode.
 max-txs-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# HistoryRetentionEpochs defines how many epochs of block headers and receipts a non-archive
+# node keeps, older epochs are compacted away once per epoch from the block store and from the
+# EVM indexer (txs, receipts, log and address indexes). 0 keeps the whole history.
+# It is ignored when pruning = "nothing".
+history-retention-epochs = {{ .EVM.HistoryRetentionEpochs }}
+
+# HistoryEpochLength defines the number of blocks of a history retention epoch.
+history-epoch-length = {{ .EVM.HistoryEpochLength }}
+
+# SimulationNoBaseFee forces the base fee to 0 for the simulated executions (eth_call,
+# eth_estimateGas), so they succeed with a zero gas price. It doesn't affect consensus.
+simulation-no-base-fee = {{ .EVM.SimulationNoBaseFee }}
+
+# EnablePreimageRecording enables the recording of the SHA3/keccak preimages by the EVM into
+# the node local preimage db, they are served by debug_preimage.
+enable-preimage-recording = {{ .EVM.EnablePreimageRecording }}
+
+# EnableAccessStats enables the recording of the per block storage read and write counters of
+# the contracts into the node local access stats db, they are served by artela_hotContracts.
+enable-access-stats = {{ .EVM.EnableAccessStats }}
+
+# EnableContractIndex enables the indexing of the creator, creation tx and init code hash of the
+# contracts into the node local contract index db, they are served by artela_getContractCreation.
+enable-contract-index = {{ .EVM.EnableContractIndex }}
+
+# EnableWitness enables the recording of the accounts, storage slots and codes read by the EVM in
+# each block into the node local witness db, the block witnesses are served by debug_executionWitness.
+enable-witness = {{ .EVM.EnableWitness }}
+
+# RPCGasCap defines the max gas of the eth_call and eth_estimateGas queries enforced by the
+# query server, and the total gas of the calls of eth_simulateV1, it's independent from the
+# consensus block gas limit (0=no cap).
+rpc-gas-cap = {{ .EVM.RPCGasCap }}
+
+# MinPriorityFee defines the minimum priority fee per gas in wei, the tip paid on top of the
+# base fee, of the eth txs accepted into the local mempool. It's checked on top of the
+# min_priority_fee param of the fee module, and replaces minimum-gas-prices for the eth txs
+# once the base fee is enabled (0=disabled).
+min-priority-fee = {{ .EVM.MinPriorityFee }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -33,6 +73,7 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
+# The admin namespace is served over HTTP only if it's listed.
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -41,6 +82,16 @@ gas-cap = {{ .JSONRPC.GasCap }}
 # EVMTimeout is the global timeout for eth_call. Default: 5s.
 evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 
+# CallCacheSize is the number of the eth_call results cached in a LRU for the calls at fixed heights,
+# the state of a committed block never changes, so the identical historical calls are served from the
+# cache. The calls against the pending block are never cached (0=disabled).
+call-cache-size = {{ .JSONRPC.CallCacheSize }}
+
+# ResponseCacheSize is the number of the blocks, block results, txs and receipts of the committed blocks
+# cached in LRUs, the committed blocks are final, so the entries are only dropped once the blocks are
+# pruned from the node (0=disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
 # TxFeeCap is the global txs-fee cap for send txs. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
@@ -50,12 +101,39 @@ filter-cap = {{ .JSONRPC.FilterCap }}
 # FeeHistoryCap sets the global cap for total number of blocks that can be fetched
 feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 
+# FeeStatsWindows are the windows of the rolling time-weighted base fee, median tip and gas used
+# ratio, served by artela_feeStats and reported as the fee_stats telemetry gauges. The windows are
+# up to 24h, the fee stats are disabled if empty.
+fee-stats-windows = "{{range $index, $elmt := .JSONRPC.FeeStatsWindows}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# GPOBlocks defines the number of recent blocks the gas price oracle samples the effective
+# priority fees of, the suggestions of eth_gasPrice and eth_maxPriorityFeePerGas are derived from them.
+gpo-blocks = {{ .JSONRPC.GPOBlocks }}
+
+# GPOPercentile defines the percentile of the sampled priority fees suggested by the gas price oracle.
+gpo-percentile = {{ .JSONRPC.GPOPercentile }}
+
+# GPOMaxPrice defines the cap in wei of the priority fee suggested by the gas price oracle.
+gpo-max-price = {{ .JSONRPC.GPOMaxPrice }}
+
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# HealthMaxBlockLag is the max number of blocks the node can be behind its peers while the /ready
+# endpoint reports the node as ready to serve the RPC requests.
+health-max-block-lag = {{ .JSONRPC.HealthMaxBlockLag }}
+
+# HealthMaxIndexerLag is the max number of blocks the tx indexer can be behind the node while the
+# /ready endpoint reports the node as ready to serve the RPC requests.
+health-max-indexer-lag = {{ .JSONRPC.HealthMaxIndexerLag }}
+
+# HealthMaxBlockAge is the max age of the latest block while the /ready endpoint reports the node as
+# ready, it catches a stalled chain when the node has no peers to compare with (0=disabled).
+health-max-block-age = "{{ .JSONRPC.HealthMaxBlockAge }}"
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
@@ -66,13 +144,128 @@ http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 
+# DenyAddresses rejects the txs submitted via the node's RPC which are sent from, sent to or
+# access the listed addresses, the rejections are logged for audit. It's an RPC policy only,
+# the txs received from the p2p network are not affected.
+# Example: "0x1A2b3C4d5E6f7A8b9C0d1E2f3A4b5C6d7E8f9A0b,0x0b9A8f7E6d5C4b3A2f1E0d9C8b7A6f5E4d3C2b1A"
+deny-addresses = "{{range $index, $elmt := .JSONRPC.DenyAddresses}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AllowAddresses, if not empty, rejects the txs submitted via the node's RPC which are neither
+# sent from nor sent to the listed addresses. The deny list takes precedence.
+allow-addresses = "{{range $index, $elmt := .JSONRPC.AllowAddresses}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 # MaxOpenConnections sets the maximum number of simultaneous connections
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
 # EnableIndexer enables the custom txs indexer for the EVM (ethereum transactions).
+# At startup the latest indexed blocks are checked against the block store, a divergent
+# range (e.g. after an unclean shutdown or a rollback) is reported and re-indexed.
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndex enables the (address, topic0) to blocks inverted index of the EVM logs, which
+# accelerates the eth_getLogs queries filtering on both an address and topic0. It requires the
+# custom indexer, the blocks indexed before it is enabled are served by scanning the blooms.
+enable-log-index = {{ .JSONRPC.EnableLogIndex }}
+
+# EnableBloomIndex enables the bloom bits index of the blocks, the blooms transposed by sections
+# of 4096 blocks and built in the background, so the eth_getLogs queries over large ranges skip
+# the blocks without matching logs. It requires the custom indexer.
+enable-bloom-index = {{ .JSONRPC.EnableBloomIndex }}
+
+# EnableAddressIndex enables the address to txs index of the senders and the recipients of the eth
+# txs, served by artela_getTransactionsByAddress. It requires the custom indexer, the blocks indexed
+# before it is enabled are not covered.
+enable-address-index = {{ .JSONRPC.EnableAddressIndex }}
+
+# IndexInternalCalls also indexes the callers and the callees of the successful internal calls in the
+# address index, each block with eth txs is replayed with the call tracer when it's indexed. It
+# requires the address index.
+index-internal-calls = {{ .JSONRPC.IndexInternalCalls }}
+
+# EnableStateFeed records the state changes of each committed block, which are streamed to the read
+# replicas by artela_subscribe("stateChanges"). A replica applies them to its local copy of the state
+# and serves the state queries without running consensus, see the replica command.
+enable-state-feed = {{ .JSONRPC.EnableStateFeed }}
+
+# StateFeedRetainBlocks is the number of the latest blocks whose state changes are kept by the state
+# feed, a replica can't catch up from a block out of the window (0=all).
+state-feed-retain-blocks = {{ .JSONRPC.StateFeedRetainBlocks }}
+
+# EnableGRPCBridge serves the common read-only eth methods over the gRPC server (service
+# artela.ethereum.v1.Eth) and the REST API server (/artela/ethereum/v1/{method}), with the
+# same semantics as the JSON-RPC methods.
+enable-grpc-bridge = {{ .JSONRPC.EnableGRPCBridge }}
+
+# EnableGraphQL serves the GraphQL queries (EIP-1767) of the blocks, txs and logs on /graphql
+# of the HTTP server, and the GraphiQL query browser on /graphql/ui.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# UnsafePersonal serves the personal namespace, which creates, imports, unlocks and signs with
+# the keys of the node keyring. It's unsafe on the public nodes, enable it for dev environments only.
+unsafe-personal = {{ .JSONRPC.UnsafePersonal }}
+
+# ReadOnly rejects all the methods sending or signing txs, e.g. eth_sendRawTransaction, eth_sign,
+# the personal namespace and the faucet, for the nodes exposing data-only public endpoints.
+read-only = {{ .JSONRPC.ReadOnly }}
+
+# DisabledMethods are the methods rejected by the server served over http, a method is a method
+# name or a namespace pattern. A batch with any disabled call is rejected.
+# Example: "debug_*,eth_getProof"
+disabled-methods = "{{range $index, $elmt := .JSONRPC.DisabledMethods}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MaxBatchItems is the max number of the calls of a batch request served over http (0=infinite).
+max-batch-items = {{ .JSONRPC.MaxBatchItems }}
+
+# MaxRequestSize is the max size in bytes of a request body served over http (0=infinite).
+max-request-size = {{ .JSONRPC.MaxRequestSize }}
+
+# MaxResponseSize is the max size in bytes of a response body served over http (0=infinite).
+max-response-size = {{ .JSONRPC.MaxResponseSize }}
+
+# MaxConcurrentRequests is the max number of the requests served over http at once, the
+# requests over the limit are rejected (0=infinite).
+max-concurrent-requests = {{ .JSONRPC.MaxConcurrentRequests }}
+
+# MethodRateLimits are the max calls per second of the methods served over http. A method is
+# a method name, a namespace pattern or * for all the methods, the namespace pattern shares
+# its limit among the methods of the namespace.
+# Example: "debug_*=2,eth_call=100"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MethodTimeouts are the execution timeouts of the methods served over http, a batch runs
+# with the shortest timeout of its calls.
+# Note, with any of the http limits above set, the node APIs of the admin and debug
+# namespaces, e.g. the profiling methods, are not served over http.
+# Example: "debug_*=30s,trace_*=30s"
+method-timeouts = "{{range $index, $elmt := .JSONRPC.MethodTimeouts}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MethodMetrics defines if the per-method calls, errors and latencies, and the in-flight
+# requests of the json-rpc server over http are recorded to the telemetry, they're exposed
+# on the prometheus endpoint of the API server. It requires the telemetry to be enabled, and
+# the APIs of the admin and debug namespaces are not served over http then.
+method-metrics = {{ .JSONRPC.MethodMetrics }}
+
+# AuthAddress defines the HTTP and WebSocket server address to bind to, which serves the auth-api
+# namespaces to the requests authenticated with a JWT, as the engine API of the ethereum clients.
+# It's meant to expose the privileged namespaces to the internal infrastructure, it's disabled if empty.
+# Example: "127.0.0.1:8551"
+auth-address = "{{ .JSONRPC.AuthAddress }}"
+
+# AuthJWTSecret is the path of the file of the hex encoded 32 bytes JWT secret, the file is generated
+# if it doesn't exist. Default: config/jwtsecret of the node home.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# AuthAPI defines a list of the JSON-RPC namespaces served by the authenticated server.
+auth-api = "{{range $index, $elmt := .JSONRPC.AuthAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# IPCPath defines the path of the unix socket IPC endpoint (a named pipe on windows), e.g. for geth
+# attach. A file name is placed in data/jsonrpc of the node home. The endpoint serves all the enabled
+# namespaces, including the privileged ones, to the local users allowed to access the socket.
+# It's disabled if empty.
+# Example: "artela.ipc"
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -86,16 +279,56 @@ fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
 [tls]
 
+# The JSON-RPC HTTP and WebSocket servers are served over TLS if the certificate and key paths
+# are set. Note, the APIs of the admin and debug namespaces are not served over HTTP then.
+
 # Certificate path defines the cert.pem file path for the TLS configuration.
 certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
 
+# Client CA path defines the .pem file path of the CA certificates verifying the client certificates,
+# the clients must present a certificate signed by them if set (mutual TLS).
+client-ca-path = "{{ .TLS.ClientCAPath }}"
+
 ###############################################################################
 ###                            Aspect Configuration                         ###
 ###############################################################################
 [aspect]
 apply-pool-size = {{ .Aspect.ApplyPoolSize }}
 query-pool-size = {{ .Aspect.QueryPoolSize }}
+
+###############################################################################
+###                            Faucet Configuration                         ###
+###############################################################################
+
+[faucet]
+
+# Enable defines if the development faucet (artela_requestFunds) should be served over JSON-RPC.
+# Only enable it on test networks.
+enable = {{ .Faucet.Enable }}
+
+# KeyName is the name of the key in the node's keyring of the faucet operator set in the evm params
+# (faucet_operator), it signs the drips and pays their fees. The drips are sent from the faucet
+# module account, fund it at genesis with "artelad add-genesis-account $(artelad faucet address)".
+key-name = "{{ .Faucet.KeyName }}"
+
+# Amount defines the coins sent for each accepted request.
+amount = "{{ .Faucet.Amount }}"
+
+# Fees defines the fees paid by each faucet transfer.
+fees = "{{ .Faucet.Fees }}"
+
+# GasLimit is the gas limit of each faucet transfer.
+gas-limit = {{ .Faucet.GasLimit }}
+
+# AddressInterval is the minimum duration between two accepted requests for the same address.
+address-interval = "{{ .Faucet.AddressInterval }}"
+
+# IPInterval is the minimum duration between two accepted requests from the same IP.
+ip-interval = "{{ .Faucet.IPInterval }}"
+
+# CaptchaWebhook is an optional URL the captcha token of each request is posted to for verification.
+captcha-webhook = "{{ .Faucet.CaptchaWebhook }}"
 `
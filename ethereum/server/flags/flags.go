@@ -41,15 +41,48 @@ const (
 	JSONWsAddress              = "json-rpc.ws-address"
 	JSONRPCGasCap              = "json-rpc.gas-cap"
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
+	JSONRPCCallCacheSize       = "json-rpc.call-cache-size"
+	JSONRPCResponseCacheSize   = "json-rpc.response-cache-size"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
+	JSONRPCGPOBlocks           = "json-rpc.gpo-blocks"
+	JSONRPCGPOPercentile       = "json-rpc.gpo-percentile"
+	JSONRPCGPOMaxPrice         = "json-rpc.gpo-max-price"
 	JSONRPCLogsCap             = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
+	JSONRPCHealthMaxBlockLag   = "json-rpc.health-max-block-lag"
+	JSONRPCHealthMaxIndexerLag = "json-rpc.health-max-indexer-lag"
+	JSONRPCHealthMaxBlockAge   = "json-rpc.health-max-block-age"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
+	JSONRPCDenyAddresses       = "json-rpc.deny-addresses"
+	JSONRPCAllowAddresses      = "json-rpc.allow-addresses"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnableLogIndex      = "json-rpc.enable-log-index"
+	JSONRPCEnableBloomIndex    = "json-rpc.enable-bloom-index"
+	JSONRPCEnableAddressIndex  = "json-rpc.enable-address-index"
+	JSONRPCIndexInternalCalls  = "json-rpc.index-internal-calls"
+	JSONRPCEnableStateFeed     = "json-rpc.enable-state-feed"
+	JSONRPCStateFeedRetain     = "json-rpc.state-feed-retain-blocks"
+	JSONRPCEnableGRPCBridge    = "json-rpc.enable-grpc-bridge"
+	JSONRPCEnableGraphQL       = "json-rpc.enable-graphql"
+	JSONRPCUnsafePersonal      = "json-rpc.unsafe-personal"
+	JSONRPCReadOnly            = "json-rpc.read-only"
+	JSONRPCDisabledMethods     = "json-rpc.disabled-methods"
+	JSONRPCFeeStatsWindows     = "json-rpc.fee-stats-windows"
+	JSONRPCMaxBatchItems       = "json-rpc.max-batch-items"
+	JSONRPCMaxRequestSize      = "json-rpc.max-request-size"
+	JSONRPCMaxResponseSize     = "json-rpc.max-response-size"
+	JSONRPCMaxConcurrent       = "json-rpc.max-concurrent-requests"
+	JSONRPCMethodRateLimits    = "json-rpc.method-rate-limits"
+	JSONRPCMethodTimeouts      = "json-rpc.method-timeouts"
+	JSONRPCMethodMetrics       = "json-rpc.method-metrics"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
+	JSONRPCAuthAPI             = "json-rpc.auth-api"
+	JSONRPCIPCPath             = "json-rpc.ipc-path"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -61,6 +94,17 @@ const (
 const (
 	EVMTracer         = "evm.tracer"
 	EVMMaxTxGasWanted = "evm.max-txs-gas-wanted"
+
+	EVMHistoryRetentionEpochs = "evm.history-retention-epochs"
+	EVMHistoryEpochLength     = "evm.history-epoch-length"
+
+	EVMSimulationNoBaseFee     = "evm.simulation-no-base-fee"
+	EVMEnablePreimageRecording = "evm.enable-preimage-recording"
+	EVMEnableAccessStats       = "evm.enable-access-stats"
+	EVMEnableContractIndex     = "evm.enable-contract-index"
+	EVMEnableWitness           = "evm.enable-witness"
+	EVMRPCGasCap               = "evm.rpc-gas-cap"
+	EVMMinPriorityFee          = "evm.min-priority-fee"
 )
 
 // Aspect flags
@@ -69,10 +113,23 @@ const (
 	QueryPoolSize = "aspect.query-pool-size"
 )
 
+// Faucet flags
+const (
+	FaucetEnable          = "faucet.enable"
+	FaucetKeyName         = "faucet.key-name"
+	FaucetAmount          = "faucet.amount"
+	FaucetFees            = "faucet.fees"
+	FaucetGasLimit        = "faucet.gas-limit"
+	FaucetAddressInterval = "faucet.address-interval"
+	FaucetIPInterval      = "faucet.ip-interval"
+	FaucetCaptchaWebhook  = "faucet.captcha-webhook"
+)
+
 // TLS flags
 const (
-	TLSCertPath = "tls.certificate-path"
-	TLSKeyPath  = "tls.key-path"
+	TLSCertPath     = "tls.certificate-path"
+	TLSKeyPath      = "tls.key-path"
+	TLSClientCAPath = "tls.client-ca-path"
 )
 
 // AddTxFlags adds common flags for commands to post txs
@@ -0,0 +1,354 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmtypes "github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/ethereum/rpc/utils"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+
+	// RecoveryCheckWindow is the number of latest indexed blocks checked
+	// against the block store at startup.
+	RecoveryCheckWindow = 1000
+
+	// blockchainInfoBatch is the max number of block metas returned by one BlockchainInfo call
+	blockchainInfoBatch = 20
+
+	// BloomIndexInterval is the interval the completed bloom bits sections are indexed at
+	BloomIndexInterval = 30 * time.Second
+
+	// HistoryPruneInterval is the interval the indexed blocks pruned from the block store are
+	// pruned from the indexer at
+	HistoryPruneInterval = time.Minute
+)
+
+// EVMIndexerService indexes transactions for json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr *indexer.KVIndexer
+	client rpcclient.Client
+
+	// bloomIndex enables indexing the bloom bits of the completed sections
+	bloomIndex bool
+	// historyPruning enables pruning the indexed blocks the block store no longer keeps
+	historyPruning bool
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr *indexer.KVIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// SetBloomIndex enables or disables indexing the bloom bits of the blocks in the
+// background, it must be set before the service is started.
+func (eis *EVMIndexerService) SetBloomIndex(enable bool) {
+	eis.bloomIndex = enable
+}
+
+// SetHistoryPruning enables or disables pruning the indexed blocks below the earliest block
+// of the block store in the background, so the EVM history follows the history retention
+// of the node. It must be set before the service is started.
+func (eis *EVMIndexerService) SetHistoryPruning(enable bool) {
+	eis.historyPruning = enable
+}
+
+// OnStart implements service.Service by recovering the indexer from an
+// inconsistent state and subscribing for new blocks and indexing them.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var latestBlock atomic.Int64
+	latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+
+	lastBlock, err := eis.recover(ctx, status.SyncInfo.EarliestBlockHeight, status.SyncInfo.LatestBlockHeight)
+	if err != nil {
+		return err
+	}
+
+	newBlockSignal := make(chan struct{}, 1)
+
+	// Use SubscribeUnbuffered here to ensure both subscriptions does not get
+	// canceled due to not pulling messages fast enough. Cause this might
+	// sometimes happen when there are no other subscribers.
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case msg := <-blockHeadersChan:
+				eventDataHeader := msg.Data.(tmtypes.EventDataNewBlockHeader)
+				if eventDataHeader.Header.Height > latestBlock.Load() {
+					latestBlock.Store(eventDataHeader.Header.Height)
+					// notify
+					select {
+					case newBlockSignal <- struct{}{}:
+					default:
+					}
+				}
+			case <-eis.Quit():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			if latestBlock.Load() <= lastBlock {
+				// nothing to index. wait for signal of new block
+				select {
+				case <-newBlockSignal:
+				case <-time.After(NewBlockWaitTimeout):
+				case <-eis.Quit():
+					return
+				}
+				continue
+			}
+			for i := lastBlock + 1; i <= latestBlock.Load(); i++ {
+				block, err := eis.client.Block(ctx, &i)
+				if err != nil {
+					eis.Logger.Error("failed to fetch block", "height", i, "err", err)
+					break
+				}
+				blockResult, err := eis.client.BlockResults(ctx, &i)
+				if err != nil {
+					eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
+					break
+				}
+				if err := eis.txIdxr.IndexBlock(block.Block, blockResult); err != nil {
+					eis.Logger.Error("failed to index block", "height", i, "err", err)
+					break
+				}
+				lastBlock = blockResult.Height
+			}
+		}
+	}()
+
+	if eis.bloomIndex {
+		go func() {
+			for {
+				if err := eis.indexBloomSections(ctx); err != nil {
+					eis.Logger.Error("failed to index bloom bits", "err", err)
+				}
+				select {
+				case <-time.After(BloomIndexInterval):
+				case <-eis.Quit():
+					return
+				}
+			}
+		}()
+	}
+
+	if eis.historyPruning {
+		go func() {
+			for {
+				if err := eis.pruneHistory(ctx); err != nil {
+					eis.Logger.Error("failed to prune evm history", "err", err)
+				}
+				select {
+				case <-time.After(HistoryPruneInterval):
+				case <-eis.Quit():
+					return
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// pruneHistory prunes the indexed blocks below the earliest block of the block store, which
+// CometBFT prunes once per history epoch, see app.Commit.
+func (eis *EVMIndexerService) pruneHistory(ctx context.Context) error {
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	first, err := eis.txIdxr.FirstIndexedBlock()
+	if err != nil {
+		return err
+	}
+
+	earliest := status.SyncInfo.EarliestBlockHeight
+	if first == -1 || first >= earliest {
+		return nil
+	}
+	if err := eis.txIdxr.PruneBlocksBefore(earliest); err != nil {
+		return err
+	}
+	eis.Logger.Info("pruned evm history", "from", first, "to", earliest-1)
+	return nil
+}
+
+// indexBloomSections indexes the bloom bits of the sections completed by the committed
+// blocks. The blocks pruned from the block store get a full bloom, so the filters always
+// scan them as before.
+func (eis *EVMIndexerService) indexBloomSections(ctx context.Context) error {
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	sections, err := eis.txIdxr.BloomSections()
+	if err != nil {
+		return err
+	}
+
+	size := int64(indexer.BloomBitsSectionSize) // #nosec G701
+	next := int64(sections)                     // #nosec G701
+
+	for section := next; (section+1)*size-1 <= status.SyncInfo.LatestBlockHeight; section++ {
+		blooms := make([]ethtypes.Bloom, size)
+		for i := range blooms {
+			height := section*size + int64(i)
+			switch {
+			case height == 0:
+				// there's no block at height 0
+			case height < status.SyncInfo.EarliestBlockHeight:
+				blooms[i] = fullBloom
+			default:
+				blockResult, err := eis.client.BlockResults(ctx, &height)
+				if err != nil {
+					return err
+				}
+				bloom, ok := utils.BlockBloomFromBlockResults(blockResult)
+				if !ok {
+					bloom = fullBloom
+				}
+				blooms[i] = bloom
+			}
+		}
+
+		if err := eis.txIdxr.IndexBloomSection(uint64(section), blooms); err != nil { // #nosec G701
+			return err
+		}
+		eis.Logger.Info("indexed bloom bits section", "section", section, "to", (section+1)*size-1)
+
+		select {
+		case <-eis.Quit():
+			return nil
+		default:
+		}
+	}
+	return nil
+}
+
+// fullBloom matches any filter, it's the bloom of the blocks whose logs are unknown.
+var fullBloom = func() (bloom ethtypes.Bloom) {
+	for i := range bloom {
+		bloom[i] = 0xff
+	}
+	return bloom
+}()
+
+// recover checks the latest indexed blocks against the block store, the
+// indexed data of a divergent range (e.g. left by an unclean shutdown or a
+// rollback) is dropped so those blocks are re-indexed. It returns the height
+// of the last block that is consistently indexed.
+func (eis *EVMIndexerService) recover(ctx context.Context, earliest, latest int64) (int64, error) {
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return 0, err
+	}
+	if lastBlock == -1 {
+		// empty indexer, start from the current block
+		return latest, nil
+	}
+
+	divergentFrom := int64(-1)
+	if lastBlock > latest {
+		// the indexer is ahead of the block store
+		divergentFrom = latest + 1
+	}
+
+	checkTo := lastBlock
+	if checkTo > latest {
+		checkTo = latest
+	}
+	checkFrom := checkTo - RecoveryCheckWindow + 1
+	if checkFrom < earliest {
+		checkFrom = earliest
+	}
+	if first, err := eis.txIdxr.FirstIndexedBlock(); err == nil && checkFrom < first {
+		checkFrom = first
+	}
+
+	mismatch, err := eis.firstMismatch(ctx, checkFrom, checkTo)
+	if err != nil {
+		return 0, err
+	}
+	if mismatch != -1 {
+		divergentFrom = mismatch
+	}
+
+	if divergentFrom == -1 {
+		return lastBlock, nil
+	}
+
+	eis.Logger.Error("evm indexer is inconsistent with the block store, re-indexing divergent range",
+		"from", divergentFrom, "to", lastBlock, "block-store-height", latest)
+	if err := eis.txIdxr.DeleteBlocksFrom(divergentFrom); err != nil {
+		return 0, err
+	}
+	return divergentFrom - 1, nil
+}
+
+// firstMismatch returns the first height in [from, to] which is not indexed or
+// whose indexed hash doesn't match the block store, -1 if all match.
+func (eis *EVMIndexerService) firstMismatch(ctx context.Context, from, to int64) (int64, error) {
+	for start := from; start <= to; start += blockchainInfoBatch {
+		end := start + blockchainInfoBatch - 1
+		if end > to {
+			end = to
+		}
+
+		info, err := eis.client.BlockchainInfo(ctx, start, end)
+		if err != nil {
+			return 0, err
+		}
+
+		// block metas are returned in descending order
+		for i := len(info.BlockMetas) - 1; i >= 0; i-- {
+			if mismatch, err := eis.checkBlock(info.BlockMetas[i]); err != nil || mismatch {
+				return info.BlockMetas[i].Header.Height, err
+			}
+		}
+	}
+	return -1, nil
+}
+
+func (eis *EVMIndexerService) checkBlock(meta *tmtypes.BlockMeta) (bool, error) {
+	hash, err := eis.txIdxr.IndexedBlockHash(meta.Header.Height)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(hash, meta.BlockID.Hash), nil
+}
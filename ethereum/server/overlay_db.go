@@ -0,0 +1,180 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+)
+
+var _ dbm.DB = (*overlayDB)(nil)
+
+// overlayDB is a copy-on-write view of a db, the reads fall through to the parent db
+// and the writes are kept in memory, so the parent db is never modified.
+type overlayDB struct {
+	parent dbm.DB
+	cache  *cachekv.Store
+}
+
+func newOverlayDB(parent dbm.DB) *overlayDB {
+	return &overlayDB{
+		parent: parent,
+		cache:  cachekv.NewStore(dbadapter.Store{DB: parent}),
+	}
+}
+
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key cannot be empty")
+	}
+	return db.cache.Get(key), nil
+}
+
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	if len(key) == 0 {
+		return false, errors.New("key cannot be empty")
+	}
+	return db.cache.Has(key), nil
+}
+
+func (db *overlayDB) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+	db.cache.Set(key, value)
+	return nil
+}
+
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+func (db *overlayDB) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	db.cache.Delete(key)
+	return nil
+}
+
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errors.New("key cannot be empty")
+	}
+	return overlayIterator{db.cache.Iterator(start, end)}, nil
+}
+
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errors.New("key cannot be empty")
+	}
+	return overlayIterator{db.cache.ReverseIterator(start, end)}, nil
+}
+
+// Close closes the parent db, the in-memory writes are dropped.
+func (db *overlayDB) Close() error {
+	return db.parent.Close()
+}
+
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+func (db *overlayDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return nil
+}
+
+func (db *overlayDB) Stats() map[string]string {
+	return db.parent.Stats()
+}
+
+// overlayIterator adapts the cache iterators, which report an error once exhausted, to
+// the db iterators.
+type overlayIterator struct {
+	dbm.Iterator
+}
+
+func (itr overlayIterator) Error() error {
+	if !itr.Valid() {
+		return nil
+	}
+	return itr.Iterator.Error()
+}
+
+// overlayBatch buffers the writes of a batch until it's written to the overlay db.
+type overlayBatch struct {
+	db  *overlayDB
+	ops []overlayOp
+}
+
+type overlayOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+func (b *overlayBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if value == nil {
+		return errors.New("value cannot be nil")
+	}
+	if b.db == nil {
+		return errors.New("batch has been written or closed")
+	}
+	b.ops = append(b.ops, overlayOp{key: key, value: value})
+	return nil
+}
+
+func (b *overlayBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errors.New("key cannot be empty")
+	}
+	if b.db == nil {
+		return errors.New("batch has been written or closed")
+	}
+	b.ops = append(b.ops, overlayOp{key: key, delete: true})
+	return nil
+}
+
+func (b *overlayBatch) Write() error {
+	if b.db == nil {
+		return errors.New("batch has been written or closed")
+	}
+	for _, op := range b.ops {
+		if op.delete {
+			b.db.cache.Delete(op.key)
+		} else {
+			b.db.cache.Set(op.key, op.value)
+		}
+	}
+	return b.Close()
+}
+
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+func (b *overlayBatch) Close() error {
+	b.db = nil
+	b.ops = nil
+	return nil
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/ethereum/replica"
+	"github.com/artela-network/artela/ethereum/server/config"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
+)
+
+const (
+	FlagReplicaUpstreamRPC     = "upstream-rpc"
+	FlagReplicaUpstreamJSONRPC = "upstream-json-rpc"
+)
+
+// replicaApp is the app of a replica, its state is written by the state feed of the
+// upstream node and its module queries are served from it.
+type replicaApp interface {
+	types.Application
+	GRPCQueryRouter() *baseapp.GRPCQueryRouter
+}
+
+// ReplicaCmd runs the JSON-RPC server as a read replica of an upstream node.
+// The replica doesn't run consensus nor execute the blocks, it applies the state
+// changes streamed by the upstream node to a local copy of the app state, serves
+// the state queries from it, and indexes the committed blocks in a local EVM indexer.
+func ReplicaCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replica",
+		Short: "Run a JSON-RPC read replica following an upstream validator or sentry node",
+		Long: `Run the JSON-RPC server without consensus, trailing an upstream node.
+
+The upstream node records the state changes of each committed block, enable
+json-rpc.enable-state-feed in its app.toml. The replica subscribes to them over
+the upstream JSON-RPC websocket and applies them to its local app state, checking
+each resulting app hash against the upstream one. State queries, such as balances,
+storage and eth_call, are served from the local state, the blocks and the txs are
+streamed from the upstream CometBFT RPC and indexed in the local EVM indexer.
+
+The replica resumes from its latest local block, which must be retained by the
+upstream state feed: start it from a copy of the upstream data/application.db
+taken while the upstream is stopped, or from genesis if the upstream retains all
+the blocks. The [json-rpc] section of app.toml configures the replica's JSON-RPC
+server.
+`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			return serverCtx.Viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			upstreamRPC, _ := cmd.Flags().GetString(FlagReplicaUpstreamRPC)
+			upstreamJSONRPC, _ := cmd.Flags().GetString(FlagReplicaUpstreamJSONRPC)
+			err = startReplica(serverCtx, clientCtx, appCreator, upstreamRPC, upstreamJSONRPC)
+			errCode, ok := err.(sdkserver.ErrorCode)
+			if !ok {
+				return err
+			}
+
+			serverCtx.Logger.Debug(fmt.Sprintf("received quit signal: %d", errCode.Code))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagReplicaUpstreamRPC, "tcp://127.0.0.1:26657", "the CometBFT RPC address of the upstream node")
+	cmd.Flags().String(FlagReplicaUpstreamJSONRPC, "ws://127.0.0.1:8546", "the JSON-RPC websocket address of the upstream node")
+	return cmd
+}
+
+func startReplica(ctx *sdkserver.Context, clientCtx client.Context, appCreator types.AppCreator, upstreamRPC, upstreamJSONRPC string) error {
+	cfg, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		return err
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !cfg.JSONRPC.Enable {
+		return errors.New("json-rpc must be enabled to run a replica")
+	}
+
+	tmEndpoint := "/websocket"
+	tmClient, err := rpchttp.New(upstreamRPC, tmEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to connect upstream rpc %s: %w", upstreamRPC, err)
+	}
+
+	status, err := tmClient.Status(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to query upstream status: %w", err)
+	}
+
+	db, err := openDB(ctx.Config.RootDir, sdkserver.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return err
+	}
+	app, ok := appCreator(ctx.Logger, db, nil, ctx.Viper).(replicaApp)
+	if !ok {
+		return errors.New("the app doesn't support the replica mode")
+	}
+	defer app.Close()
+
+	cms := app.CommitMultiStore()
+	queryClient := replica.NewQueryClient(tmClient, cms, app.GRPCQueryRouter(), ctx.Logger.With("module", "replica"))
+	clientCtx = clientCtx.
+		WithHomeDir(ctx.Config.RootDir).
+		WithChainID(status.NodeInfo.Network).
+		WithClient(queryClient)
+
+	// the websocket connection streams the committed blocks to the indexer
+	if err := tmClient.Start(); err != nil {
+		return err
+	}
+	defer func() { _ = tmClient.Stop() }()
+
+	followCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 2)
+	follower := replica.NewFollower(cms, upstreamJSONRPC, ctx.Logger.With("module", "replica"))
+	go func() {
+		errCh <- follower.Run(followCtx)
+	}()
+
+	idxDB, err := OpenIndexerDB(ctx.Config.RootDir, sdkserver.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return err
+	}
+	defer idxDB.Close()
+
+	idxLogger := ctx.Logger.With("indexer", "evm")
+	kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+	kvIdxer.SetLogIndex(cfg.JSONRPC.EnableLogIndex)
+	kvIdxer.SetAddressIndex(cfg.JSONRPC.EnableAddressIndex, internalCallTracer(clientCtx, cfg.JSONRPC))
+	indexerService := NewEVMIndexerService(kvIdxer, tmClient)
+	indexerService.SetBloomIndex(cfg.JSONRPC.EnableBloomIndex)
+	indexerService.SetLogger(idxLogger)
+	if err := indexerService.Start(); err != nil {
+		return err
+	}
+	defer func() { _ = indexerService.Stop() }()
+
+	var idxer artelatypes.EVMTxIndexer = kvIdxer
+	jsonrpcSrv, err := CreateJSONRPC(ctx, clientCtx, upstreamRPC, tmEndpoint, &cfg, idxer, nil, nil, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := jsonrpcSrv.Start(); err != nil {
+		return err
+	}
+	defer func() { _ = jsonrpcSrv.Shutdown() }()
+
+	ctx.Logger.Info("json-rpc replica started", "upstream-rpc", upstreamRPC, "upstream-json-rpc", upstreamJSONRPC,
+		"chain-id", status.NodeInfo.Network, "height", cms.LastCommitID().Version)
+
+	go func() {
+		errCh <- sdkserver.WaitForQuitSignals()
+	}()
+	return <-errCh
+}
@@ -0,0 +1,473 @@
+package server
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela/x/evm/txs"
+)
+
+const (
+	FlagSimulateHeight = "height"
+	FlagSimulateTime   = "time"
+)
+
+// simulatedTx is the outcome of an input tx of a simulated block.
+type simulatedTx struct {
+	// Index is the line of the tx in the input txs, counting from 0 and skipping the
+	// empty and comment lines.
+	Index     int    `json:"index"`
+	Hash      string `json:"hash"`
+	Included  bool   `json:"included"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace,omitempty"`
+	Log       string `json:"log,omitempty"`
+	GasWanted int64  `json:"gas_wanted"`
+	GasUsed   int64  `json:"gas_used"`
+	Fees      string `json:"fees"`
+}
+
+// simulatedBlock is the outcome of a simulated block, the included txs come first in
+// the block order, followed by the excluded ones in the input order.
+type simulatedBlock struct {
+	Height    int64         `json:"height"`
+	Time      time.Time     `json:"time"`
+	Proposer  string        `json:"proposer"`
+	GasUsed   int64         `json:"gas_used"`
+	Fees      string        `json:"fees"`
+	StateRoot string        `json:"state_root"`
+	Txs       []simulatedTx `json:"txs"`
+}
+
+// SimulateBlockCmd builds a block of the given txs on top of a committed height and
+// executes it offline, the node's data is not modified.
+func SimulateBlockCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-block [txs-file]",
+		Short: "Build and execute a block of the given txs on top of a committed height, without modifying the node's data",
+		Long: `Build the next block of a committed height from a list of txs and execute it offline,
+to model the inclusion outcomes of the txs.
+
+The txs file has one tx per line, either a 0x prefixed hex raw Ethereum tx or a base64
+encoded Cosmos tx, the empty lines and the lines starting with # are skipped.
+
+The block is built as the node would propose it: the txs are checked against the state
+of the height and ordered as the CometBFT mempool reaps them (by priority for the v1
+mempool, in the input order otherwise), capped by the block max bytes and max gas,
+and passed to the app's PrepareProposal. The block is then executed and committed
+against an in-memory copy of the state, and the per tx gas and fees, the fees collected
+and the resulting state root (app hash) are printed as JSON.
+
+The block header is derived from the committed block and the validator set of the next
+height, so the same inputs always give the same outcome. The node must be stopped.
+
+Example:
+$ artelad simulate-block txs.txt --height 1000000
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, _ := cmd.Flags().GetInt64(FlagSimulateHeight)
+			blockTime, _ := cmd.Flags().GetString(FlagSimulateTime)
+
+			lines, err := readSimulateTxs(args[0])
+			if err != nil {
+				return err
+			}
+
+			block, err := simulateBlock(serverCtx, clientCtx, appCreator, height, blockTime, lines)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(block, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagSimulateHeight, 0, "the committed height the block is built on, 0 for the latest one")
+	cmd.Flags().String(FlagSimulateTime, "", "the RFC3339 time of the block, the time of the committed block plus 1s by default")
+	return cmd
+}
+
+// readSimulateTxs reads the non-empty and non-comment lines of the txs file.
+func readSimulateTxs(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), tmtypes.MaxBlockSizeBytes)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func simulateBlock(
+	ctx *sdkserver.Context,
+	clientCtx client.Context,
+	appCreator types.AppCreator,
+	height int64,
+	blockTime string,
+	lines []string,
+) (*simulatedBlock, error) {
+	cfg := ctx.Config
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(cfg.DBBackend), cfg.DBDir())
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+
+	if height == 0 {
+		height = blockStore.Height()
+	}
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("block %d is not in the block store", height)
+	}
+	vals, err := stateStore.LoadValidators(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	params, err := stateStore.LoadConsensusParams(height + 1)
+	if err != nil {
+		return nil, err
+	}
+	commitInfo, lastCommit, err := loadLastCommitInfo(blockStore, stateStore, height)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := openDB(cfg.RootDir, sdkserver.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		return nil, err
+	}
+	// the writes of the simulated block are kept in memory, and the snapshots which are
+	// written to the node home are disabled.
+	overlay := newOverlayDB(db)
+	defer overlay.Close()
+	ctx.Viper.Set(FlagStateSyncSnapshotInterval, 0)
+
+	app := appCreator(ctx.Logger, overlay, nil, ctx.Viper)
+	cms := app.CommitMultiStore()
+	if latest := cms.LastCommitID().Version; latest < height {
+		return nil, fmt.Errorf("the app state is at height %d, below the block %d", latest, height)
+	} else if latest > height {
+		if err := cms.RollbackToVersion(height); err != nil {
+			return nil, fmt.Errorf("failed to load the state of height %d: %w", height, err)
+		}
+		loader, ok := app.(interface{ LoadHeight(height int64) error })
+		if !ok {
+			return nil, errors.New("the app can't load a past height")
+		}
+		if err := loader.LoadHeight(height); err != nil {
+			return nil, err
+		}
+	}
+
+	header := meta.Header
+	header.Height = height + 1
+	header.Time = meta.Header.Time.Add(time.Second)
+	if blockTime != "" {
+		if header.Time, err = time.Parse(time.RFC3339Nano, blockTime); err != nil {
+			return nil, err
+		}
+	}
+	header.LastBlockID = meta.BlockID
+	header.LastCommitHash = lastCommit.Hash()
+	header.AppHash = cms.LastCommitID().Hash
+	header.ValidatorsHash = vals.Hash()
+	header.ConsensusHash = params.Hash()
+	header.ProposerAddress = vals.GetProposer().Address
+
+	txBytes, hashes, err := decodeSimulateTxs(app, clientCtx, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &simulatedBlock{
+		Height:   header.Height,
+		Time:     header.Time,
+		Proposer: header.ProposerAddress.String(),
+	}
+	outcomes := make([]simulatedTx, len(txBytes))
+	for i := range txBytes {
+		outcomes[i] = simulatedTx{Index: i, Hash: hashes[i], Fees: sdk.NewCoins().String()}
+	}
+
+	// check the txs against the state of the height, as the mempool does
+	type candidate struct {
+		index int
+		res   abci.ResponseCheckTx
+	}
+	var candidates []candidate
+	for i, bz := range txBytes {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+		outcomes[i].GasWanted = res.GasWanted
+		if !res.IsOK() {
+			outcomes[i].Code, outcomes[i].Codespace, outcomes[i].Log = res.Code, res.Codespace, res.Log
+			continue
+		}
+		candidates = append(candidates, candidate{index: i, res: res})
+	}
+
+	// reap the txs as the mempool does
+	if cfg.Mempool.Version == tmcfg.MempoolV1 {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].res.Priority > candidates[j].res.Priority
+		})
+	}
+	maxBytes := params.Block.MaxBytes
+	if maxBytes == -1 {
+		maxBytes = tmtypes.MaxBlockSizeBytes
+	}
+	maxDataBytes := tmtypes.MaxDataBytes(maxBytes, 0, vals.Size())
+
+	var (
+		reaped              [][]byte
+		totalGas, totalSize int64
+	)
+	indexes := make(map[string]int, len(candidates))
+	for n, c := range candidates {
+		totalGas += c.res.GasWanted
+		totalSize += tmtypes.ComputeProtoSizeForTxs([]tmtypes.Tx{txBytes[c.index]})
+		if (params.Block.MaxGas >= 0 && totalGas > params.Block.MaxGas) || totalSize > maxDataBytes {
+			for _, left := range candidates[n:] {
+				outcomes[left.index].Log = "not reaped, the block max bytes or max gas is reached"
+			}
+			break
+		}
+		reaped = append(reaped, txBytes[c.index])
+		indexes[string(txBytes[c.index])] = c.index
+	}
+
+	prepared := app.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes:         maxDataBytes,
+		Txs:                reaped,
+		LocalLastCommit:    abci.ExtendedCommitInfo{Round: commitInfo.Round},
+		Height:             header.Height,
+		Time:               header.Time,
+		NextValidatorsHash: header.NextValidatorsHash,
+		ProposerAddress:    header.ProposerAddress,
+	})
+	header.DataHash = tmtypes.ToTxs(prepared.Txs).Hash()
+
+	// execute the block
+	app.BeginBlock(abci.RequestBeginBlock{
+		Hash:           header.Hash(),
+		Header:         *header.ToProto(),
+		LastCommitInfo: commitInfo,
+	})
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	totalFees := sdk.NewCoins()
+	included := make([]simulatedTx, 0, len(prepared.Txs))
+	for _, bz := range prepared.Txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+		fees := collectedFees(res.Events, feeCollector)
+		totalFees = totalFees.Add(fees...)
+		result.GasUsed += res.GasUsed
+
+		outcome := simulatedTx{Index: -1, Hash: fmt.Sprintf("%X", tmtypes.Tx(bz).Hash())}
+		if i, ok := indexes[string(bz)]; ok {
+			outcome = outcomes[i]
+			outcomes[i].Included = true
+		}
+		outcome.Included = true
+		outcome.Code, outcome.Codespace, outcome.Log = res.Code, res.Codespace, res.Log
+		outcome.GasWanted, outcome.GasUsed = res.GasWanted, res.GasUsed
+		outcome.Fees = fees.String()
+		included = append(included, outcome)
+	}
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	commit := app.Commit()
+
+	result.Fees = totalFees.String()
+	result.StateRoot = hexutil.Encode(commit.Data)
+	result.Txs = included
+	for _, outcome := range outcomes {
+		if !outcome.Included {
+			result.Txs = append(result.Txs, outcome)
+		}
+	}
+	return result, nil
+}
+
+// loadLastCommitInfo returns the votes of the commit of the given height, as CometBFT
+// passes them to the BeginBlock of the next height.
+func loadLastCommitInfo(blockStore *store.BlockStore, stateStore sm.Store, height int64) (abci.CommitInfo, *tmtypes.Commit, error) {
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return abci.CommitInfo{}, nil, fmt.Errorf("the commit of block %d is not in the block store", height)
+	}
+
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return abci.CommitInfo{}, nil, err
+	}
+	if commit.Size() != vals.Size() {
+		return abci.CommitInfo{}, nil, fmt.Errorf("commit size (%d) doesn't match validator set length (%d) at height %d", commit.Size(), vals.Size(), height)
+	}
+
+	votes := make([]abci.VoteInfo, commit.Size())
+	for i, val := range vals.Validators {
+		votes[i] = abci.VoteInfo{
+			Validator:       tmtypes.TM2PB.Validator(val),
+			SignedLastBlock: commit.Signatures[i].BlockIDFlag != tmtypes.BlockIDFlagAbsent,
+		}
+	}
+	return abci.CommitInfo{Round: commit.Round, Votes: votes}, commit, nil
+}
+
+// decodeSimulateTxs decodes the input txs into the Cosmos tx bytes, the raw Ethereum txs
+// are wrapped into Cosmos txs as eth_sendRawTransaction does. The returned hashes are the
+// Ethereum tx hashes for the Ethereum txs and the CometBFT tx hashes otherwise.
+func decodeSimulateTxs(app types.Application, clientCtx client.Context, lines []string) ([][]byte, []string, error) {
+	var evmDenom string
+	txBytes := make([][]byte, len(lines))
+	hashes := make([]string, len(lines))
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "0x") {
+			bz, err := base64.StdEncoding.DecodeString(line)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid tx %d: %w", i, err)
+			}
+			txBytes[i], hashes[i] = bz, fmt.Sprintf("%X", tmtypes.Tx(bz).Hash())
+			if tx, err := clientCtx.TxConfig.TxDecoder()(bz); err == nil {
+				if msgs := tx.GetMsgs(); len(msgs) == 1 {
+					if ethMsg, ok := msgs[0].(*txs.MsgEthereumTx); ok {
+						hashes[i] = ethMsg.AsTransaction().Hash().Hex()
+					}
+				}
+			}
+			continue
+		}
+
+		raw, err := hexutil.Decode(line)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+		signedTx := new(ethtypes.Transaction)
+		if err := signedTx.UnmarshalBinary(raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+		ethereumTx := &txs.MsgEthereumTx{}
+		if err := ethereumTx.FromEthereumTx(signedTx); err != nil {
+			return nil, nil, fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+
+		if evmDenom == "" {
+			req, err := (&txs.QueryParamsRequest{}).Marshal()
+			if err != nil {
+				return nil, nil, err
+			}
+			res := app.Query(abci.RequestQuery{Path: "/artela.evm.v1.Query/Params", Data: req})
+			if !res.IsOK() {
+				return nil, nil, fmt.Errorf("failed to query evm params: %s", res.Log)
+			}
+			var params txs.QueryParamsResponse
+			if err := params.Unmarshal(res.Value); err != nil {
+				return nil, nil, err
+			}
+			evmDenom = params.Params.EvmDenom
+		}
+
+		cosmosTx, err := ethereumTx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmDenom)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+		if txBytes[i], err = clientCtx.TxConfig.TxEncoder()(cosmosTx); err != nil {
+			return nil, nil, err
+		}
+		hashes[i] = signedTx.Hash().Hex()
+	}
+	return txBytes, hashes, nil
+}
+
+// collectedFees returns the coins the fee collector received minus the ones it spent in
+// the events of a tx, which are the fees paid by the tx after the refunds.
+func collectedFees(events []abci.Event, feeCollector string) sdk.Coins {
+	received, spent := sdk.NewCoins(), sdk.NewCoins()
+	for _, event := range events {
+		var account, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case banktypes.AttributeKeyReceiver, banktypes.AttributeKeySpender:
+				account = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		if account != feeCollector {
+			continue
+		}
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			continue
+		}
+		switch event.Type {
+		case banktypes.EventTypeCoinReceived:
+			received = received.Add(coins...)
+		case banktypes.EventTypeCoinSpent:
+			spent = spent.Add(coins...)
+		}
+	}
+
+	fees, hasNeg := received.SafeSub(spent...)
+	if hasNeg {
+		return sdk.NewCoins()
+	}
+	return fees
+}
@@ -11,10 +11,15 @@ import (
 	"runtime/pprof"
 	"time"
 
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/ethereum/replica"
 	"github.com/artela-network/artela/ethereum/rpc"
+	"github.com/artela-network/artela/ethereum/rpc/gateway"
 	"github.com/artela-network/artela/ethereum/server/config"
 	artelaflag "github.com/artela-network/artela/ethereum/server/flags"
+	artelatypes "github.com/artela-network/artela/ethereum/types"
 
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	"github.com/cometbft/cometbft/node"
@@ -215,25 +220,77 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(artelaflag.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is uart (0=infinite)")        //nolint:lll
 	cmd.Flags().Float64(artelaflag.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 artela)") //nolint:lll
 	cmd.Flags().Int32(artelaflag.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Int32(artelaflag.JSONRPCGPOBlocks, config.DefaultGPOBlocks, "Sets the number of recent blocks the gas price oracle samples the priority fees of")
+	cmd.Flags().Int32(artelaflag.JSONRPCGPOPercentile, config.DefaultGPOPercentile, "Sets the percentile of the sampled priority fees suggested by the gas price oracle")
+	cmd.Flags().Uint64(artelaflag.JSONRPCGPOMaxPrice, config.DefaultGPOMaxPrice, "Sets the cap in wei of the priority fee suggested by the gas price oracle")
 	cmd.Flags().Duration(artelaflag.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Int(artelaflag.JSONRPCCallCacheSize, 0, "Sets the number of the eth_call results cached for the calls at fixed heights (0=disabled)")
+	cmd.Flags().Int(artelaflag.JSONRPCResponseCacheSize, 0, "Sets the number of the blocks, block results, txs and receipts of the committed blocks cached for the RPC queries (0=disabled)")
 	cmd.Flags().Duration(artelaflag.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(artelaflag.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(artelaflag.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
+	cmd.Flags().StringSlice(artelaflag.JSONRPCDenyAddresses, nil, "Reject the txs submitted via the node's RPC which interact with the listed addresses")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCAllowAddresses, nil, "Reject the txs submitted via the node's RPC which are neither sent from nor sent to the listed addresses")
 	cmd.Flags().Int32(artelaflag.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(artelaflag.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int64(artelaflag.JSONRPCHealthMaxBlockLag, config.DefaultHealthMaxBlockLag, "Sets the max number of blocks the node can be behind its peers while /ready reports the node as ready")
+	cmd.Flags().Int64(artelaflag.JSONRPCHealthMaxIndexerLag, config.DefaultHealthMaxIndexerLag, "Sets the max number of blocks the indexer can be behind the node while /ready reports the node as ready")
+	cmd.Flags().Duration(artelaflag.JSONRPCHealthMaxBlockAge, config.DefaultHealthMaxBlockAge, "Sets the max age of the latest block while /ready reports the node as ready (0=disabled)")
 	cmd.Flags().Int(artelaflag.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableLogIndex, false, "Enable the (address, topic0) inverted index of the EVM logs, requires the custom tx indexer")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableBloomIndex, false, "Enable the bloom bits index of the blocks, requires the custom tx indexer")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableAddressIndex, false, "Enable the address to txs index of the eth txs, requires the custom tx indexer")
+	cmd.Flags().Bool(artelaflag.JSONRPCIndexInternalCalls, false, "Index the callers and the callees of the internal calls in the address index, requires the address index")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableStateFeed, false, "Record the state changes of the committed blocks and stream them to the read replicas")
+	cmd.Flags().Uint64(artelaflag.JSONRPCStateFeedRetain, config.DefaultStateFeedRetainBlocks, "Sets the number of the latest blocks whose state changes are kept by the state feed (0=all)")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableGRPCBridge, false, "Serve the common read-only eth methods over the gRPC and REST API servers")
+	cmd.Flags().Bool(artelaflag.JSONRPCEnableGraphQL, false, "Serve the GraphQL queries on /graphql of the json-rpc http server")
+	cmd.Flags().Int(artelaflag.JSONRPCMaxBatchItems, 0, "Sets the max number of the calls of a batch request served over http (0=infinite)")
+	cmd.Flags().Int64(artelaflag.JSONRPCMaxRequestSize, 0, "Sets the max size in bytes of a request body served over http (0=infinite)")
+	cmd.Flags().Int(artelaflag.JSONRPCMaxResponseSize, 0, "Sets the max size in bytes of a response body served over http (0=infinite)")
+	cmd.Flags().Int(artelaflag.JSONRPCMaxConcurrent, 0, "Sets the max number of the requests served over http at once (0=infinite)")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCMethodRateLimits, nil, "Sets the method=calls per second rate limits of the methods served over http, e.g. debug_*=2")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCMethodTimeouts, nil, "Sets the method=timeout execution timeouts of the methods served over http, e.g. debug_*=30s")
+	cmd.Flags().Bool(artelaflag.JSONRPCMethodMetrics, false, "Record the per-method metrics of the json-rpc requests served over http to the telemetry")
+	cmd.Flags().String(artelaflag.JSONRPCAuthAddress, "", "the JWT authenticated JSON-RPC server address to listen on, disabled if empty")
+	cmd.Flags().String(artelaflag.JSONRPCAuthJWTSecret, "", "the path of the JWT secret file of the authenticated JSON-RPC server (default config/jwtsecret of the node home)")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCAuthAPI, config.GetDefaultAuthAPINamespaces(), "Defines a list of the JSON-RPC namespaces served by the authenticated server")
+	cmd.Flags().String(artelaflag.JSONRPCIPCPath, "", "the path of the JSON-RPC IPC endpoint, a file name is placed in data/jsonrpc of the node home, disabled if empty")
+	cmd.Flags().Bool(artelaflag.JSONRPCUnsafePersonal, false, "Serve the personal namespace backed by the node keyring, for dev environments only")
+	cmd.Flags().Bool(artelaflag.JSONRPCReadOnly, false, "Reject all the json-rpc methods sending or signing txs")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCDisabledMethods, nil, "Sets the methods rejected by the json-rpc server served over http, e.g. debug_*")
+	cmd.Flags().StringSlice(artelaflag.JSONRPCFeeStatsWindows, config.GetDefaultFeeStatsWindows(), "Sets the windows of the rolling fee stats served by artela_feeStats, disabled if empty")
 	cmd.Flags().Bool(artelaflag.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(artelaflag.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(artelaflag.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Uint64(artelaflag.EVMHistoryRetentionEpochs, 0, "the number of epochs of block headers and receipts kept on a non-archive node (0=keep all)")
+	cmd.Flags().Uint64(artelaflag.EVMHistoryEpochLength, config.DefaultHistoryEpochLength, "the number of blocks of a history retention epoch")
+	cmd.Flags().Bool(artelaflag.EVMSimulationNoBaseFee, false, "force the base fee to 0 for eth_call and eth_estimateGas")
+	cmd.Flags().Bool(artelaflag.EVMEnablePreimageRecording, false, "enable the recording of the SHA3 preimages by the EVM")
+	cmd.Flags().Bool(artelaflag.EVMEnableAccessStats, false, "enable the recording of the per block storage access counters of the contracts")
+	cmd.Flags().Bool(artelaflag.EVMEnableContractIndex, false, "enable the indexing of the creator and creation tx of the contracts")
+	cmd.Flags().Bool(artelaflag.EVMEnableWitness, false, "enable the recording of the per block execution witnesses")
+	cmd.Flags().Uint64(artelaflag.EVMRPCGasCap, config.DefaultRPCGasCap, "the max gas of eth_call and eth_estimateGas enforced by the query server (0=no cap)")
+	cmd.Flags().Uint64(artelaflag.EVMMinPriorityFee, 0, "the min priority fee per gas in wei of the eth txs accepted into the local mempool (0=disabled)")
 
 	cmd.Flags().String(artelaflag.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(artelaflag.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
+	cmd.Flags().String(artelaflag.TLSClientCAPath, "", "the CA .pem file path verifying the client certificates of the server TLS configuration")
 
 	cmd.Flags().Uint64(artelaflag.ApplyPoolSize, aspecttypes.DefaultAspectPoolSize, "the cache pool size for runtime instances for applying message")
 	cmd.Flags().Uint64(artelaflag.QueryPoolSize, aspecttypes.DefaultAspectPoolSize, "the cache pool size for runtime instances for querying message")
 
+	cmd.Flags().Bool(artelaflag.FaucetEnable, false, "Define if the development faucet (artela_requestFunds) should be served, only for test networks")
+	cmd.Flags().String(artelaflag.FaucetKeyName, config.DefaultFaucetKeyName, "the keyring key of the faucet operator signing the faucet drips")
+	cmd.Flags().String(artelaflag.FaucetAmount, config.DefaultFaucetAmount, "the coins sent for each faucet request")
+	cmd.Flags().String(artelaflag.FaucetFees, "", "the fees paid by each faucet transfer")
+	cmd.Flags().Uint64(artelaflag.FaucetGasLimit, config.DefaultFaucetGasLimit, "the gas limit of each faucet transfer")
+	cmd.Flags().Duration(artelaflag.FaucetAddressInterval, config.DefaultFaucetAddressInterval, "the minimum duration between two faucet requests for the same address")
+	cmd.Flags().Duration(artelaflag.FaucetIPInterval, config.DefaultFaucetIPInterval, "the minimum duration between two faucet requests from the same IP")
+	cmd.Flags().String(artelaflag.FaucetCaptchaWebhook, "", "an optional URL used to verify the captcha token of faucet requests")
+
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
@@ -333,12 +390,105 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
+	var preimageDB dbm.DB
+	if config.EVM.EnablePreimageRecording {
+		preimageDB, err = OpenPreimageDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open preimage DB", "error", err.Error())
+			return err
+		}
+		defer preimageDB.Close()
+
+		recorder, ok := app.(PreimageRecorder)
+		if !ok {
+			return errors.New("the app doesn't support preimage recording")
+		}
+		recorder.SetPreimageDB(preimageDB)
+	}
+
+	var accessStatsDB dbm.DB
+	if config.EVM.EnableAccessStats {
+		accessStatsDB, err = OpenAccessStatsDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open access stats DB", "error", err.Error())
+			return err
+		}
+		defer accessStatsDB.Close()
+
+		recorder, ok := app.(AccessStatsRecorder)
+		if !ok {
+			return errors.New("the app doesn't support access stats recording")
+		}
+		recorder.SetAccessStatsDB(accessStatsDB)
+	}
+
+	var contractIndexDB dbm.DB
+	if config.EVM.EnableContractIndex {
+		contractIndexDB, err = OpenContractIndexDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open contract index DB", "error", err.Error())
+			return err
+		}
+		defer contractIndexDB.Close()
+
+		indexer, ok := app.(ContractIndexer)
+		if !ok {
+			return errors.New("the app doesn't support contract indexing")
+		}
+		indexer.SetContractIndexDB(contractIndexDB)
+	}
+
+	var witnessDB dbm.DB
+	if config.EVM.EnableWitness {
+		witnessDB, err = OpenWitnessDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open witness DB", "error", err.Error())
+			return err
+		}
+		defer witnessDB.Close()
+
+		recorder, ok := app.(WitnessRecorder)
+		if !ok {
+			return errors.New("the app doesn't support witness recording")
+		}
+		recorder.SetWitnessDB(witnessDB)
+	}
+
+	var stateFeed *replica.Recorder
+	if config.JSONRPC.EnableStateFeed {
+		stateFeedDB, err := OpenStateFeedDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open state feed DB", "error", err.Error())
+			return err
+		}
+		defer stateFeedDB.Close()
+
+		recorder, ok := app.(StateFeedRecorder)
+		if !ok {
+			return errors.New("the app doesn't support state feed recording")
+		}
+		stateFeed = replica.NewRecorder(stateFeedDB, config.JSONRPC.StateFeedRetainBlocks)
+		recorder.SetStateFeed(stateFeed)
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		return err
 	}
 	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
 
+	// the signer and the JSON-RPC use the chain-id of the genesis, make sure the app is
+	// configured with the same one, e.g. not overridden by a stale --chain-id flag.
+	if provider, ok := app.(ChainIDProvider); ok {
+		genDoc, err := genDocProvider()
+		if err != nil {
+			return err
+		}
+		if err := artelatypes.ValidateChainIDsMatch(genDoc.ChainID, provider.ChainId()); err != nil {
+			return err
+		}
+	}
+
 	var (
 		tmNode   *node.Node
 		gRPCOnly = ctx.Viper.GetBool(flagGRPCOnly)
@@ -387,6 +537,11 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 		return err
 	}
 
+	var ethBridge *gateway.Bridge
+	if config.JSONRPC.Enable && config.JSONRPC.EnableGRPCBridge {
+		ethBridge = gateway.NewBridge()
+	}
+
 	var grpcClient *grpc.ClientConn
 	var apiSrv *api.Server
 	if config.API.Enable {
@@ -435,6 +590,9 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
 		app.RegisterAPIRoutes(apiSrv, config.API)
+		if ethBridge != nil {
+			ethBridge.RegisterRoutes(apiSrv.Router)
+		}
 		if config.Telemetry.Enabled {
 			apiSrv.SetTelemetry(metrics)
 		}
@@ -454,6 +612,33 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 		}
 	}
 
+	var idxer artelatypes.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer && tmNode != nil {
+		idxDB, err := OpenIndexerDB(home, sdkserver.GetAppDBBackend(ctx.Viper))
+		if err != nil {
+			ctx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer.SetLogIndex(config.JSONRPC.EnableLogIndex)
+		kvIdxer.SetAddressIndex(config.JSONRPC.EnableAddressIndex, internalCallTracer(clientCtx, config.JSONRPC))
+		indexerService := NewEVMIndexerService(kvIdxer, local.New(tmNode))
+		indexerService.SetBloomIndex(config.JSONRPC.EnableBloomIndex)
+		indexerService.SetHistoryPruning(config.HistoryRetention())
+		indexerService.SetLogger(idxLogger)
+
+		if err := indexerService.Start(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = indexerService.Stop()
+			_ = idxDB.Close()
+		}()
+		idxer = kvIdxer
+	}
+
 	var (
 		jsonrpcSrv *rpc.ArtelaService
 		errCh      chan error = make(chan error)
@@ -469,11 +654,29 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config)
+		jsonrpcSrv, err = CreateJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, preimageDB, accessStatsDB, contractIndexDB, witnessDB, stateFeed)
 		if err != nil {
 			return err
 		}
 
+		if notifier, ok := app.(ProposalNotifier); ok {
+			notifier.SetProposalListener(jsonrpcSrv.NotifyProposal)
+		}
+		if provider, ok := app.(TraceSnapshotProvider); ok {
+			jsonrpcSrv.SetTraceSnapshots(provider.TraceSnapshots())
+		}
+		if notifier, ok := app.(CheckTxNotifier); ok {
+			notifier.SetCheckTxListener(jsonrpcSrv.NotifyCheckedTx)
+		}
+
+		if ethBridge != nil {
+			client, err := jsonrpcSrv.Attach()
+			if err != nil {
+				return err
+			}
+			ethBridge.SetClient(client)
+		}
+
 		go func() {
 			// wait for the start of the RPC server.
 			time.Sleep(8 * time.Second)
@@ -496,7 +699,11 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 	)
 
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC)
+		grpcApp := app
+		if ethBridge != nil {
+			grpcApp = bridgedApp{Application: app, bridge: ethBridge}
+		}
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, grpcApp, config.GRPC)
 		if err != nil {
 			return err
 		}
@@ -573,6 +780,11 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 	}
 
 	defer func() {
+		// the json-rpc requests in flight are drained before the node they query is stopped
+		if jsonrpcSrv != nil {
+			_ = jsonrpcSrv.Shutdown()
+		}
+
 		if tmNode != nil && tmNode.IsRunning() {
 			_ = tmNode.Stop()
 			_ = app.Close()
@@ -586,10 +798,6 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, appCreator
 			_ = apiSrv.Close()
 		}
 
-		if jsonrpcSrv != nil {
-			_ = jsonrpcSrv.Shutdown()
-		}
-
 		ctx.Logger.Info("exiting...")
 	}()
 
@@ -3,6 +3,8 @@ package server
 import (
 	"fmt"
 	"io"
+	"math/big"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -15,6 +17,7 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 
 	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -22,9 +25,15 @@ import (
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
+	"github.com/artela-network/artela/ethereum/indexer"
+	"github.com/artela-network/artela/ethereum/replica"
 	rpc2 "github.com/artela-network/artela/ethereum/rpc"
+	"github.com/artela-network/artela/ethereum/rpc/gateway"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/ethereum/server/config"
+	types2 "github.com/artela-network/artela/ethereum/types"
 )
 
 // add server commands
@@ -50,6 +59,8 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 
 	rootCmd.AddCommand(
 		startCmd,
+		ReplicaCmd(appCreator, defaultNodeHome),
+		SimulateBlockCmd(appCreator, defaultNodeHome),
 		tendermintCmd,
 		sdkserver.ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
@@ -63,11 +74,25 @@ func CreateJSONRPC(ctx *server.Context,
 	tmRPCAddr,
 	tmEndpoint string,
 	config *config.Config,
+	indexer types2.EVMTxIndexer,
+	preimageDB dbm.DB,
+	accessStatsDB dbm.DB,
+	contractIndexDB dbm.DB,
+	witnessDB dbm.DB,
+	stateFeed *replica.Recorder,
 ) (*rpc2.ArtelaService, error) {
 	cfg := rpc2.DefaultConfig()
+	cfg.PreimageDB = preimageDB
+	cfg.AccessStatsDB = accessStatsDB
+	cfg.ContractIndexDB = contractIndexDB
+	cfg.WitnessDB = witnessDB
+	cfg.StateFeed = stateFeed
 	cfg.RPCGasCap = config.JSONRPC.GasCap
 	cfg.RPCEVMTimeout = config.JSONRPC.EVMTimeout
 	cfg.RPCTxFeeCap = config.JSONRPC.TxFeeCap
+	cfg.GPO.Blocks = int(config.JSONRPC.GPOBlocks)
+	cfg.GPO.Percentile = int(config.JSONRPC.GPOPercentile)
+	cfg.GPO.MaxPrice = new(big.Int).SetUint64(config.JSONRPC.GPOMaxPrice)
 	cfg.AppCfg = config
 
 	nodeCfg := rpc2.DefaultGethNodeConfig()
@@ -82,6 +107,49 @@ func CreateJSONRPC(ctx *server.Context,
 		}
 		nodeCfg.HTTPPort = port
 	}
+	wsAddress := strings.Split(config.JSONRPC.WsAddress, ":")
+	if len(wsAddress) > 1 {
+		port, err := strconv.Atoi(wsAddress[1])
+		if err != nil {
+			return nil, fmt.Errorf("ws-address of JSON RPC Configuration is not valid, %w", err)
+		}
+		nodeCfg.WSHost = wsAddress[0]
+		nodeCfg.WSPort = port
+	}
+	// keep the data of the networking stack in the node home, so that the nodes of a
+	// host don't compete for the lock of the default directory
+	nodeCfg.DataDir = filepath.Join(ctx.Config.RootDir, "data", "jsonrpc")
+	nodeCfg.IPCPath = config.JSONRPC.IPCPath
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "artela")
+	nodeCfg.WSModules = append(nodeCfg.WSModules, "artela")
+	// the admin namespace of the node also controls the servers, so it's served over HTTP
+	// only if it's listed in the enabled namespaces explicitly
+	if apiEnabled(config.JSONRPC.API, "admin") {
+		nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "admin")
+	}
+	if config.JSONRPC.UnsafePersonal {
+		// the node drops the personal namespace unless it's enabled explicitly
+		nodeCfg.EnablePersonal = true
+		nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "personal")
+	}
+
+	authModules := config.JSONRPC.AuthNamespaces()
+	if len(authModules) > 0 {
+		host, port, err := net.SplitHostPort(config.JSONRPC.AuthAddress)
+		if err != nil {
+			return nil, fmt.Errorf("auth-address of JSON RPC Configuration is not valid, %w", err)
+		}
+		nodeCfg.AuthAddr = host
+		if nodeCfg.AuthPort, err = strconv.Atoi(port); err != nil {
+			return nil, fmt.Errorf("auth-address of JSON RPC Configuration is not valid, %w", err)
+		}
+		// the requests are authenticated by the JWT, whatever host they are sent to
+		nodeCfg.AuthVirtualHosts = []string{"*"}
+		nodeCfg.JWTSecret = config.JSONRPC.AuthJWTSecret
+		if nodeCfg.JWTSecret == "" {
+			nodeCfg.JWTSecret = filepath.Join(ctx.Config.RootDir, "config", "jwtsecret")
+		}
+	}
 
 	logger := ctx.Logger.With("module", "geth")
 	nodeCfg.Logger = ethlog.New()
@@ -97,7 +165,33 @@ func CreateJSONRPC(ctx *server.Context,
 		return nil
 	}))
 
-	stack, err := rpc2.NewNode(nodeCfg)
+	rateLimits, err := config.JSONRPC.ParseMethodRateLimits()
+	if err != nil {
+		return nil, err
+	}
+	timeouts, err := config.JSONRPC.ParseMethodTimeouts()
+	if err != nil {
+		return nil, err
+	}
+	disabled, err := config.JSONRPC.ParseDisabledMethods()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := rpc2.LoadTLSConfig(config.TLS.CertificatePath, config.TLS.KeyPath, config.TLS.ClientCAPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stack, err := rpc2.NewNode(nodeCfg, rpc2.HTTPLimits{
+		MaxBatchItems:         config.JSONRPC.MaxBatchItems,
+		MaxRequestSize:        config.JSONRPC.MaxRequestSize,
+		MaxResponseSize:       config.JSONRPC.MaxResponseSize,
+		MaxConcurrentRequests: config.JSONRPC.MaxConcurrentRequests,
+		MethodRateLimits:      rateLimits,
+		MethodTimeouts:        timeouts,
+		DisabledMethods:       disabled,
+	}, config.JSONRPC.MethodMetrics && config.Telemetry.Enabled, tlsConfig, authModules)
 	if err != nil {
 		return nil, err
 	}
@@ -105,11 +199,115 @@ func CreateJSONRPC(ctx *server.Context,
 	wsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
 
 	am := accounts.NewManager(&accounts.Config{InsecureUnlockAllowed: false})
-	serv := rpc2.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, am, nodeCfg.Logger)
+	serv := rpc2.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, am, nodeCfg.Logger, indexer)
 
 	return serv, nil
 }
 
+// bridgedApp registers the gRPC service of the eth methods bridge along with the app services.
+type bridgedApp struct {
+	types.Application
+	bridge *gateway.Bridge
+}
+
+// RegisterGRPCServer registers the gRPC services of the app and the bridge.
+func (a bridgedApp) RegisterGRPCServer(server gogogrpc.Server) {
+	a.Application.RegisterGRPCServer(server)
+	a.bridge.RegisterGRPCServer(server)
+}
+
+// PreimageRecorder is implemented by the apps recording the SHA3 preimages seen by the EVM.
+type PreimageRecorder interface {
+	SetPreimageDB(db dbm.DB)
+}
+
+// ChainIDProvider is implemented by the apps exposing the chain-id they are configured with.
+type ChainIDProvider interface {
+	ChainId() string
+}
+
+// CheckTxNotifier is implemented by the apps notifying the new txs passing the CheckTx.
+type CheckTxNotifier interface {
+	SetCheckTxListener(listener func(tx []byte))
+}
+
+// ProposalNotifier is implemented by the apps notifying the block proposals they process.
+type ProposalNotifier interface {
+	SetProposalListener(listener func(proposal *abci.RequestProcessProposal))
+}
+
+// TraceSnapshotProvider is implemented by the apps running the debug traces against the
+// snapshots of their state.
+type TraceSnapshotProvider interface {
+	TraceSnapshots() rpctypes.TraceSnapshots
+}
+
+// AccessStatsRecorder is implemented by the apps recording the storage access counters of the contracts.
+type AccessStatsRecorder interface {
+	SetAccessStatsDB(db dbm.DB)
+}
+
+// OpenAccessStatsDB opens the db of the per block storage access counters, using the same db backend as the main app
+func OpenAccessStatsDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("accessstats", backendType, dataDir)
+}
+
+// ContractIndexer is implemented by the apps indexing the creations of the contracts.
+type ContractIndexer interface {
+	SetContractIndexDB(db dbm.DB)
+}
+
+// OpenContractIndexDB opens the db of the contract creations, using the same db backend as the main app
+func OpenContractIndexDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("contractindex", backendType, dataDir)
+}
+
+// WitnessRecorder is implemented by the apps recording the execution witnesses of the blocks.
+type WitnessRecorder interface {
+	SetWitnessDB(db dbm.DB)
+}
+
+// OpenWitnessDB opens the db of the per block execution witnesses, using the same db backend as the main app
+func OpenWitnessDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("witness", backendType, dataDir)
+}
+
+// StateFeedRecorder is implemented by the apps recording the state changes of the committed
+// blocks for the read replicas.
+type StateFeedRecorder interface {
+	SetStateFeed(recorder *replica.Recorder)
+}
+
+// OpenStateFeedDB opens the db of the per block state changes, using the same db backend as the main app
+func OpenStateFeedDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("statefeed", backendType, dataDir)
+}
+
+// OpenPreimageDB opens the db of the SHA3 preimages recorded by the EVM, using the same db backend as the main app
+func OpenPreimageDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("preimages", backendType, dataDir)
+}
+
+// OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
+
+// internalCallTracer returns the tracer of the internal calls indexed in the address index,
+// nil if the internal calls are not indexed.
+func internalCallTracer(clientCtx client.Context, cfg config.JSONRPCConfig) indexer.CallTracer {
+	if !cfg.IndexInternalCalls {
+		return nil
+	}
+	return indexer.NewQueryCallTracer(clientCtx)
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -143,7 +341,7 @@ func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger ethlog.Logger) *rpcclient.
 			"address", tmRPCAddr+tmEndpoint,
 			"error", err,
 		)
-	} else if err := tmWsClient.OnStart(); err != nil {
+	} else if err := tmWsClient.Start(); err != nil {
 		logger.Error(
 			"Tendermint WS client could not start",
 			"address", tmRPCAddr+tmEndpoint,
@@ -153,3 +351,16 @@ func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger ethlog.Logger) *rpcclient.
 
 	return tmWsClient
 }
+
+// apiEnabled reports if the namespace is listed in the enabled JSON-RPC namespaces, the
+// namespaces may be given as a comma separated list.
+func apiEnabled(apis []string, namespace string) bool {
+	for _, api := range apis {
+		for _, name := range strings.Split(api, ",") {
+			if strings.TrimSpace(name) == namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
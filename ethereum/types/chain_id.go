@@ -53,3 +53,24 @@ func ParseChainID(chainID string) (*big.Int, error) {
 
 	return chainIDInt, nil
 }
+
+// ValidateChainIDsMatch returns an error if the chain identifier other doesn't match the
+// configured one, or they are parsed to different EIP155 chain ids.
+func ValidateChainIDsMatch(configured, other string) error {
+	if strings.TrimSpace(configured) != strings.TrimSpace(other) {
+		return errorsmod.Wrapf(ErrInvalidChainID, "chain-id '%s' doesn't match the configured chain-id '%s'", other, configured)
+	}
+
+	configuredID, err := ParseChainID(configured)
+	if err != nil {
+		return err
+	}
+	otherID, err := ParseChainID(other)
+	if err != nil {
+		return err
+	}
+	if configuredID.Cmp(otherID) != 0 {
+		return errorsmod.Wrapf(ErrInvalidChainID, "EIP155 chain id %s doesn't match the configured %s", otherID, configuredID)
+	}
+	return nil
+}
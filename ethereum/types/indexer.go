@@ -1,8 +1,9 @@
 package types
 
 import (
-	abci "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -10,10 +11,61 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
-	IndexBlock(*tmtypes.Block, []*abci.ResponseDeliverTx) error
+	// IndexBlock indexes the eth txs of the block, and the system txs in the begin and end
+	// block events of its results.
+	IndexBlock(*tmtypes.Block, *tmrpctypes.ResultBlockResults) error
 
 	// GetByTxHash returns nil if txs not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if txs not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer is implemented by the indexers maintaining the (address, topic0)
+// to blocks inverted index of the EVM logs.
+type EVMLogIndexer interface {
+	// LogIndexStart returns the first block covered by the log index, -1 if empty.
+	LogIndexStart() (int64, error)
+	// BlocksByLogTopic returns the ascending numbers of the blocks in [from, to]
+	// which contain a log of address with topic0.
+	BlocksByLogTopic(address common.Address, topic0 common.Hash, from, to int64) ([]int64, error)
+}
+
+// EVMBloomIndexer is implemented by the indexers maintaining the bit-transposed
+// blooms of the blocks by section, like the bloom bits of geth.
+type EVMBloomIndexer interface {
+	// BloomSections returns the number of the sections indexed.
+	BloomSections() (uint64, error)
+	// BlocksByBloomBits returns the ascending numbers of the blocks in [from, to] whose
+	// blooms match the addresses and the topics, and the last block covered by the index.
+	// It returns false if from is not covered.
+	BlocksByBloomBits(addresses []common.Address, topics [][]common.Hash, from, to int64) ([]int64, int64, bool, error)
+}
+
+// EVMAddressIndexer is implemented by the indexers maintaining the address to txs index of
+// the senders, the recipients and the internal calls of the eth txs.
+type EVMAddressIndexer interface {
+	// AddressIndexStart returns the first block covered by the address index, -1 if empty.
+	AddressIndexStart() (int64, error)
+	// TxsByAddress returns at most limit txs of the address before the (height, eth tx
+	// index) position, in the descending order of the position.
+	TxsByAddress(address common.Address, beforeHeight int64, beforeIndex int32, limit int) ([]AddressTx, error)
+}
+
+// AddressTx is an eth tx in the address index, at its block height and eth tx index.
+type AddressTx struct {
+	Height     int64
+	EthTxIndex int32
+	Hash       common.Hash
+}
+
+// SetTxMetadata sets the memo and the timeout height of the cosmos tx wrapping the eth tx, they're
+// signed by the sender of the eth tx, see ExtensionOptionsEthereumTx.
+func (r *TxResult) SetTxMetadata(tx sdk.Tx) {
+	if memoTx, ok := tx.(sdk.TxWithMemo); ok {
+		r.Memo = memoTx.GetMemo()
+	}
+	if timeoutTx, ok := tx.(interface{ GetTimeoutHeight() uint64 }); ok {
+		r.TimeoutHeight = timeoutTx.GetTimeoutHeight()
+	}
+}
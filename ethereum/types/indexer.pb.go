@@ -42,6 +42,14 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch txs.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// memo of the cosmos transaction, signed by the sender of the eth transaction
+	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout_height of the cosmos transaction, 0 if not set
+	TimeoutHeight uint64 `protobuf:"varint,9,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// system_tx is true if the eth transaction is a system transaction applied by the begin or
+	// end blockers, it's not in the block, tx_index is 0 and msg_index is its index in the
+	// system transactions of the block
+	SystemTx bool `protobuf:"varint,10,opt,name=system_tx,json=systemTx,proto3" json:"system_tx,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -84,26 +92,29 @@ func init() {
 func init() { proto.RegisterFile("artela/types/v1/indexer.proto", fileDescriptor_ee5a8796d27044a7) }
 
 var fileDescriptor_ee5a8796d27044a7 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0xbf, 0x6d, 0x9a, 0xdf, 0x02, 0x21, 0x02, 0xaa, 0x02, 0x88, 0x60, 0x31, 0x85,
-	0x81, 0x44, 0x15, 0x1b, 0x23, 0x0c, 0x88, 0xd5, 0x2a, 0x0b, 0x4b, 0xe4, 0x36, 0x17, 0x27, 0x22,
-	0xa9, 0xab, 0xf8, 0xa6, 0x84, 0x37, 0x60, 0xe4, 0x11, 0x78, 0x1c, 0xc6, 0x8e, 0x8c, 0xa8, 0x15,
-	0xef, 0x81, 0xe2, 0x44, 0x65, 0xf3, 0xd1, 0x77, 0x8e, 0xaf, 0xf4, 0xd1, 0x53, 0x51, 0x22, 0xe4,
-	0x22, 0xc2, 0xd7, 0x05, 0xe8, 0x68, 0x39, 0x8e, 0xb2, 0x79, 0x02, 0x35, 0x94, 0xe1, 0xa2, 0x54,
-	0xa8, 0xdc, 0xbd, 0x16, 0x87, 0x06, 0x87, 0xcb, 0xf1, 0xf1, 0xa1, 0x54, 0x52, 0x19, 0x16, 0x35,
-	0xaf, 0xb6, 0x76, 0xfe, 0x43, 0xa8, 0x33, 0xa9, 0x39, 0xe8, 0x2a, 0x47, 0x77, 0x44, 0xed, 0x14,
-	0x32, 0x99, 0xa2, 0x47, 0x18, 0x09, 0x7a, 0xbc, 0x4b, 0xee, 0x11, 0x75, 0xb0, 0x8e, 0xcd, 0xff,
-	0xde, 0x3f, 0x46, 0x82, 0x5d, 0x3e, 0xc4, 0xfa, 0xbe, 0x89, 0xee, 0x09, 0xfd, 0x5f, 0x68, 0xd9,
-	0xb1, 0x9e, 0x61, 0x4e, 0xa1, 0x65, 0x0b, 0x19, 0xdd, 0x01, 0x4c, 0xe3, 0xed, 0xb6, 0xcf, 0x48,
-	0x30, 0xe0, 0x14, 0x30, 0x9d, 0x74, 0xf3, 0x11, 0xb5, 0x9f, 0x44, 0x96, 0x43, 0xe2, 0x0d, 0x18,
-	0x09, 0x1c, 0xde, 0xa5, 0xe6, 0xa2, 0x14, 0x3a, 0xae, 0x34, 0x24, 0x9e, 0xcd, 0x48, 0xd0, 0xe7,
-	0x43, 0x29, 0xf4, 0x83, 0x86, 0xc4, 0x0d, 0xe9, 0xc1, 0xac, 0x2a, 0xaa, 0x5c, 0x60, 0xb6, 0x84,
-	0x78, 0xdb, 0x1a, 0x9a, 0xd6, 0xfe, 0x1f, 0xba, 0x6b, 0xfb, 0xd7, 0xfd, 0xb7, 0x8f, 0x33, 0xeb,
-	0xe6, 0xf6, 0x73, 0xed, 0x93, 0xd5, 0xda, 0x27, 0xdf, 0x6b, 0x9f, 0xbc, 0x6f, 0x7c, 0x6b, 0xb5,
-	0xf1, 0xad, 0xaf, 0x8d, 0x6f, 0x3d, 0x5e, 0xc8, 0x0c, 0xd3, 0x6a, 0x1a, 0xce, 0x54, 0x11, 0xb5,
-	0xce, 0x2e, 0xe7, 0x80, 0x2f, 0xaa, 0x7c, 0xee, 0x62, 0xe3, 0xd6, 0x58, 0x9c, 0xda, 0xc6, 0xd9,
-	0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x93, 0xb8, 0x29, 0x39, 0x7b, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xc1, 0x6e, 0x9b, 0x40,
+	0x10, 0x86, 0x59, 0x1b, 0x63, 0x58, 0xd5, 0xad, 0x4a, 0x2b, 0x8b, 0xd6, 0x2a, 0x45, 0x95, 0x2a,
+	0xd1, 0x43, 0x41, 0x56, 0x6f, 0x3d, 0xb6, 0x87, 0x24, 0x57, 0xe4, 0x5c, 0x72, 0x41, 0xd8, 0x4c,
+	0x16, 0x14, 0xd6, 0x6b, 0xb1, 0x83, 0x83, 0xdf, 0x20, 0xc7, 0x3c, 0x42, 0x5e, 0x24, 0xf7, 0x1c,
+	0x7d, 0xcc, 0x31, 0xb2, 0x5f, 0x24, 0x62, 0x59, 0x39, 0xb7, 0xf9, 0xe7, 0xfb, 0x7f, 0xd8, 0xd1,
+	0x4f, 0xbf, 0x65, 0x35, 0x42, 0x95, 0xc5, 0xb8, 0xdb, 0x80, 0x8c, 0xb7, 0xf3, 0xb8, 0x5c, 0xe7,
+	0xd0, 0x42, 0x1d, 0x6d, 0x6a, 0x81, 0xc2, 0xfd, 0xd0, 0xe3, 0x48, 0xe1, 0x68, 0x3b, 0xff, 0xfa,
+	0x99, 0x09, 0x26, 0x14, 0x8b, 0xbb, 0xa9, 0xb7, 0xfd, 0x78, 0x1c, 0x50, 0x7b, 0xd1, 0x26, 0x20,
+	0x9b, 0x0a, 0xdd, 0x29, 0xb5, 0x0a, 0x28, 0x59, 0x81, 0x1e, 0x09, 0x48, 0x38, 0x4c, 0xb4, 0x72,
+	0xbf, 0x50, 0x1b, 0xdb, 0x54, 0x7d, 0xdf, 0x1b, 0x04, 0x24, 0x9c, 0x24, 0x63, 0x6c, 0x2f, 0x3a,
+	0xe9, 0xce, 0xa8, 0xc3, 0x25, 0xd3, 0x6c, 0xa8, 0x98, 0xcd, 0x25, 0xeb, 0x61, 0x40, 0xdf, 0x01,
+	0x16, 0xe9, 0x29, 0x6b, 0x06, 0x24, 0x1c, 0x25, 0x14, 0xb0, 0x58, 0xe8, 0xf8, 0x94, 0x5a, 0xd7,
+	0x59, 0x59, 0x41, 0xee, 0x8d, 0x02, 0x12, 0xda, 0x89, 0x56, 0xdd, 0x1f, 0x59, 0x26, 0xd3, 0x46,
+	0x42, 0xee, 0x59, 0x01, 0x09, 0xcd, 0x64, 0xcc, 0x32, 0x79, 0x29, 0x21, 0x77, 0x23, 0xfa, 0x69,
+	0xd5, 0xf0, 0xa6, 0xca, 0xb0, 0xdc, 0x42, 0x7a, 0x72, 0x8d, 0x95, 0xeb, 0xe3, 0x1b, 0x3a, 0xd3,
+	0x7e, 0x97, 0x9a, 0x1c, 0xb8, 0xf0, 0xec, 0x80, 0x84, 0x4e, 0xa2, 0x66, 0xf7, 0x27, 0x7d, 0x8f,
+	0x25, 0x07, 0xd1, 0x60, 0xaa, 0x0f, 0x76, 0x54, 0x7c, 0xa2, 0xb7, 0xe7, 0xfd, 0xdd, 0x33, 0xea,
+	0xc8, 0x9d, 0x44, 0xe0, 0x29, 0xb6, 0x1e, 0x55, 0x0f, 0xb4, 0xfb, 0xc5, 0xa2, 0xfd, 0x6b, 0xde,
+	0x3d, 0x7c, 0x37, 0xfe, 0xfd, 0x7f, 0x3a, 0xf8, 0x64, 0x7f, 0xf0, 0xc9, 0xcb, 0xc1, 0x27, 0xf7,
+	0x47, 0xdf, 0xd8, 0x1f, 0x7d, 0xe3, 0xf9, 0xe8, 0x1b, 0x57, 0xbf, 0x58, 0x89, 0x45, 0xb3, 0x8c,
+	0x56, 0x82, 0xc7, 0x7d, 0x17, 0xbf, 0xd7, 0x80, 0xb7, 0xa2, 0xbe, 0xd1, 0xb2, 0xeb, 0x4c, 0xb5,
+	0xb3, 0xb4, 0x54, 0x17, 0x7f, 0x5e, 0x07, 0x00, 0x24, 0xef, 0xbb, 0x09, 0xd3, 0x01, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +137,28 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SystemTx {
+		i--
+		if m.SystemTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintIndexer(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -207,6 +240,16 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovIndexer(uint64(m.TimeoutHeight))
+	}
+	if m.SystemTx {
+		n += 2
+	}
 	return n
 }
 
@@ -379,6 +422,77 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SystemTx = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
@@ -9,15 +9,23 @@ number of validators as well as account funds and even custom genesis states.
 
 When creating a test network, a series of Validator objects are returned. Each
 Validator object has useful information such as their address and public key. A
-Validator will also provide its RPC, P2P, and API addresses that can be useful
-for integration testing. In addition, a Tendermint local RPC client is also provided
-which can be handy for making direct RPC calls to Tendermint.
+Validator will also provide its RPC, P2P, JSON-RPC and API addresses that can be
+useful for integration testing. In addition, a Tendermint local RPC client and an
+ethereum JSON-RPC client are also provided which can be handy for making direct
+calls to the validator.
 
 Note, due to limitations in concurrency and the design of the RPC layer in
-Tendermint, only the first Validator object will have an RPC and API client
-exposed. Due to this exact same limitation, only a single test network can exist
-at a time. A caller must be certain it calls Cleanup after it no longer needs
-the network.
+Tendermint, only the first Validator object will have an API and gRPC server
+exposed, and the Tendermint RPC of every Validator is served from the state of
+a single node of the process. Due to this exact same limitation, only a single
+test network can exist at a time. A caller must be certain it calls Cleanup after
+it no longer needs the network.
+
+The aspect runtime keeps process-wide state, so a network executing EVM
+transactions must run a single validator, the networks of many validators are
+suitable for the consensus and RPC tests. The Validator helpers SendEthTx,
+DeployContract, DeployAspect and BindAspect sign the transactions with the key
+of the validator and wait for their receipts via its JSON-RPC.
 
 A typical testing flow might look like the following:
 
@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+)
+
+// receiptPollInterval is the interval of polling the receipts of the sent txs
+const receiptPollInterval = 500 * time.Millisecond
+
+// ErrTxFailed is returned for the txs executed with a failed status.
+var ErrTxFailed = errors.New("transaction failed")
+
+// AspectProperty is a key-value property of an aspect.
+type AspectProperty struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// EthAddress returns the ethereum address of the validator's account.
+func (v *Validator) EthAddress() common.Address {
+	return common.BytesToAddress(v.Address.Bytes())
+}
+
+// SendEthTx signs the ethereum tx with the validator's key, sends it via the validator's
+// JSON-RPC and waits for its receipt. The gas limit is estimated if it's zero. It returns
+// the receipt along with ErrTxFailed if the tx failed.
+func (v *Validator) SendEthTx(ctx context.Context, to *common.Address, value *big.Int, data []byte, gas uint64) (*ethtypes.Transaction, *ethtypes.Receipt, error) {
+	if v.JSONRPCClient == nil {
+		return nil, nil, fmt.Errorf("validator %s doesn't expose JSON-RPC", v.Moniker)
+	}
+	client := v.JSONRPCClient
+	from := v.EthAddress()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, nil, err
+	}
+	gasPrice, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+	if gas == 0 {
+		gas, err = client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: to, Value: value, Data: data})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       to,
+		Value:    value,
+		Data:     data,
+	})
+	sig, _, err := v.ClientCtx.Keyring.Sign(v.Moniker, signer.Hash(tx).Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	tx, err = tx.WithSignature(signer, sig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		return nil, nil, err
+	}
+
+	receipt, err := v.WaitForReceipt(ctx, tx.Hash())
+	if err != nil {
+		return tx, nil, err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return tx, receipt, fmt.Errorf("%w: %s", ErrTxFailed, tx.Hash().Hex())
+	}
+	return tx, receipt, nil
+}
+
+// WaitForReceipt polls the validator's JSON-RPC for the receipt of the tx until the
+// context is done.
+func (v *Validator) WaitForReceipt(ctx context.Context, hash common.Hash) (*ethtypes.Receipt, error) {
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := v.JSONRPCClient.TransactionReceipt(ctx, hash)
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeployContract deploys the contract of the creation code, which includes the encoded
+// constructor arguments if any, and returns its address.
+func (v *Validator) DeployContract(ctx context.Context, code []byte) (common.Address, *ethtypes.Receipt, error) {
+	_, receipt, err := v.SendEthTx(ctx, nil, nil, code, 0)
+	if err != nil {
+		return common.Address{}, receipt, err
+	}
+	return receipt.ContractAddress, receipt, nil
+}
+
+// DeployAspect deploys the aspect of the wasm code via the aspect system contract and
+// returns the aspect id.
+func (v *Validator) DeployAspect(ctx context.Context, code []byte, properties []AspectProperty, joinPoints *big.Int, gas uint64) (common.Address, *ethtypes.Receipt, error) {
+	if properties == nil {
+		properties = []AspectProperty{}
+	}
+	if joinPoints == nil {
+		joinPoints = new(big.Int)
+	}
+	data, err := artelatypes.PackMethod("deploy", code, properties, v.EthAddress(), []byte{}, joinPoints)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	tx, receipt, err := v.SendEthTx(ctx, aspectSystemContract(), nil, data, gas)
+	if err != nil {
+		return common.Address{}, receipt, err
+	}
+	return crypto.CreateAddress(v.EthAddress(), tx.Nonce()), receipt, nil
+}
+
+// BindAspect binds the version of the aspect to the contract, or to the validator's
+// account if the contract is the validator's address.
+func (v *Validator) BindAspect(ctx context.Context, aspectID common.Address, version uint64, contract common.Address, priority int8, gas uint64) (*ethtypes.Receipt, error) {
+	data, err := artelatypes.PackMethod("bind", aspectID, new(big.Int).SetUint64(version), contract, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	_, receipt, err := v.SendEthTx(ctx, aspectSystemContract(), nil, data, gas)
+	return receipt, err
+}
+
+func aspectSystemContract() *common.Address {
+	addr := common.HexToAddress(asptypes.ARTELA_ADDR)
+	return &addr
+}
@@ -136,6 +136,7 @@ func NewAppConstructor(encodingCfg params.EncodingConfig) AppConstructor {
 			simtestutil.EmptyAppOptions{},
 			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
 			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
+			baseapp.SetChainID(val.ClientCtx.ChainID),
 		)
 	}
 }
@@ -150,7 +151,7 @@ type (
 	// may only be one test network running at a time. Thus, any caller must be
 	// sure to Cleanup after testing is finished in order to allow other tests
 	// to create networks. In addition, only the first validator will have a valid
-	// RPC and API server/client.
+	// API and gRPC server/client.
 	Network struct {
 		Logger     Logger
 		BaseDir    string
@@ -178,16 +179,11 @@ type (
 		RPCClient     tmclient.Client
 		JSONRPCClient *ethclient.Client
 
-		tmNode      *node.Node
-		api         *api.Server
-		grpc        *grpc.Server
-		grpcWeb     *http.Server
-		jsonrpc     *http.Server
-		jsonrpcDone chan struct{}
-
+		tmNode        *node.Node
+		api           *api.Server
+		grpc          *grpc.Server
+		grpcWeb       *http.Server
 		artelaService *rpc.ArtelaService
-		// nolint:unused
-		artelaServiceDone chan struct{}
 	}
 )
 
@@ -264,13 +260,12 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		tmCfg := ctx.Config
 		tmCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
 
-		// Only allow the first validator to expose an RPC, API and gRPC
-		// server/client due to Tendermint in-txs constraints.
+		// Only allow the first validator to expose an API and gRPC server/client
+		// due to Tendermint in-txs constraints, every validator exposes its RPC and
+		// JSON-RPC.
 		apiAddr := ""
-		tmCfg.RPC.ListenAddress = ""
 		appCfg.GRPC.Enable = false
 		appCfg.GRPCWeb.Enable = false
-		appCfg.JSONRPC.Enable = false
 		apiListenAddr := ""
 		if i == 0 {
 			if cfg.APIAddress != "" {
@@ -290,16 +285,6 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			apiAddr = fmt.Sprintf("http://%s:%s", apiURL.Hostname(), apiURL.Port())
 
-			if cfg.RPCAddress != "" {
-				tmCfg.RPC.ListenAddress = cfg.RPCAddress
-			} else {
-				rpcAddr, _, err := server.FreeTCPAddr()
-				if err != nil {
-					return nil, err
-				}
-				tmCfg.RPC.ListenAddress = rpcAddr
-			}
-
 			if cfg.GRPCAddress != "" {
 				appCfg.GRPC.Address = cfg.GRPCAddress
 			} else {
@@ -317,19 +302,34 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			appCfg.GRPCWeb.Address = fmt.Sprintf("0.0.0.0:%s", grpcWebPort)
 			appCfg.GRPCWeb.Enable = true
+		}
 
-			if cfg.JSONRPCAddress != "" {
-				appCfg.JSONRPC.Address = cfg.JSONRPCAddress
-			} else {
-				_, jsonRPCPort, err := server.FreeTCPAddr()
-				if err != nil {
-					return nil, err
-				}
-				appCfg.JSONRPC.Address = fmt.Sprintf("0.0.0.0:%s", jsonRPCPort)
+		if i == 0 && cfg.RPCAddress != "" {
+			tmCfg.RPC.ListenAddress = cfg.RPCAddress
+		} else {
+			rpcAddr, _, err := server.FreeTCPAddr()
+			if err != nil {
+				return nil, err
+			}
+			tmCfg.RPC.ListenAddress = rpcAddr
+		}
+
+		if i == 0 && cfg.JSONRPCAddress != "" {
+			appCfg.JSONRPC.Address = cfg.JSONRPCAddress
+		} else {
+			_, jsonRPCPort, err := server.FreeTCPAddr()
+			if err != nil {
+				return nil, err
 			}
-			appCfg.JSONRPC.Enable = true
-			appCfg.JSONRPC.API = config.GetAPINamespaces()
+			appCfg.JSONRPC.Address = fmt.Sprintf("127.0.0.1:%s", jsonRPCPort)
+		}
+		_, wsPort, err := server.FreeTCPAddr()
+		if err != nil {
+			return nil, err
 		}
+		appCfg.JSONRPC.WsAddress = fmt.Sprintf("127.0.0.1:%s", wsPort)
+		appCfg.JSONRPC.Enable = true
+		appCfg.JSONRPC.API = config.GetAPINamespaces()
 
 		logger := log.NewNopLogger()
 		if cfg.EnableTMLogging {
@@ -344,7 +344,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		clientDir := filepath.Join(network.BaseDir, nodeDirName, "artelacli")
 		gentxsDir := filepath.Join(network.BaseDir, "gentxs")
 
-		err := os.MkdirAll(filepath.Join(nodeDir, "config"), 0o750)
+		err = os.MkdirAll(filepath.Join(nodeDir, "config"), 0o750)
 		if err != nil {
 			return nil, err
 		}
@@ -623,6 +623,16 @@ func (n *Network) Cleanup() {
 	n.Logger.Log("cleaning up test network...")
 
 	for _, v := range n.Validators {
+		if v.JSONRPCClient != nil {
+			v.JSONRPCClient.Close()
+		}
+
+		if v.artelaService != nil {
+			if err := v.artelaService.Shutdown(); err != nil {
+				v.tmNode.Logger.Error("JSON-RPC server shutdown produced a warning", "error", err.Error())
+			}
+		}
+
 		if v.tmNode != nil && v.tmNode.IsRunning() {
 			_ = v.tmNode.Stop()
 		}
@@ -638,20 +648,6 @@ func (n *Network) Cleanup() {
 			}
 		}
 
-		if v.jsonrpc != nil {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-
-			if err := v.jsonrpc.Shutdown(shutdownCtx); err != nil {
-				v.tmNode.Logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				v.tmNode.Logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-v.jsonrpcDone:
-				}
-			}
-		}
 	}
 
 	if n.Config.CleanupDir {
@@ -4,14 +4,20 @@
 package network_test
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/artela-network/artela/ethereum/server/config"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/artela-network/artela/testutil/network"
@@ -61,6 +67,42 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 	s.Require().GreaterOrEqual(latestHeight, h)
 }
 
+func (s *IntegrationTestSuite) TestNetwork_DeployContract() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// creation code of a contract whose runtime code returns 42
+	code := common.FromHex("0x69602a60005260206000f3600052600a6016f3")
+	contract, receipt, err := s.network.Validators[0].DeployContract(ctx, code)
+	s.Require().NoError(err)
+	s.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	result, err := s.network.Validators[0].JSONRPCClient.CallContract(ctx, ethereum.CallMsg{To: &contract}, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(42), new(big.Int).SetBytes(result))
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func TestNetwork_MultiValidatorJSONRPC(t *testing.T) {
+	cfg := artelanetwork.DefaultConfig()
+	cfg.NumValidators = 2
+
+	net, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer net.Cleanup()
+
+	_, err = net.WaitForHeight(2)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	for _, val := range net.Validators {
+		require.NotNil(t, val.JSONRPCClient, val.Moniker)
+		number, err := val.JSONRPCClient.BlockNumber(ctx)
+		require.NoError(t, err, val.Moniker)
+		require.GreaterOrEqual(t, number, uint64(2), val.Moniker)
+	}
+}
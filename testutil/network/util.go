@@ -2,11 +2,11 @@ package network
 
 import (
 	"encoding/json"
+	"fmt"
 	"path/filepath"
-	"strings"
 	"time"
 
-	rpc2 "github.com/artela-network/artela/ethereum/rpc"
+	ethserver "github.com/artela-network/artela/ethereum/server"
 	"github.com/artela-network/artela/x/evm/txs/support"
 
 	tmos "github.com/cometbft/cometbft/libs/os"
@@ -17,8 +17,7 @@ import (
 	"github.com/cometbft/cometbft/rpc/client/local"
 	"github.com/cometbft/cometbft/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
-	"github.com/ethereum/go-ethereum/accounts"
-	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/cosmos/cosmos-sdk/server/api"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
@@ -126,45 +125,35 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	if val.AppConfig.JSONRPC.Enable && val.AppConfig.JSONRPC.Address != "" {
-		/* TODO artela rpc server
 		if val.Ctx == nil || val.Ctx.Viper == nil {
 			return fmt.Errorf("validator %s context is nil", val.Moniker)
 		}
 
+		clientCtx := val.ClientCtx.WithClient(val.RPCClient)
 		tmEndpoint := "/websocket"
-		tmRPCAddr := fmt.Sprintf("tcp://%s", val.AppConfig.GRPC.Address)
-
-		val.jsonrpc, val.jsonrpcDone, err = server.StartJSONRPC(val.Ctx, val.ClientCtx, tmRPCAddr, tmEndpoint, val.AppConfig, nil)
+		val.artelaService, err = ethserver.CreateJSONRPC(val.Ctx, clientCtx, val.RPCAddress, tmEndpoint, val.AppConfig, nil, nil, nil, nil, nil, nil)
 		if err != nil {
 			return err
 		}
 
-		address := fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address)
-
-		val.JSONRPCClient, err = ethclient.Dial(address)
-		if err != nil {
-			return fmt.Errorf("failed to dial JSON-RPC at %s: %w", val.AppConfig.JSONRPC.Address, err)
-		}*/
-		cfg := rpc2.DefaultConfig()
-		nodeCfg := rpc2.DefaultGethNodeConfig()
-
-		httpEndpoint := strings.Split(val.AppConfig.JSONRPC.Address, ":")
-		if len(httpEndpoint) > 0 {
-			nodeCfg.HTTPHost = strings.Split(val.AppConfig.JSONRPC.Address, ":")[0]
+		if notifier, ok := app.(ethserver.ProposalNotifier); ok {
+			notifier.SetProposalListener(val.artelaService.NotifyProposal)
 		}
-		if len(httpEndpoint) > 1 {
-			nodeCfg.HTTPHost = strings.Split(val.AppConfig.JSONRPC.Address, ":")[1]
+		if notifier, ok := app.(ethserver.CheckTxNotifier); ok {
+			notifier.SetCheckTxListener(val.artelaService.NotifyCheckedTx)
+		}
+		if provider, ok := app.(ethserver.TraceSnapshotProvider); ok {
+			val.artelaService.SetTraceSnapshots(provider.TraceSnapshots())
 		}
 
-		node, err := rpc2.NewNode(nodeCfg)
-		if err != nil {
-			panic(err)
+		if err := val.artelaService.Start(); err != nil {
+			return err
 		}
 
-		val.artelaService = rpc2.NewArtelaService(val.Ctx, val.ClientCtx, nil, cfg, node, accounts.NewManager(&accounts.Config{InsecureUnlockAllowed: false}), log.Root())
-		startErr := val.artelaService.Start()
-		if startErr != nil {
-			return startErr
+		address := fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address)
+		val.JSONRPCClient, err = ethclient.Dial(address)
+		if err != nil {
+			return fmt.Errorf("failed to dial JSON-RPC at %s: %w", val.AppConfig.JSONRPC.Address, err)
 		}
 	}
 
@@ -233,8 +222,7 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	var govGenState govv1.GenesisState
 	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[govtypes.ModuleName], &govGenState)
 
-	// nolint:staticcheck
-	govGenState.DepositParams.MinDeposit[0].Denom = cfg.BondDenom
+	govGenState.Params.MinDeposit[0].Denom = cfg.BondDenom
 	cfg.GenesisState[govtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&govGenState)
 
 	/* TODO artela
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"encoding/json"
+	"errors"
 	"math/big"
 
 	"github.com/artela-network/artela/ethereum/server/config"
@@ -152,6 +153,9 @@ func GasLimit(ctx sdk.Context, from common.Address, data []byte, queryClientEvm
 		if err != nil {
 			return gas, err
 		}
+		if res.Failed() {
+			return gas, errors.New(res.VmError)
+		}
 		gas = res.Gas
 	}
 	return gas, nil
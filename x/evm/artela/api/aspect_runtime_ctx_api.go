@@ -29,9 +29,22 @@ var (
 	}
 )
 
+var (
+	// historyCtxPoints are the join points the history contexts are available at, the
+	// receipts of the earlier txs of the block are available at the tx execution only
+	historyCtxPoints = hashset.New(
+		asptypes.PRE_TX_EXECUTE_METHOD,
+		asptypes.POST_TX_EXECUTE_METHOD,
+		asptypes.PRE_CONTRACT_CALL_METHOD,
+		asptypes.POST_CONTRACT_CALL_METHOD,
+	)
+	historyHeaderKeys = hashset.New(toInterfaces(datactx.HistoryHeaderKeys)...)
+)
+
 type aspectRuntimeContextHostAPI struct {
 	aspectRuntimeContext *types.AspectRuntimeContext
 	execMap              map[string]datactx.ContextLoader
+	historyCtx           *datactx.HistoryContext
 }
 
 func newAspectRuntimeContextHostAPI(aspectRuntimeContext *types.AspectRuntimeContext) *aspectRuntimeContextHostAPI {
@@ -41,6 +54,7 @@ func newAspectRuntimeContextHostAPI(aspectRuntimeContext *types.AspectRuntimeCon
 	}
 
 	instance.Register()
+	instance.historyCtx = datactx.NewHistoryContext(aspectRuntimeContext, evmKeeper)
 	return instance
 }
 
@@ -139,26 +153,65 @@ func (a *aspectRuntimeContextHostAPI) Register() {
 	a.execMap[aspctx.IsCall] = func(ctx *asptypes.RunnerContext) ([]byte, error) {
 		// verify tx can only be triggered when it is a transaction, so we can safely assume that
 		// if the point is verify-tx, it must be a transaction.
-		// otherwise if the request is not being committed, we can assume that it is a call.
+		// otherwise if the request is neither being committed nor estimating the gas of a
+		// transaction, we can assume that it is a call.
+		ethTxCtx := a.aspectRuntimeContext.EthTxContext()
 		result := ctx.Point != string(asptypes.VERIFY_TX) &&
-			!a.aspectRuntimeContext.EthTxContext().Commit()
+			!ethTxCtx.Commit() && !ethTxCtx.Estimate()
 		return proto.Marshal(&asptypes.BoolData{Data: &result})
 	}
 }
 
 func (a *aspectRuntimeContextHostAPI) Get(ctx *asptypes.RunnerContext, key string) []byte {
-	joinPointCtxKeyConstraints, ok := ctxKeyConstraints[asptypes.PointCut(ctx.Point)]
-	if !ok || !joinPointCtxKeyConstraints.Contains(key) {
+	loader, ok := a.loader(ctx, key)
+	if !ok {
 		return []byte{}
 	}
 
-	res, err := a.execMap[key](ctx)
+	res, err := loader(ctx)
 	if err != nil {
 		panic(err)
 	}
 	return res
 }
 
+// loader returns the loader of the key at the join point of the runner context, false if
+// the key isn't available at the join point.
+func (a *aspectRuntimeContextHostAPI) loader(ctx *asptypes.RunnerContext, key string) (datactx.ContextLoader, bool) {
+	point := asptypes.PointCut(ctx.Point)
+	joinPointCtxKeyConstraints, ok := ctxKeyConstraints[point]
+	if !ok {
+		return nil, false
+	}
+	if joinPointCtxKeyConstraints.Contains(key) {
+		return a.execMap[key], true
+	}
+
+	// the history contexts are parameterized with the block number or the tx index
+	if !historyCtxPoints.Contains(point) {
+		return nil, false
+	}
+	historyKey, param, ok := datactx.SplitParamKey(key)
+	switch {
+	case !ok:
+		return nil, false
+	case historyHeaderKeys.Contains(historyKey):
+		return a.historyCtx.ValueLoader(historyKey, param), true
+	case historyKey == datactx.BlockReceipt:
+		return a.historyCtx.ReceiptLoader(param), true
+	default:
+		return nil, false
+	}
+}
+
+func toInterfaces(keys []string) []interface{} {
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = key
+	}
+	return values
+}
+
 func GetAspectRuntimeContextHostInstance(ctx context.Context) (asptypes.RuntimeContextHostAPI, error) {
 	aspectCtx, ok := ctx.(*types.AspectRuntimeContext)
 	if !ok {
@@ -30,6 +30,9 @@ func (a *aspectStateHostAPI) Get(ctx *asptypes.RunnerContext, key string) []byte
 }
 
 func (a *aspectStateHostAPI) Set(ctx *asptypes.RunnerContext, key string, value []byte) {
+	if a.aspectRuntimeContext.ReadOnly() {
+		panic(types.ErrReadOnlyContext.Error())
+	}
 	if !setConstraints.Contains(asptypes.PointCut(ctx.Point)) {
 		panic("cannot set aspect state in current join point")
 	}
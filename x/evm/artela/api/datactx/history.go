@@ -0,0 +1,152 @@
+package datactx
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	artelatypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+)
+
+// keys of the history contexts, they are parameterized with the block number or the tx
+// index as the last part, e.g. block.history.header.hash.100 or block.receipt.2.
+const (
+	HistoryHeaderHash             = "block.history.header.hash"
+	HistoryHeaderParentHash       = "block.history.header.parentHash"
+	HistoryHeaderMiner            = "block.history.header.miner"
+	HistoryHeaderTransactionsRoot = "block.history.header.transactionsRoot"
+	HistoryHeaderTimestamp        = "block.history.header.timestamp"
+	BlockReceipt                  = "block.receipt"
+)
+
+// HistoryHeaderKeys are the keys of the headers of the recent blocks.
+var HistoryHeaderKeys = []string{
+	HistoryHeaderHash,
+	HistoryHeaderParentHash,
+	HistoryHeaderMiner,
+	HistoryHeaderTransactionsRoot,
+	HistoryHeaderTimestamp,
+}
+
+// SplitParamKey splits the parameterized key into the key and the parameter, false if the
+// key isn't parameterized.
+func SplitParamKey(key string) (string, uint64, bool) {
+	i := strings.LastIndex(key, ".")
+	if i < 0 {
+		return "", 0, false
+	}
+	param, err := strconv.ParseUint(key[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return key[:i], param, true
+}
+
+type HistoryHeaderFieldLoader func(header *ethereum.Header, hash common.Hash) proto.Message
+
+// HistoryContext loads the headers of the blocks of the BLOCKHASH window and the receipts
+// of the txs delivered earlier in the current block, which are deterministic.
+type HistoryContext struct {
+	headerLoaders map[string]HistoryHeaderFieldLoader
+	ctx           *types.AspectRuntimeContext
+	keeper        EVMKeeper
+}
+
+func NewHistoryContext(ctx *types.AspectRuntimeContext, keeper EVMKeeper) *HistoryContext {
+	historyCtx := &HistoryContext{
+		headerLoaders: make(map[string]HistoryHeaderFieldLoader),
+		ctx:           ctx,
+		keeper:        keeper,
+	}
+	historyCtx.registerLoaders()
+	return historyCtx
+}
+
+func (c *HistoryContext) registerLoaders() {
+	loaders := c.headerLoaders
+	loaders[HistoryHeaderHash] = func(header *ethereum.Header, hash common.Hash) proto.Message {
+		return &artelatypes.BytesData{Data: hash.Bytes()}
+	}
+	loaders[HistoryHeaderParentHash] = func(header *ethereum.Header, hash common.Hash) proto.Message {
+		return &artelatypes.BytesData{Data: header.ParentHash.Bytes()}
+	}
+	loaders[HistoryHeaderMiner] = func(header *ethereum.Header, hash common.Hash) proto.Message {
+		return &artelatypes.BytesData{Data: header.Coinbase.Bytes()}
+	}
+	loaders[HistoryHeaderTransactionsRoot] = func(header *ethereum.Header, hash common.Hash) proto.Message {
+		return &artelatypes.BytesData{Data: header.TxHash.Bytes()}
+	}
+	loaders[HistoryHeaderTimestamp] = func(header *ethereum.Header, hash common.Hash) proto.Message {
+		time := header.Time
+		return &artelatypes.UintData{Data: &time}
+	}
+}
+
+// ValueLoader returns the loader of the header field of the given key, the parameter is the
+// block number. It loads nothing if the block is out of the window.
+func (c *HistoryContext) ValueLoader(key string, number uint64) ContextLoader {
+	return func(ctx *artelatypes.RunnerContext) ([]byte, error) {
+		if ctx == nil {
+			return nil, errors.New("aspect context error, missing important information")
+		}
+		loader, ok := c.headerLoaders[key]
+		if !ok || number > math.MaxInt64 {
+			return []byte{}, nil
+		}
+		header, hash, found := c.keeper.RecentBlockHeader(c.ctx.CosmosContext(), int64(number))
+		if !found {
+			return []byte{}, nil
+		}
+		return proto.Marshal(loader(header, hash))
+	}
+}
+
+// ReceiptLoader returns the loader of the receipt of the tx of the given index delivered
+// earlier in the block. It loads nothing if there's no such receipt.
+func (c *HistoryContext) ReceiptLoader(index uint64) ContextLoader {
+	return func(ctx *artelatypes.RunnerContext) ([]byte, error) {
+		if ctx == nil {
+			return nil, errors.New("aspect context error, missing important information")
+		}
+		blockCtx := c.ctx.EthBlockContext()
+		if blockCtx == nil {
+			return []byte{}, nil
+		}
+		receipt := blockCtx.Receipt(index)
+		if receipt == nil {
+			return []byte{}, nil
+		}
+
+		logs := make([]*artelatypes.EthLog, 0, len(receipt.Logs))
+		for _, log := range receipt.Logs {
+			topics := make([][]byte, 0, len(log.Topics))
+			for _, topic := range log.Topics {
+				topics = append(topics, topic.Bytes())
+			}
+			logIndex := uint64(log.Index)
+			logs = append(logs, &artelatypes.EthLog{
+				Address: log.Address.Bytes(),
+				Topics:  topics,
+				Data:    log.Data,
+				Index:   &logIndex,
+			})
+		}
+		effectiveGasPrice := []byte{}
+		if receipt.EffectiveGasPrice != nil {
+			effectiveGasPrice = receipt.EffectiveGasPrice.Bytes()
+		}
+		return proto.Marshal(&artelatypes.EthReceipt{
+			Status:            &receipt.Status,
+			CumulativeGasUsed: &receipt.CumulativeGasUsed,
+			LogsBloom:         receipt.Bloom.Bytes(),
+			EffectiveGasPrice: effectiveGasPrice,
+			Logs:              logs,
+		})
+	}
+}
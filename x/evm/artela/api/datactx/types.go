@@ -1,12 +1,17 @@
 package datactx
 
 import (
-	artelatypes "github.com/artela-network/aspect-core/types"
 	"math/big"
+
+	artelatypes "github.com/artela-network/aspect-core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
 )
 
 type ContextLoader func(ctx *artelatypes.RunnerContext) ([]byte, error)
 
 type EVMKeeper interface {
 	ChainID() *big.Int
+	RecentBlockHeader(ctx sdk.Context, height int64) (*ethereum.Header, common.Hash, bool)
 }
@@ -81,8 +81,13 @@ func (e *evmHostApi) StaticCall(ctx *asptypes.RunnerContext, request *asptypes.S
 }
 
 func (e *evmHostApi) JITCall(ctx *asptypes.RunnerContext, request *asptypes.JitInherentRequest) *asptypes.JitInherentResponse {
-	// determine jit call stage
 	defBool := false
+	if e.aspectCtx.ReadOnly() {
+		msg := artelatypes.ErrReadOnlyContext.Error()
+		return &asptypes.JitInherentResponse{Success: &defBool, ErrorMsg: &msg}
+	}
+
+	// determine jit call stage
 	var stage integration.JoinPointStage
 	switch asptypes.PointCut(ctx.Point) {
 	case asptypes.PRE_TX_EXECUTE_METHOD, asptypes.POST_TX_EXECUTE_METHOD,
@@ -6,6 +6,7 @@ import (
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"math/big"
 )
 
@@ -24,6 +25,7 @@ type EVMKeeper interface {
 	) *vm.EVM
 	EVMConfig(ctx cosmos.Context, proposerAddress cosmos.ConsAddress, chainID *big.Int) (*states.EVMConfig, error)
 	ChainID() *big.Int
+	RecentBlockHeader(ctx cosmos.Context, height int64) (*ethtypes.Header, common.Hash, bool)
 	GetAccount(ctx cosmos.Context, addr common.Address) *states.StateAccount
 	GetState(ctx cosmos.Context, addr common.Address, key common.Hash) common.Hash
 	GetCode(ctx cosmos.Context, codeHash common.Hash) []byte
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"testing"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+)
+
+func TestBlockReceiptContext(t *testing.T) {
+	aspectCtx := types.NewAspectRuntimeContext()
+	blockCtx := types.NewEthBlockContextFromHeight(10)
+	blockCtx.AddReceipt(&ethereum.Receipt{
+		Status:            ethereum.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		TransactionIndex:  0,
+	})
+	aspectCtx.SetEthBlockContext(blockCtx)
+
+	ctxAPI, err := GetAspectRuntimeContextHostInstance(aspectCtx)
+	require.NoError(t, err)
+
+	runnerCtx := &asptypes.RunnerContext{Point: string(asptypes.PRE_TX_EXECUTE_METHOD)}
+	receipt := &asptypes.EthReceipt{}
+	require.NoError(t, proto.Unmarshal(ctxAPI.Get(runnerCtx, "block.receipt.0"), receipt))
+	require.Equal(t, ethereum.ReceiptStatusSuccessful, receipt.GetStatus())
+	require.Equal(t, uint64(21000), receipt.GetCumulativeGasUsed())
+
+	require.Empty(t, ctxAPI.Get(runnerCtx, "block.receipt.1"))
+	require.Empty(t, ctxAPI.Get(runnerCtx, "block.receipt.x"))
+
+	// the receipts aren't available at the tx verification
+	runnerCtx.Point = string(asptypes.VERIFY_TX)
+	require.Empty(t, ctxAPI.Get(runnerCtx, "block.receipt.0"))
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"testing"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/artela/types"
+)
+
+func TestReadOnlyContext(t *testing.T) {
+	aspectCtx := types.NewAspectRuntimeContext()
+	aspectCtx.SetReadOnly(true)
+	runnerCtx := &asptypes.RunnerContext{Point: string(asptypes.PRE_TX_EXECUTE_METHOD)}
+
+	stateAPI, err := GetAspectStateHostInstance(aspectCtx)
+	require.NoError(t, err)
+	require.PanicsWithValue(t, types.ErrReadOnlyContext.Error(), func() {
+		stateAPI.Set(runnerCtx, "key", []byte("value"))
+	})
+
+	evmAPI, err := GetEvmHostInstance(aspectCtx)
+	require.NoError(t, err)
+	resp := evmAPI.JITCall(runnerCtx, &asptypes.JitInherentRequest{})
+	require.False(t, resp.GetSuccess())
+	require.Equal(t, types.ErrReadOnlyContext.Error(), resp.GetErrorMsg())
+}
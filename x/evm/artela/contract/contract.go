@@ -91,7 +91,7 @@ func (k *AspectNativeContract) applyMsg(ctx sdk.Context, msg *core.Message, comm
 			joinPoints := parameters["joinPoints"].(*big.Int)
 
 			aspectId := crypto.CreateAddress(sender.Address(), msg.Nonce)
-			return k.deploy(ctx, aspectId, code, propertyAry, joinPoints)
+			return k.deploy(ctx, evmtypes.EventTypeAspectDeploy, aspectId, code, propertyAry, joinPoints)
 		}
 	case "upgrade":
 		{
@@ -122,7 +122,7 @@ func (k *AspectNativeContract) applyMsg(ctx sdk.Context, msg *core.Message, comm
 				return nil, errorsmod.Wrapf(evmtypes.ErrCallContract, "failed to check if the sender is the owner, unable to upgrade, sender: %s , aspectId: %s", sender.Address().String(), aspectId.String())
 			}
 			joinPoints := parameters["joinPoints"].(*big.Int)
-			return k.deploy(ctx, aspectId, code, propertyAry, joinPoints)
+			return k.deploy(ctx, evmtypes.EventTypeAspectUpgrade, aspectId, code, propertyAry, joinPoints)
 		}
 	case "bind":
 		{
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/artela-network/artela-evm/vm"
 	"github.com/artela-network/aspect-core/djpm/contract"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/artela-network/artela/x/evm/artela/types"
 	evmtypes "github.com/artela-network/artela/x/evm/txs"
+	evmmodule "github.com/artela-network/artela/x/evm/types"
 )
 
 func (anc *AspectNativeContract) entrypoint(ctx sdk.Context, msg *core.Message, method *abi.Method, aspectId common.Address, data []byte, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
@@ -125,6 +127,14 @@ func (k *AspectNativeContract) bind(ctx sdk.Context, aspectId common.Address, ac
 		return nil, err
 	}
 
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		evmmodule.EventTypeAspectBind,
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectId, aspectId.Hex()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectAccount, account.Hex()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectVersion, aspectVersion.Dec()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectPriority, strconv.Itoa(int(priority))),
+	))
+
 	return &evmtypes.MsgEthereumTxResponse{
 		GasUsed: ctx.GasMeter().GasConsumed(),
 		VmError: "",
@@ -147,6 +157,12 @@ func (k *AspectNativeContract) unbind(ctx sdk.Context, aspectId common.Address,
 	if err := k.aspectService.aspectStore.UnbindAspectRefValue(ctx, contract, aspectId); err != nil {
 		return nil, err
 	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		evmmodule.EventTypeAspectUnbind,
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectId, aspectId.Hex()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectAccount, contract.Hex()),
+	))
 	return &evmtypes.MsgEthereumTxResponse{
 		GasUsed: ctx.GasMeter().GasConsumed(),
 		VmError: "",
@@ -302,7 +318,7 @@ func (k *AspectNativeContract) checkAspectOwner(ctx sdk.Context, aspectId common
 	return binding, runErr
 }
 
-func (k *AspectNativeContract) deploy(ctx sdk.Context, aspectId common.Address, code []byte, properties []types.Property, joinPoint *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
+func (k *AspectNativeContract) deploy(ctx sdk.Context, eventType string, aspectId common.Address, code []byte, properties []types.Property, joinPoint *big.Int) (*evmtypes.MsgEthereumTxResponse, error) {
 	if len(code) == 0 && len(properties) == 0 && joinPoint == nil {
 		return &evmtypes.MsgEthereumTxResponse{
 			GasUsed: ctx.GasMeter().GasConsumed(),
@@ -337,6 +353,13 @@ func (k *AspectNativeContract) deploy(ctx sdk.Context, aspectId common.Address,
 		}
 	}
 
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectId, aspectId.Hex()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectVersion, aspectVersion.Dec()),
+		sdk.NewAttribute(evmmodule.AttributeKeyAspectJoinPoint, joinPoint.String()),
+	))
+
 	return &evmtypes.MsgEthereumTxResponse{
 		GasUsed: ctx.GasMeter().GasConsumed(),
 		VmError: "",
@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/emirpasic/gods/sets/treeset"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 	"github.com/status-im/keycard-go/hexutils"
 	"golang.org/x/exp/slices"
@@ -143,9 +144,28 @@ func (k *AspectStore) StoreAspectCode(ctx sdk.Context, aspectId common.Address,
 
 	// update last version
 	k.StoreAspectVersion(ctx, aspectId, newVersion)
+
+	// record the deployment height of the version
+	heightStore := k.newPrefixStore(ctx, types.AspectVersionHeightKeyPrefix)
+	heightStore.Set(versionKey, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
 	return newVersion
 }
 
+// GetAspectVersionHeight returns the height the aspect version is deployed at, 0 if it's
+// not recorded.
+func (k *AspectStore) GetAspectVersionHeight(ctx sdk.Context, aspectId common.Address, version *uint256.Int) int64 {
+	heightStore := k.newPrefixStore(ctx, types.AspectVersionHeightKeyPrefix)
+	versionKey := types.AspectVersionKey(
+		aspectId.Bytes(),
+		version.Bytes(),
+	)
+	bz := heightStore.Get(versionKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
 func (k *AspectStore) GetAspectCode(ctx sdk.Context, aspectId common.Address, version *uint256.Int) ([]byte, *uint256.Int) {
 	codeStore := k.newPrefixStore(ctx, types.AspectCodeKeyPrefix)
 	if version == nil {
@@ -274,6 +294,56 @@ func (k *AspectStore) GetAspectPropertyValue(ctx sdk.Context, aspectId common.Ad
 	return codeStore.Get(aspectPropertyKey)
 }
 
+// GetAspectPropertyKeys returns the keys of the properties of the aspect, the reserved
+// account and proof keys are excluded.
+func (k *AspectStore) GetAspectPropertyKeys(ctx sdk.Context, aspectId common.Address) []string {
+	allKeys := k.GetAspectPropertyValue(ctx, aspectId, types.AspectPropertyAllKeyPrefix)
+	if len(allKeys) == 0 {
+		return []string{}
+	}
+
+	keys := make([]string, 0)
+	for _, key := range strings.Split(string(allKeys), types.AspectPropertyAllKeySplit) {
+		if key == types.AspectProofKey || key == types.AspectAccountKey {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// GetAspectMetadata returns the metadata of the aspect, nil if the aspect is not deployed.
+func (k *AspectStore) GetAspectMetadata(ctx sdk.Context, aspectId common.Address) *types.AspectMetadata {
+	latest := k.GetAspectLastVersion(ctx, aspectId)
+	if latest.IsZero() {
+		return nil
+	}
+
+	metadata := &types.AspectMetadata{
+		AspectId:      aspectId,
+		LatestVersion: latest.Uint64(),
+		JoinPoints:    types.JoinPointNames(k.GetAspectJP(ctx, aspectId, latest)),
+		Properties:    k.GetAspectPropertyKeys(ctx, aspectId),
+		Versions:      make([]*types.AspectVersionMeta, 0, latest.Uint64()),
+	}
+	if operation := k.GetAspectPropertyValue(ctx, aspectId, types.AspectOperationInterfaceKey); json.Valid(operation) {
+		metadata.OperationInterface = operation
+	}
+
+	for v := uint64(1); v <= latest.Uint64(); v++ {
+		version := uint256.NewInt(v)
+		code, _ := k.GetAspectCode(ctx, aspectId, version)
+		metadata.Versions = append(metadata.Versions, &types.AspectVersionMeta{
+			Version:    v,
+			Height:     k.GetAspectVersionHeight(ctx, aspectId, version),
+			CodeHash:   crypto.Keccak256Hash(code),
+			CodeSize:   len(code),
+			JoinPoints: types.JoinPointNames(k.GetAspectJP(ctx, aspectId, version)),
+		})
+	}
+	return metadata
+}
+
 func (k *AspectStore) BindTxAspect(ctx sdk.Context, account common.Address, aspectId common.Address, aspectVersion *uint256.Int, priority int8) error {
 	return k.saveBindingInfo(ctx, account, aspectId, aspectVersion, priority,
 		k.GetTxLevelAspects, types.ContractBindKeyPrefix, math.MaxUint8)
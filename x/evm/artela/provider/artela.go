@@ -35,7 +35,30 @@ func (j *ArtelaProvider) GetTxBondAspects(ctx context.Context, address common.Ad
 	if !ok {
 		return nil, errors.New("failed to unwrap AspectRuntimeContext from context.Context")
 	}
-	return j.service.GetAspectsForJoinPoint(aspectCtx.CosmosContext(), address, point)
+	aspects, err := j.service.GetAspectsForJoinPoint(aspectCtx.CosmosContext(), address, point)
+	if err == nil && len(aspects) > 0 {
+		captureAspects(aspectCtx, address, point, aspects)
+	}
+	return aspects, err
+}
+
+// captureAspects notifies the tracer of the traced tx, if it's an AspectTracer,
+// of the aspects that are about to be executed at the join point.
+func captureAspects(aspectCtx *types.AspectRuntimeContext, address common.Address, point asptypes.PointCut, aspects []*asptypes.AspectCode) {
+	ethTxCtx := aspectCtx.EthTxContext()
+	if ethTxCtx == nil || ethTxCtx.LastEvm() == nil {
+		return
+	}
+	tracer, ok := ethTxCtx.LastEvm().Config.Tracer.(types.AspectTracer)
+	if !ok {
+		return
+	}
+
+	ids := make([]string, 0, len(aspects))
+	for _, aspect := range aspects {
+		ids = append(ids, aspect.AspectId)
+	}
+	tracer.CaptureAspect(point, address, ids)
 }
 
 func (j *ArtelaProvider) GetAccountVerifiers(ctx context.Context, address common.Address) ([]*asptypes.AspectCode, error) {
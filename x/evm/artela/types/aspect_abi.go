@@ -2,9 +2,11 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -110,3 +112,18 @@ func ParseInput(tx []byte) (*abi.Method, map[string]interface{}, error) {
 
 	return &method, argsMap, nil
 }
+
+// PackMethod packs the call data of a method of the aspect system contract.
+func PackMethod(name string, args ...interface{}) ([]byte, error) {
+	method, ok := methods[name]
+	if !ok {
+		return nil, fmt.Errorf("method %s does not exist", name)
+	}
+
+	input, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(common.CopyBytes(method.ID), input...), nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	artela "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AspectVersionMeta is the metadata of a deployed version of an aspect.
+type AspectVersionMeta struct {
+	Version uint64 `json:"version"`
+	// Height is the block the version is deployed at, 0 for the versions deployed
+	// before the heights are recorded.
+	Height     int64       `json:"height"`
+	CodeHash   common.Hash `json:"codeHash"`
+	CodeSize   int         `json:"codeSize"`
+	JoinPoints []string    `json:"joinPoints"`
+}
+
+// AspectMetadata is the metadata of an aspect for the tooling to introspect the aspect
+// before binding to it.
+type AspectMetadata struct {
+	AspectId      common.Address `json:"aspectId"`
+	LatestVersion uint64         `json:"latestVersion"`
+	// JoinPoints is the join points declared by the latest version.
+	JoinPoints []string `json:"joinPoints"`
+	// OperationInterface is the interface of the operation method, if it's declared in
+	// the operationInterface property of the aspect.
+	OperationInterface json.RawMessage `json:"operationInterface,omitempty"`
+	// Properties is the keys of the properties of the aspect.
+	Properties []string             `json:"properties"`
+	Versions   []*AspectVersionMeta `json:"versions"`
+}
+
+// JoinPointNames returns the names of the join points in the join point bitmap, in the
+// order of their bits.
+func JoinPointNames(joinPoints *big.Int) []string {
+	jps, _ := artela.CheckIsJoinPoint(joinPoints)
+
+	bits := make([]int64, 0, len(jps))
+	for bit := range jps {
+		bits = append(bits, bit)
+	}
+	sort.Slice(bits, func(i, j int) bool { return bits[i] < bits[j] })
+
+	names := make([]string, 0, len(bits))
+	for _, bit := range bits {
+		names = append(names, jps[bit])
+	}
+	return names
+}
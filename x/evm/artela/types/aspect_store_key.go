@@ -26,6 +26,8 @@ const (
 	AspectStateKeyPrefix       = "AspectStore/State/"
 
 	AspectJoinPointRunKeyPrefix = "AspectStore/JoinPointRun/"
+	// AspectVersionHeightKeyPrefix is the prefix of the heights the aspect versions are deployed at
+	AspectVersionHeightKeyPrefix = "AspectStore/VersionHeight/"
 
 	AspectIdMapKey = "aspectId"
 	VersionMapKey  = "version"
@@ -40,6 +42,9 @@ const (
 	AspectPropertyAllKeyPrefix = "Aspect_@Property@AllKey@_"
 	AspectPropertyAllKeySplit  = "^^^"
 	AspectPropertyLimit        = math.MaxUint8
+	// AspectOperationInterfaceKey is the property an aspect can declare the json interface
+	// of its operation method in, it's exposed with the aspect metadata.
+	AspectOperationInterfaceKey = "operationInterface"
 )
 
 var (
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -33,6 +34,10 @@ const (
 
 var cachedStoreKey storetypes.StoreKey
 
+// ErrReadOnlyContext is returned for the side effects of aspects, i.e. the state writes and the
+// JIT calls, in the read-only contexts of the queries such as eth_call and eth_estimateGas.
+var ErrReadOnlyContext = errors.New("aspect side effects are not allowed in a read-only context")
+
 type (
 	HistoryStoreBuilder func(height int64, keyPrefix string) (prefix.Store, error)
 	ContextBuilder      func(height int64, prove bool) (cosmos.Context, error)
@@ -78,6 +83,9 @@ type AspectRuntimeContext struct {
 
 	logger     log.Logger
 	jitManager *inherent.Manager
+
+	// readOnly disables the side effects of the aspects in the query contexts
+	readOnly bool
 }
 
 func NewAspectRuntimeContext() *AspectRuntimeContext {
@@ -98,6 +106,16 @@ func (c *AspectRuntimeContext) WithCosmosContext(newTxCtx cosmos.Context) {
 	c.logger = newTxCtx.Logger().With("module", fmt.Sprintf("x/%s", AspectModuleName))
 }
 
+// SetReadOnly marks the context as the context of a query, where the aspects can't have side effects.
+func (c *AspectRuntimeContext) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+// ReadOnly returns true if the context is the context of a query.
+func (c *AspectRuntimeContext) ReadOnly() bool {
+	return c.readOnly
+}
+
 func (c *AspectRuntimeContext) Debug(msg string, keyvals ...interface{}) {
 	if c.ethTxContext != nil {
 		keyvals = append(keyvals, "tx-from", fmt.Sprintf("%s", c.ethTxContext.TxFrom().Hex()))
@@ -229,6 +247,9 @@ type EthTxContext struct {
 	from      common.Address
 	index     uint64
 	commit    bool
+
+	// estimate marks the uncommitted runs of a tx estimating its gas
+	estimate bool
 }
 
 func NewEthTxContext(ethTx *ethtypes.Transaction) *EthTxContext {
@@ -265,6 +286,10 @@ func (c *EthTxContext) LastEvm() *vm.EVM                 { return c.lastEvm }
 func (c *EthTxContext) Message() *core.Message           { return c.msg }
 func (c *EthTxContext) Commit() bool                     { return c.commit }
 
+// Estimate returns true if the tx is run to estimate its gas, the aspects see it as a
+// transaction even if it's not committed.
+func (c *EthTxContext) Estimate() bool { return c.estimate }
+
 func (c *EthTxContext) WithEVM(
 	from common.Address,
 	msg *core.Message,
@@ -300,6 +325,11 @@ func (c *EthTxContext) WithCommit(commit bool) *EthTxContext {
 	return c
 }
 
+func (c *EthTxContext) WithEstimate(estimate bool) *EthTxContext {
+	c.estimate = estimate
+	return c
+}
+
 func (c *EthTxContext) WithStateDB(stateDb vm.StateDB) *EthTxContext {
 	c.stateDb = stateDb
 	return c
@@ -316,10 +346,12 @@ func (c *EthTxContext) ClearEvmObject() *EthTxContext {
 
 type EthBlockContext struct {
 	blockHeader *ethtypes.Header
+	// receipts of the txs delivered in the block so far, by tx index
+	receipts []*ethtypes.Receipt
 }
 
 func NewEthBlockContextFromHeight(height int64) *EthBlockContext {
-	return &EthBlockContext{&ethtypes.Header{Number: big.NewInt(height)}}
+	return &EthBlockContext{blockHeader: &ethtypes.Header{Number: big.NewInt(height)}}
 }
 
 func NewEthBlockContextFromABCIBeginBlockReq(req abci.RequestBeginBlock) *EthBlockContext {
@@ -342,6 +374,11 @@ func NewEthBlockContextFromABCIBeginBlockReq(req abci.RequestBeginBlock) *EthBlo
 }
 
 func NewEthBlockContextFromQuery(sdkCtx cosmos.Context, queryCtx client.Context) *EthBlockContext {
+	if queryCtx.Client == nil {
+		// the client context is set along with the API routes, the node may not serve them
+		return nil
+	}
+
 	blockHeight := sdkCtx.BlockHeight()
 	resBlock, err := queryCtx.Client.Block(sdkCtx, &blockHeight)
 	if err != nil || resBlock == nil || resBlock.Block == nil {
@@ -372,6 +409,22 @@ func (c *EthBlockContext) BlockHeader() *ethtypes.Header {
 	return c.blockHeader
 }
 
+// AddReceipt records the receipt of a tx delivered in the block.
+func (c *EthBlockContext) AddReceipt(receipt *ethtypes.Receipt) {
+	c.receipts = append(c.receipts, receipt)
+}
+
+// Receipt returns the receipt of the tx of the given index delivered earlier in the block,
+// nil if there's none.
+func (c *EthBlockContext) Receipt(index uint64) *ethtypes.Receipt {
+	for _, receipt := range c.receipts {
+		if uint64(receipt.TransactionIndex) == index {
+			return receipt
+		}
+	}
+	return nil
+}
+
 type AspectContext struct {
 	// 1.string=namespace Default
 	// 2.string=key
@@ -0,0 +1,18 @@
+package types
+
+import (
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AspectTracer is an optional extension of vm.EVMLogger, the loggers implementing it
+// are notified of the aspects executed at the join points of the traced transaction.
+type AspectTracer interface {
+	// CaptureAspect is called right before the aspects bound to contract are executed
+	// at the join point, the aspect ids are in the execution order.
+	CaptureAspect(joinPoint asptypes.PointCut, contract common.Address, aspectIDs []string)
+	// CaptureAspectEnd is called with the result of the latest captured join point.
+	// It's only reported for the join points executed outside the EVM, i.e. the
+	// pre and post tx execution.
+	CaptureAspectEnd(output []byte, gas, gasUsed uint64, err error)
+}
@@ -7,12 +7,13 @@ import (
 	"github.com/artela-network/artela/x/evm/keeper"
 
 	cosmos "github.com/cosmos/cosmos-sdk/types"
-
-	ethereum "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the cosmos Context and EIP155 chain id to the Keeper.
-func BeginBlock(_ cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBeginBlock) {
+// BeginBlock sets the cosmos Context and EIP155 chain id to the Keeper, stores the hash of
+// the block for the BLOCKHASH opcode, and updates the chain info system contract.
+func BeginBlock(ctx cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBeginBlock) {
+	k.TrackHeaderHash(ctx)
+	k.ResetEVMConfigCache(ctx)
 
 	// Aspect Runtime Context Lifecycle: create and store ExtBlockContext
 	// due to the design of the block context in Cosmos SDK,
@@ -20,11 +21,17 @@ func BeginBlock(_ cosmos.Context, k *keeper.Keeper, beginBlock abci.RequestBegin
 	// using code like ctx = ctx.WithValue(artelatypes.ExtBlockContextKey, extBlockCtx).
 	// Instead, it suggests saving it to the keeper.
 	k.BlockContext = types.NewEthBlockContextFromABCIBeginBlockReq(beginBlock)
+
+	// the chain info is updated before the scheduled calls so they read the current one
+	k.UpdateChainInfo(ctx)
+
+	// the scheduled calls run with the block context of the block
+	k.ExecuteScheduledCalls(ctx)
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock aggregates the blooms of the txs from the transient store into the block bloom, and
+// emits it with the execution digest of the block. The EVM end block logic doesn't update the
+// validator set, thus it returns an empty slice.
 func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Aspect Runtime Context Lifecycle: destory ExtBlockContext
 	k.BlockContext = nil
@@ -32,8 +39,12 @@ func EndBlock(ctx cosmos.Context, k *keeper.Keeper, _ abci.RequestEndBlock) []ab
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(cosmos.NewInfiniteGasMeter())
 
-	bloom := ethereum.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
-	k.EmitBlockBloomEvent(infCtx, bloom)
+	// the blooms of the txs are aggregated once for the block
+	k.EmitBlockBloomEvent(infCtx, k.GetBlockBloomTransient(infCtx))
+	k.EmitExecutionDigestEvent(infCtx)
+
+	k.FlushAccessStats(infCtx)
+	k.FlushWitness(infCtx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -0,0 +1,92 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm"
+	"github.com/artela-network/artela/x/evm/keeper"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestEndBlockBloom(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contracts = []common.Address{
+			common.HexToAddress("0x1000000000000000000000000000000000000007"),
+			common.HexToAddress("0x1000000000000000000000000000000000000008"),
+		}
+	)
+	// the code emits a log without topics from the contract
+	code := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.LOG0), byte(vm.STOP)}
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela).WithEventManager(cosmos.NewEventManager())
+	k := artela.EvmKeeper
+
+	stateDB := states.New(ctx, k, states.NewEmptyTxConfig(common.Hash{}))
+	for _, contract := range contracts {
+		stateDB.SetCode(contract, code)
+	}
+	require.NoError(t, stateDB.Commit())
+
+	var expected ethereum.Bloom
+	for _, contract := range contracts {
+		contract := contract
+		res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &contract, GasLimit: 100_000})
+		require.NoError(t, err)
+		require.False(t, res.Failed(), res.VmError)
+		require.Len(t, res.Logs, 1)
+
+		bloom := ethereum.BytesToBloom(ethereum.LogsBloom([]*ethereum.Log{{Address: contract}}))
+		for i := range expected {
+			expected[i] |= bloom[i]
+		}
+	}
+
+	evm.EndBlock(ctx, k, abci.RequestEndBlock{})
+
+	var blockBloom *ethereum.Bloom
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeBlockBloom {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyEthereumBloom {
+				bloom := ethereum.BytesToBloom([]byte(attr.Value))
+				blockBloom = &bloom
+			}
+		}
+	}
+	require.NotNil(t, blockBloom)
+	require.Equal(t, expected, *blockBloom)
+	for _, contract := range contracts {
+		require.True(t, blockBloom.Test(contract.Bytes()))
+	}
+}
+
+func TestBlockBloomTransient(t *testing.T) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	// enough blooms to be ORed by several workers
+	var expected ethereum.Bloom
+	for i := uint64(0); i < 1000; i++ {
+		bloom := ethereum.BytesToBloom(ethereum.LogsBloom([]*ethereum.Log{{Address: common.BigToAddress(new(big.Int).SetUint64(i))}}))
+		k.SetTxBloomTransient(ctx, i, bloom)
+		for j := range expected {
+			expected[j] |= bloom[j]
+		}
+	}
+	require.Equal(t, expected, k.GetBlockBloomTransient(ctx))
+}
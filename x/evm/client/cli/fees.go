@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"math/big"
+
+	rpc "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	feetypes "github.com/artela-network/artela/x/fee/types"
+)
+
+// GasFees are the fees of an ethereum tx suggested from the base fee of the latest block.
+type GasFees struct {
+	// BaseFee is nil if the london hardfork or the fee market is not enabled
+	BaseFee   *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// GasPrice returns the gas price of a legacy tx paying the suggested fees.
+func (f *GasFees) GasPrice() *big.Int {
+	if f.BaseFee == nil {
+		return new(big.Int).Set(f.GasTipCap)
+	}
+	return new(big.Int).Add(f.BaseFee, f.GasTipCap)
+}
+
+// SuggestGasFees queries the base fee of the latest block and suggests the priority fee
+// and the fee cap of a dynamic fee tx. The fee cap is twice the base fee plus the tip, so
+// the tx stays includable for several blocks of base fee increases.
+func SuggestGasFees(ctx context.Context, queryClient *rpc.QueryClient) (*GasFees, error) {
+	fees := &GasFees{
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+	}
+
+	res, err := queryClient.BaseFee(ctx, &txs.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.BaseFee == nil {
+		return fees, nil
+	}
+	fees.BaseFee = res.BaseFee.BigInt()
+
+	params, err := queryClient.FeeMarket.Params(ctx, &feetypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	fees.GasTipCap = params.Params.SuggestGasTipCap(fees.BaseFee)
+	fees.GasFeeCap = new(big.Int).Add(new(big.Int).Mul(fees.BaseFee, big.NewInt(2)), fees.GasTipCap)
+	return fees, nil
+}
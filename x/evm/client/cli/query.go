@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"strconv"
+
 	rpc "github.com/artela-network/artela/ethereum/rpc/types"
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetScheduledCallCmd(),
+		GetScheduledCallsCmd(),
+		GetGasPolicyCmd(),
 	)
 	return cmd
 }
@@ -133,3 +139,89 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetScheduledCallCmd queries a pending scheduled call by its id
+func GetScheduledCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-call ID",
+		Short: "Get a pending scheduled call",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid scheduled call id")
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledCall(rpc.ContextWithHeight(clientCtx.Height), &txs.QueryScheduledCallRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetScheduledCallsCmd queries the pending scheduled calls of a sender
+func GetScheduledCallsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-calls ADDRESS",
+		Short: "Get the pending scheduled calls of a sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledCalls(rpc.ContextWithHeight(clientCtx.Height), &txs.QueryScheduledCallsRequest{Sender: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGasPolicyCmd queries the gas policy of an account and the gas it spent today
+func GetGasPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-policy ADDRESS",
+		Short: "Get the gas policy of an account and the gas it spent today",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := txs.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasPolicy(rpc.ContextWithHeight(clientCtx.Height), &txs.QueryGasPolicyRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
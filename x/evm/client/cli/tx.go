@@ -2,22 +2,53 @@ package cli
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
+	"strconv"
 
 	rpc "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/ethereum/server/config"
+	ethereumtypes "github.com/artela-network/artela/ethereum/types"
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/artela-network/artela/x/evm/types"
 )
 
+const (
+	// FlagLegacy sends a legacy tx paying base fee plus tip as gas price
+	FlagLegacy = "legacy"
+	// FlagData is the hex encoded call data of the tx
+	FlagData = "data"
+	// FlagGasLimit is the gas limit of the tx, estimated if not set
+	FlagGasLimit = "gas-limit"
+	// FlagValue is the amount transferred by a scheduled call
+	FlagValue = "value"
+	// FlagCallGasPrice is the gas price of a scheduled call, the base fee if not set
+	FlagCallGasPrice = "call-gas-price"
+	// FlagAdmin is the admin of a gas policy, the account itself if not set
+	FlagAdmin = "admin"
+	// FlagMaxGasPrice is the max gas price of a gas policy, not capped if not set
+	FlagMaxGasPrice = "max-gas-price"
+	// FlagDailyGasBudget is the daily gas budget of a gas policy, not capped if zero
+	FlagDailyGasBudget = "daily-gas-budget"
+	// FlagAllowedTargets are the targets allowed by a gas policy, not capped if empty
+	FlagAllowedTargets = "allowed-targets"
+)
+
 // GetTxCmd returns the txs commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,7 +58,190 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSendTxCmd(),
+		NewPauseEVMCmd(),
+		NewScheduleCallCmd(),
+		NewCancelScheduledCallCmd(),
+		NewSetGasPolicyCmd(),
+	)
+	return cmd
+}
+
+// NewPauseEVMCmd command pauses all the EVM calls and creates in emergencies, it must be
+// sent from the pause authority of the evm params.
+func NewPauseEVMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [BLOCKS]",
+		Short: "Pause all the EVM calls and creates in an emergency",
+		Long:  "Pause all the EVM calls and creates for the given number of blocks, or for the pause_blocks param if not set. The pause expires at the end of it unless governance extends it.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &txs.MsgPauseEVM{Authority: clientCtx.GetFromAddress().String()}
+			if len(args) > 0 {
+				if msg.Blocks, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return errors.Wrap(err, "invalid pause blocks")
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewScheduleCallCmd command schedules an EVM call to be executed at a future height, the
+// fee of the call is escrowed until it's executed, cancelled or expired.
+func NewScheduleCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-call TO_ADDRESS HEIGHT",
+		Short: "Schedule an EVM call to be executed at a future height",
+		Long: `Schedule an EVM call from the --from key to be executed at or after the given height. The fee of
+the call, its gas limit times its gas price, is escrowed until the call is executed, and the fee of the
+unused gas is refunded. The call is refunded if it's not executed within a number of blocks after the
+height, and it can be cancelled before it's executed.`,
+		Example: "artelad tx evm schedule-call 0x6a8c...02c1 120000 --data 0xd09de08a --gas-limit 100000 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid height")
+			}
+
+			var data []byte
+			if dataHex, _ := cmd.Flags().GetString(FlagData); dataHex != "" {
+				if data, err = hexutil.Decode(dataHex); err != nil {
+					return errors.Wrap(err, "failed to decode call data")
+				}
+			}
+
+			gasLimit, _ := cmd.Flags().GetUint64(FlagGasLimit)
+			if gasLimit == 0 {
+				return errors.New("the gas limit of the call is required")
+			}
+
+			gasPrice, _ := cmd.Flags().GetString(FlagCallGasPrice)
+			if gasPrice == "" {
+				res, err := txs.NewQueryClient(clientCtx).BaseFee(cmd.Context(), &txs.QueryBaseFeeRequest{})
+				if err != nil {
+					return errors.Wrap(err, "failed to query the base fee")
+				}
+				gasPrice = "0"
+				if res.BaseFee != nil {
+					gasPrice = res.BaseFee.String()
+				}
+			}
+
+			value, _ := cmd.Flags().GetString(FlagValue)
+			msg := &txs.MsgScheduleCall{
+				Sender:    clientCtx.GetFromAddress().String(),
+				To:        to,
+				Data:      data,
+				Value:     value,
+				GasLimit:  gasLimit,
+				GasPrice:  gasPrice,
+				ExecuteAt: height,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagData, "", "hex encoded call data of the call")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "gas limit of the call")
+	cmd.Flags().String(FlagValue, "", "amount of the evm denom transferred by the call")
+	cmd.Flags().String(FlagCallGasPrice, "", "gas price of the call in the evm denom, the current base fee if not set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelScheduledCallCmd command cancels a pending scheduled call and refunds its fee.
+func NewCancelScheduledCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-call ID",
+		Short: "Cancel a pending scheduled call and refund its fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid scheduled call id")
+			}
+
+			msg := &txs.MsgCancelScheduledCall{Sender: clientCtx.GetFromAddress().String(), Id: id}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetGasPolicyCmd command sets the gas policy of an account, the policy without any cap
+// removes it. The policy is set by the account itself first, and by its admin afterwards.
+func NewSetGasPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-gas-policy ADDRESS",
+		Short: "Set the gas policy capping the gas price, daily gas and targets of the eth txs of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			admin, _ := cmd.Flags().GetString(FlagAdmin)
+			maxGasPrice, _ := cmd.Flags().GetString(FlagMaxGasPrice)
+			dailyGasBudget, _ := cmd.Flags().GetUint64(FlagDailyGasBudget)
+			allowedTargets, _ := cmd.Flags().GetStringSlice(FlagAllowedTargets)
+
+			msg := &txs.MsgSetGasPolicy{
+				Sender: clientCtx.GetFromAddress().String(),
+				Policy: support.GasPolicy{
+					Address:        args[0],
+					Admin:          admin,
+					MaxGasPrice:    maxGasPrice,
+					DailyGasBudget: dailyGasBudget,
+					AllowedTargets: allowedTargets,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAdmin, "", "the admin allowed to update or remove the policy, the account itself if not set")
+	cmd.Flags().String(FlagMaxGasPrice, "", "the max gas price in wei of the txs, not capped if not set")
+	cmd.Flags().Uint64(FlagDailyGasBudget, 0, "the total gas limit of the txs per UTC day, not capped if zero")
+	cmd.Flags().StringSlice(FlagAllowedTargets, nil, "the hex addresses the txs may call, not capped if empty")
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -57,57 +271,219 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			rsp, err := rpc.NewQueryClient(clientCtx).Params(cmd.Context(), &txs.QueryParamsRequest{})
+			return broadcastEthereumTx(cmd, clientCtx, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSendTxCmd command signs and sends an ethereum tx with the fees suggested from the
+// base fee of the latest block
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send TO_ADDRESS AMOUNT",
+		Short: "Sign and send an ethereum tx paying the suggested fees",
+		Long: `Sign and send an ethereum tx from the --from key. A dynamic fee tx is built by default, its
+fee cap is twice the base fee of the latest block plus the suggested tip. Use --legacy to send a
+legacy tx paying the base fee plus the tip as gas price.`,
+		Example: "artelad tx evm send 0x6a8c...02c1 1000000000000000000 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			toHex, err := accountToHex(args[0])
 			if err != nil {
 				return err
 			}
+			to := common.HexToAddress(toHex)
 
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
-
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			amount, ok := new(big.Int).SetString(args[1], 10)
+			if !ok || amount.Sign() < 0 {
+				return fmt.Errorf("invalid amount %s", args[1])
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
-
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
-
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm txs before signing and broadcasting", buf, os.Stderr)
-
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled txs")
-					return err
+			var data []byte
+			if dataHex, _ := cmd.Flags().GetString(FlagData); dataHex != "" {
+				if data, err = hexutil.Decode(dataHex); err != nil {
+					return errors.Wrap(err, "failed to decode tx data")
 				}
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
-			if err != nil {
-				return err
-			}
+			legacy, _ := cmd.Flags().GetBool(FlagLegacy)
+			gasLimit, _ := cmd.Flags().GetUint64(FlagGasLimit)
 
-			// broadcast to a Tendermint node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			msg, err := NewEthereumTx(cmd.Context(), clientCtx, &to, amount, data, gasLimit, legacy)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return broadcastEthereumTx(cmd, clientCtx, msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagLegacy, false, "send a legacy tx instead of a dynamic fee tx")
+	cmd.Flags().String(FlagData, "", "hex encoded call data of the tx")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "gas limit of the tx, estimated if not set")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewEthereumTx builds an ethereum tx from the --from key of the client context and signs
+// it with the keyring. The nonce is queried from the node, the gas limit is estimated if
+// it's 0, and the fees are suggested from the base fee of the latest block. A dynamic fee
+// tx is built unless legacy is set or the base fee is not enabled.
+func NewEthereumTx(
+	ctx context.Context,
+	clientCtx client.Context,
+	to *common.Address,
+	amount *big.Int,
+	data []byte,
+	gasLimit uint64,
+	legacy bool,
+) (*txs.MsgEthereumTx, error) {
+	queryClient := rpc.NewQueryClient(clientCtx)
+
+	from := common.BytesToAddress(clientCtx.GetFromAddress())
+	if from == (common.Address{}) {
+		return nil, errors.New("the --from key is required to sign the tx")
+	}
+
+	chainID, err := ethereumtypes.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := queryClient.Account(ctx, &txs.QueryAccountRequest{Address: from.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := SuggestGasFees(ctx, queryClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if gasLimit == 0 {
+		input := hexutil.Bytes(data)
+		callArgs, err := json.Marshal(&txs.TransactionArgs{
+			From:  &from,
+			To:    to,
+			Value: (*hexutil.Big)(amount),
+			Data:  &input,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := queryClient.EstimateGas(ctx, &txs.EthCallRequest{
+			Args:    callArgs,
+			GasCap:  config.DefaultGasCap,
+			ChainId: chainID.Int64(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to estimate gas")
+		}
+		if res.Failed() {
+			return nil, errors.Wrap(types.NewExecErrorWithReason(res.Ret), "failed to estimate gas")
+		}
+		gasLimit = res.Gas
+	}
+
+	txArgs := &txs.EvmTxArgs{
+		Nonce:    account.Nonce,
+		GasLimit: gasLimit,
+		Input:    data,
+		ChainID:  chainID,
+		Amount:   amount,
+		To:       to,
+	}
+	if legacy || fees.BaseFee == nil {
+		txArgs.GasPrice = fees.GasPrice()
+	} else {
+		txArgs.GasFeeCap = fees.GasFeeCap
+		txArgs.GasTipCap = fees.GasTipCap
+	}
+
+	msg := txs.NewTx(txArgs)
+	msg.From = from.Hex()
+	if err := msg.Sign(ethtypes.LatestSignerForChainID(chainID), clientCtx.Keyring); err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// broadcastEthereumTx wraps the ethereum tx into a cosmos tx and broadcasts it, or prints
+// it if --generate-only is set
+func broadcastEthereumTx(cmd *cobra.Command, clientCtx client.Context, msg *txs.MsgEthereumTx) error {
+	rsp, err := rpc.NewQueryClient(clientCtx).Params(cmd.Context(), &txs.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	// the memo and timeout height are set on the cosmos tx, they're not covered by the eth
+	// signature, so they're signed by the sender separately
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	memo, _ := cmd.Flags().GetString(flags.FlagNote)
+	builder.SetMemo(memo)
+	timeoutHeight, _ := cmd.Flags().GetUint64(flags.FlagTimeoutHeight)
+	builder.SetTimeoutHeight(timeoutHeight)
+
+	var metadataSig []byte
+	if memo != "" || timeoutHeight != 0 {
+		if metadataSig, err = msg.SignTxMetadata(clientCtx.Keyring, memo, timeoutHeight); err != nil {
+			return err
+		}
+	}
+
+	tx, err := msg.BuildTxWithMetadata(builder, rsp.Params.EvmDenom, metadataSig)
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", txJSON))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm txs before signing and broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled txs")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
@@ -0,0 +1,54 @@
+// Package exported defines the stable interface of the EVM keeper for the external modules,
+// e.g. the ante handlers or the modules running the EVM messages, so they can integrate with
+// the EVM module without importing the keeper.
+package exported
+
+import (
+	"math/big"
+
+	"github.com/artela-network/artela-evm/vm"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+)
+
+//go:generate mockgen -source=keeper.go -package testutil -destination ../testutil/evm_keeper_mocks.go
+
+// EVMKeeper defines the EVM keeper methods available to the external modules. The methods
+// are kept backward compatible, the keeper internals may change between releases.
+type EVMKeeper interface {
+	// ChainID returns the EIP-155 chain id of the EVM.
+	ChainID() *big.Int
+	// GetParams returns the params of the EVM module.
+	GetParams(ctx cosmos.Context) support.Params
+	// GetChainConfig returns the ethereum chain config of the EVM.
+	GetChainConfig(ctx cosmos.Context) *params.ChainConfig
+	// GetBaseFee returns the base fee of the current block, nil if the london fork is
+	// not enabled, 0 if the base fee is disabled by the fee module.
+	GetBaseFee(ctx cosmos.Context, ethCfg *params.ChainConfig) *big.Int
+	// GetBalance returns the balance of the account in the EVM denom.
+	GetBalance(ctx cosmos.Context, addr common.Address) *big.Int
+
+	// EVMConfig returns the config of the EVM running the messages of the current block,
+	// the coinbase is the address of the proposer.
+	EVMConfig(ctx cosmos.Context, proposerAddress cosmos.ConsAddress, chainID *big.Int) (*states.EVMConfig, error)
+	// EVMConfigFromCtx returns the config of the EVM of the current block, the proposer is
+	// the one of the block header of the context.
+	EVMConfigFromCtx(ctx cosmos.Context) (*states.EVMConfig, error)
+
+	// VerifySig verifies the signature of the ethereum tx, or the aspect verifier of the
+	// unsigned contract call, it returns the sender, and the call data unwrapped by the
+	// aspect verifier of the unsigned call.
+	VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error)
+	// ApplyMessage runs the message in the EVM with the config of the current block, the
+	// state changes are committed to the context if commit is true, otherwise the message is
+	// run as a call. The tracer is optional. The failed executions are reported by the
+	// VmError of the response, the returned error is set only if the message can't be run.
+	ApplyMessage(ctx cosmos.Context, msg *core.Message, tracer vm.EVMLogger, commit bool) (*txs.MsgEthereumTxResponse, error)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"encoding/json"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+var _ states.AccessStatsKeeper = &Keeper{}
+
+// blockAccessStats are the storage access counters of the current block. The keeper is
+// shared with the queries served concurrently, so the counters are guarded by a mutex.
+type blockAccessStats struct {
+	mu    sync.Mutex
+	stats states.AccessStats
+}
+
+// SetAccessStatsDB sets the node local db where the per block storage access
+// counters of the contracts are stored.
+func (k *Keeper) SetAccessStatsDB(db dbm.DB) {
+	k.accessStatsDB = db
+	k.blockAccessStats = &blockAccessStats{stats: make(states.AccessStats)}
+}
+
+// AccessStatsEnabled returns true if the storage access counters are recorded.
+func (k *Keeper) AccessStatsEnabled() bool {
+	return k.accessStatsDB != nil
+}
+
+// AddAccessStats adds the storage access counters of a tx committed in DeliverTx, or of a
+// system tx of BeginBlock or EndBlock, to the counters of the current block. The check txs,
+// the simulations and the queries, e.g. the traces replaying the txs of a block, run in
+// check contexts and are skipped.
+func (k *Keeper) AddAccessStats(ctx cosmos.Context, stats states.AccessStats) {
+	if k.accessStatsDB == nil || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+
+	k.blockAccessStats.mu.Lock()
+	defer k.blockAccessStats.mu.Unlock()
+	k.blockAccessStats.stats.Merge(stats)
+}
+
+// FlushAccessStats stores the storage access counters of the current block
+// and resets them. The counters are node local data and don't affect
+// consensus, so a failed write is only logged.
+func (k *Keeper) FlushAccessStats(ctx cosmos.Context) {
+	if k.accessStatsDB == nil {
+		return
+	}
+
+	k.blockAccessStats.mu.Lock()
+	stats := k.blockAccessStats.stats
+	k.blockAccessStats.stats = make(states.AccessStats)
+	k.blockAccessStats.mu.Unlock()
+	if len(stats) == 0 {
+		return
+	}
+
+	bz, err := json.Marshal(stats)
+	if err != nil {
+		k.Logger(ctx).Error("failed to encode access stats", "height", ctx.BlockHeight(), "error", err)
+		return
+	}
+	if err := k.accessStatsDB.Set(states.AccessStatsKey(ctx.BlockHeight()), bz); err != nil {
+		k.Logger(ctx).Error("failed to record access stats", "height", ctx.BlockHeight(), "error", err)
+	}
+}
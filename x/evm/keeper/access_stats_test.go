@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+func TestAddAccessStats(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000006")
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+	db := dbm.NewMemDB()
+	k.SetAccessStatsDB(db)
+
+	// the check txs, simulations and queries are not recorded
+	k.AddAccessStats(ctx.WithIsCheckTx(true), states.AccessStats{contract: {Reads: 100}})
+	k.AddAccessStats(ctx.WithIsReCheckTx(true), states.AccessStats{contract: {Reads: 100}})
+
+	// the delivered txs are recorded, the keeper is safe for concurrent use
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			k.AddAccessStats(ctx, states.AccessStats{contract: {Reads: 2, Writes: 1}})
+		}()
+	}
+	wg.Wait()
+
+	k.FlushAccessStats(ctx)
+	bz, err := db.Get(states.AccessStatsKey(ctx.BlockHeight()))
+	require.NoError(t, err)
+	var stats states.AccessStats
+	require.NoError(t, json.Unmarshal(bz, &stats))
+	require.Equal(t, states.AccessStats{contract: {Reads: 20, Writes: 10}}, stats)
+
+	// the counters are reset by the flush
+	k.FlushAccessStats(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	bz, err = db.Get(states.AccessStatsKey(ctx.BlockHeight() + 1))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+}
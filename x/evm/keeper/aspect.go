@@ -3,9 +3,17 @@ package keeper
 import (
 	"context"
 	"errors"
+	"math/big"
 
-	artvmtype "github.com/artela-network/artela/x/evm/artela/types"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	artvmtype "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	asptypes "github.com/artela-network/aspect-core/types"
 )
 
 func (k Keeper) GetAspectRuntimeContext() *artvmtype.AspectRuntimeContext {
@@ -49,3 +57,63 @@ func (k Keeper) SetAspectContext(ctx context.Context, address common.Address, ke
 func (k Keeper) GetBlockContext() *artvmtype.EthBlockContext {
 	return k.BlockContext
 }
+
+// AspectAccessGas returns the gas of the access of the aspects bound to the called contract,
+// it's charged as intrinsic gas of the tx if the AspectAccessGas param is enabled, so the cost
+// of calling an aspect-bound contract doesn't depend on the join points the aspects run:
+//   - an aspect listed in the access list of the tx costs WarmStorageReadCostEIP2929 (100)
+//   - any other aspect costs ColdAccountAccessCostEIP2929 (2600)
+//
+// It's 0 if the param is disabled, for the contract creations and the aspect operations.
+func (k *Keeper) AspectAccessGas(ctx cosmos.Context, evmParams support.Params, cfg *params.ChainConfig,
+	to *common.Address, accessList ethereum.AccessList,
+) (uint64, error) {
+	aspects, err := k.accessedAspects(ctx, evmParams, cfg, to)
+	if err != nil {
+		return 0, err
+	}
+	return aspectAccessGas(aspects, accessList), nil
+}
+
+// accessedAspects returns the ids of the aspects bound to the called contract whose access
+// is charged, see AspectAccessGas.
+func (k *Keeper) accessedAspects(ctx cosmos.Context, evmParams support.Params, cfg *params.ChainConfig,
+	to *common.Address,
+) ([]common.Address, error) {
+	if !evmParams.AspectAccessGas || to == nil || asptypes.IsAspectContractAddr(to) ||
+		!cfg.IsBerlin(big.NewInt(ctx.BlockHeight())) {
+		return nil, nil
+	}
+
+	store := contract.NewAspectStore(k.storeKey, k.Logger(ctx))
+	aspects, err := store.GetTxLevelAspects(ctx, *to)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]common.Address, len(aspects))
+	for i, aspect := range aspects {
+		ids[i] = aspect.Id
+	}
+	return ids, nil
+}
+
+// aspectAccessGas returns the gas of the access of the aspects, an aspect is warm if it's in
+// the access list or accessed before.
+func aspectAccessGas(aspects []common.Address, accessList ethereum.AccessList) uint64 {
+	warm := make(map[common.Address]struct{}, len(accessList)+len(aspects))
+	for _, tuple := range accessList {
+		warm[tuple.Address] = struct{}{}
+	}
+
+	var gas uint64
+	for _, aspect := range aspects {
+		if _, ok := warm[aspect]; ok {
+			gas += params.WarmStorageReadCostEIP2929
+			continue
+		}
+		warm[aspect] = struct{}{}
+		gas += params.ColdAccountAccessCostEIP2929
+	}
+	return gas
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/artela/contract"
+	"github.com/artela-network/artela/x/evm/keeper"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+var (
+	boundContract = common.HexToAddress("0x1000000000000000000000000000000000000003")
+	boundAspect   = common.HexToAddress("0x1000000000000000000000000000000000000004")
+)
+
+// setupBoundAspect returns an app with an aspect bound to boundContract, and the aspect
+// access gas enabled.
+func setupBoundAspect(t *testing.T) (*app.Artela, cosmos.Context) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	store := contract.NewAspectStore(artela.GetKey(types.StoreKey), log.NewNopLogger())
+	version := store.StoreAspectCode(ctx, boundAspect, []byte{0x00, 0x61, 0x73, 0x6d})
+	require.NoError(t, store.BindTxAspect(ctx, boundContract, boundAspect, version, 0))
+
+	params := k.GetParams(ctx)
+	params.AspectAccessGas = true
+	require.NoError(t, k.SetParams(ctx, params))
+	return artela, ctx
+}
+
+func TestAspectAccessGas(t *testing.T) {
+	artela, ctx := setupBoundAspect(t)
+	k := artela.EvmKeeper
+	evmParams := k.GetParams(ctx)
+	cfg := k.GetChainConfig(ctx)
+
+	testCases := []struct {
+		name       string
+		to         *common.Address
+		accessList ethereum.AccessList
+		disabled   bool
+		expected   uint64
+	}{
+		{"cold aspect", &boundContract, nil, false, params.ColdAccountAccessCostEIP2929},
+		{"aspect in the access list", &boundContract, ethereum.AccessList{{Address: boundAspect}}, false, params.WarmStorageReadCostEIP2929},
+		{"no bound aspect", &scheduledCallRecipient, nil, false, 0},
+		{"contract creation", nil, nil, false, 0},
+		{"disabled", &boundContract, nil, true, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmParams := evmParams
+			evmParams.AspectAccessGas = !tc.disabled
+			gas, err := k.AspectAccessGas(ctx, evmParams, cfg, tc.to, tc.accessList)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, gas)
+		})
+	}
+}
+
+func TestAspectAccessGasCharge(t *testing.T) {
+	artela, ctx := setupBoundAspect(t)
+	k := artela.EvmKeeper
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// the access of the aspect is charged on top of the gas of the call
+	gasLimit := params.TxGas + params.ColdAccountAccessCostEIP2929
+	res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &boundContract, GasLimit: gasLimit})
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, gasLimit, res.GasUsed)
+
+	// running short of the gas fails the tx with a receipt, like running out of gas in the EVM
+	res, err = k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &boundContract, GasLimit: gasLimit - 1})
+	require.NoError(t, err)
+	require.True(t, res.Failed())
+	require.Equal(t, "out of gas", res.VmError)
+	require.Equal(t, gasLimit-1, res.GasUsed)
+	require.Equal(t, uint64(2), k.GetTxIndexTransient(ctx))
+}
+
+func TestVerifyFeeAspectAccessGas(t *testing.T) {
+	txData, err := txs.NewTxDataFromTx(ethereum.NewTx(&ethereum.LegacyTx{
+		To:       &boundContract,
+		Gas:      params.TxGas,
+		GasPrice: big.NewInt(1),
+	}))
+	require.NoError(t, err)
+
+	_, err = keeper.VerifyFee(txData, "aart", nil, true, true, true, 0, 0)
+	require.NoError(t, err)
+
+	// the gas limit doesn't cover the intrinsic gas with the aspect access
+	_, err = keeper.VerifyFee(txData, "aart", nil, true, true, true, 0, params.ColdAccountAccessCostEIP2929)
+	require.ErrorIs(t, err, errortypes.ErrOutOfGas)
+}
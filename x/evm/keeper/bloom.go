@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"runtime"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+)
+
+// minBloomsPerWorker is the min number of the blooms ORed by a worker of the block bloom
+// aggregation, fewer blooms are ORed in the calling goroutine.
+const minBloomsPerWorker = 64
+
+// aggregateBlooms returns the OR of the blooms, the blooms are split between up to one worker
+// per CPU. The OR is commutative, so the result doesn't depend on the split.
+func aggregateBlooms(blooms [][]byte) ethereum.Bloom {
+	workers := runtime.NumCPU()
+	if n := (len(blooms) + minBloomsPerWorker - 1) / minBloomsPerWorker; n < workers {
+		workers = n
+	}
+	if workers <= 1 {
+		return orBlooms(blooms)
+	}
+
+	size := (len(blooms) + workers - 1) / workers
+	partials := make([]ethereum.Bloom, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		start, end := i*size, (i+1)*size
+		if end > len(blooms) {
+			end = len(blooms)
+		}
+		if start >= end {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, blooms [][]byte) {
+			defer wg.Done()
+			partials[i] = orBlooms(blooms)
+		}(i, blooms[start:end])
+	}
+	wg.Wait()
+
+	var bloom ethereum.Bloom
+	for i := range partials {
+		orBloom(&bloom, partials[i][:])
+	}
+	return bloom
+}
+
+// orBlooms returns the OR of the blooms.
+func orBlooms(blooms [][]byte) ethereum.Bloom {
+	var bloom ethereum.Bloom
+	for _, bz := range blooms {
+		orBloom(&bloom, bz)
+	}
+	return bloom
+}
+
+// orBloom ORs the bloom bytes into the bloom, the bytes are right aligned the same way as
+// ethereum.BytesToBloom.
+func orBloom(bloom *ethereum.Bloom, bz []byte) {
+	if len(bz) > ethereum.BloomByteLength {
+		bz = bz[len(bz)-ethereum.BloomByteLength:]
+	}
+	offset := ethereum.BloomByteLength - len(bz)
+	for i, b := range bz {
+		bloom[offset+i] |= b
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// EVMDenomDecimals is the number of the decimals of the evm denom, which is the ether
+// of the EVM.
+const EVMDenomDecimals = 18
+
+// chainInfoCode is the runtime code of the chain info contract. It reverts the calls with
+// value, and returns the storage slot keyed by the selector of the call otherwise, so the
+// getters of unknown selectors return zero:
+//
+//	CALLVALUE PUSH1 0x13 JUMPI
+//	PUSH1 0x00 CALLDATALOAD PUSH1 0xe0 SHR SLOAD
+//	PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+//	JUMPDEST PUSH1 0x00 DUP1 REVERT
+var (
+	chainInfoCode     = common.FromHex("0x3460135760003560e01c5460005260206000f35b600080fd")
+	chainInfoCodeHash = crypto.Keccak256(chainInfoCode)
+)
+
+// UpdateChainInfo deploys the chain info contract if it's not deployed yet, and stores the
+// current chain params in its storage, so the contracts and the frontends read them from
+// the chain instead of hardcoding them. The contract implements:
+//
+//	interface IChainInfo {
+//	    function chainId() external view returns (uint256);
+//	    function baseFee() external view returns (uint256);
+//	    function minGasPrice() external view returns (uint256);
+//	    function noBaseFee() external view returns (bool);
+//	    function baseFeeChangeDenominator() external view returns (uint256);
+//	    function elasticityMultiplier() external view returns (uint256);
+//	    function blockGasLimit() external view returns (uint256);
+//	    function evmDenomDecimals() external view returns (uint8);
+//	    function isLondon() external view returns (bool);
+//	    function isShanghai() external view returns (bool);
+//	    function isCancun() external view returns (bool);
+//	    function pausedUntil() external view returns (uint256);
+//	}
+//
+// The values are the ones at the beginning of the block, the changes made by the txs of the
+// block are served from the next block.
+func (k *Keeper) UpdateChainInfo(ctx cosmos.Context) {
+	if err := k.deployChainInfoContract(ctx); err != nil {
+		k.Logger(ctx).Error("failed to deploy the chain info contract", "error", err)
+		return
+	}
+
+	ethCfg := k.GetChainConfig(ctx)
+	feeParams := k.feeKeeper.GetParams(ctx)
+	rules := ethCfg.Rules(big.NewInt(ctx.BlockHeight()), ethCfg.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix())) // #nosec G701
+
+	baseFee := k.GetBaseFee(ctx, ethCfg)
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
+	k.setChainInfo(ctx, "chainId()", k.ChainID())
+	k.setChainInfo(ctx, "baseFee()", baseFee)
+	k.setChainInfo(ctx, "minGasPrice()", feeParams.MinGasPrice.TruncateInt().BigInt())
+	k.setChainInfo(ctx, "noBaseFee()", chainInfoBool(feeParams.NoBaseFee))
+	k.setChainInfo(ctx, "baseFeeChangeDenominator()", new(big.Int).SetUint64(uint64(feeParams.BaseFeeChangeDenominator)))
+	k.setChainInfo(ctx, "elasticityMultiplier()", new(big.Int).SetUint64(uint64(feeParams.ElasticityMultiplier)))
+	k.setChainInfo(ctx, "blockGasLimit()", new(big.Int).SetUint64(artela.BlockGasLimit(ctx)))
+	k.setChainInfo(ctx, "evmDenomDecimals()", big.NewInt(EVMDenomDecimals))
+	k.setChainInfo(ctx, "isLondon()", chainInfoBool(rules.IsLondon))
+	k.setChainInfo(ctx, "isShanghai()", chainInfoBool(rules.IsShanghai))
+	k.setChainInfo(ctx, "isCancun()", chainInfoBool(rules.IsCancun))
+	k.setChainInfo(ctx, "pausedUntil()", big.NewInt(k.GetPausedUntil(ctx)))
+}
+
+// deployChainInfoContract sets the code of the chain info contract to its address, it's
+// a no-op if the code is already set.
+func (k *Keeper) deployChainInfoContract(ctx cosmos.Context) error {
+	addr := types.ChainInfoContractAddress
+	account := k.GetAccount(ctx, addr)
+	if account == nil {
+		account = states.NewEmptyAccount()
+	} else if bytes.Equal(account.CodeHash, chainInfoCodeHash) {
+		return nil
+	}
+
+	k.SetCode(ctx, chainInfoCodeHash, chainInfoCode)
+	// the nonce of a contract starts at 1 after EIP-161
+	account.Nonce = 1
+	account.CodeHash = chainInfoCodeHash
+	return k.SetAccount(ctx, addr, *account)
+}
+
+// setChainInfo stores the value returned by the getter of the given signature, the slot
+// is only written if the value is changed.
+func (k *Keeper) setChainInfo(ctx cosmos.Context, signature string, value *big.Int) {
+	addr := types.ChainInfoContractAddress
+	key := common.BytesToHash(crypto.Keccak256([]byte(signature))[:4])
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	hash := common.BigToHash(value)
+	if k.GetState(ctx, addr, key) == hash {
+		return
+	}
+
+	var bz []byte
+	if value.Sign() != 0 {
+		bz = hash.Bytes()
+	}
+	k.SetState(ctx, addr, key, bz)
+}
+
+func chainInfoBool(b bool) *big.Int {
+	if b {
+		return big.NewInt(1)
+	}
+	return new(big.Int)
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"math/big"
+	"strconv"
+	"sync"
 
 	"github.com/artela-network/artela-evm/vm"
 
@@ -13,15 +15,36 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 
 	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/types"
 )
 
 // ----------------------------------------------------------------------------
 // 								   EVM Config
 // ----------------------------------------------------------------------------
 
-// EVMConfig creates the EVMConfig based on current states
+// EVMConfig creates the EVMConfig based on current states, the params, the chain config
+// and the coinbase are cached for the block, only the base fee is fetched on every call.
 func (k *Keeper) EVMConfig(ctx cosmos.Context, proposerAddress cosmos.ConsAddress, chainID *big.Int) (*states.EVMConfig, error) {
-	params := k.GetParams(ctx)
+	if cfg := k.configCache.get(ctx.BlockHeight(), proposerAddress, chainID); cfg != nil {
+		cfg.BaseFee = k.GetBaseFee(ctx, cfg.ChainConfig)
+		return cfg, nil
+	}
+
+	cfg, err := k.evmConfig(ctx, k.GetParams(ctx), proposerAddress, chainID)
+	if err != nil {
+		return nil, err
+	}
+	k.configCache.set(ctx.BlockHeight(), proposerAddress, chainID, cfg)
+	return cfg, nil
+}
+
+// EVMConfigAtHeight creates the EVMConfig with the params that were active at the given
+// height, it's used to replay the txs of the old blocks.
+func (k *Keeper) EVMConfigAtHeight(ctx cosmos.Context, proposerAddress cosmos.ConsAddress, chainID *big.Int, height int64) (*states.EVMConfig, error) {
+	return k.evmConfig(ctx, k.GetParamsAtHeight(ctx, height), proposerAddress, chainID)
+}
+
+func (k *Keeper) evmConfig(ctx cosmos.Context, params support.Params, proposerAddress cosmos.ConsAddress, chainID *big.Int) (*states.EVMConfig, error) {
 	ethCfg := params.ChainConfig.EthereumConfig(chainID)
 
 	// get the coinbase address from the block proposer
@@ -39,22 +62,155 @@ func (k *Keeper) EVMConfig(ctx cosmos.Context, proposerAddress cosmos.ConsAddres
 	}, nil
 }
 
+// evmConfigCache caches the EVMConfig of the current block by the proposer and the chain
+// id. It's reset at BeginBlock and disabled for the rest of the block once the params are
+// set, as the params may be reverted with the msg that set them. The base fee isn't cached,
+// the fee params can be updated in the block.
+type evmConfigCache struct {
+	mu       sync.Mutex
+	height   int64
+	disabled bool
+	proposer cosmos.ConsAddress
+	chainID  *big.Int
+	cfg      *states.EVMConfig
+}
+
+func newEVMConfigCache() *evmConfigCache {
+	return &evmConfigCache{}
+}
+
+// reset drops the cached config and enables the caching for the block at height.
+func (c *evmConfigCache) reset(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.height = height
+	c.disabled = false
+	c.proposer = nil
+	c.chainID = nil
+	c.cfg = nil
+}
+
+// disable drops the cached config and disables the caching until the next reset.
+func (c *evmConfigCache) disable() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disabled = true
+	c.cfg = nil
+}
+
+// get returns a deep copy of the cached config, nil if the cached one isn't for the given
+// height, proposer and chain id.
+func (c *evmConfigCache) get(height int64, proposer cosmos.ConsAddress, chainID *big.Int) *states.EVMConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cfg == nil || c.height != height || !c.proposer.Equals(proposer) || c.chainID.Cmp(chainID) != 0 {
+		return nil
+	}
+	return copyEVMConfig(c.cfg, c.chainID)
+}
+
+// set caches a deep copy of the config, it's ignored for the heights other than the current
+// block, e.g. the queries of the old blocks.
+func (c *evmConfigCache) set(height int64, proposer cosmos.ConsAddress, chainID *big.Int, cfg *states.EVMConfig) {
+	if chainID == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.disabled || c.height != height {
+		return
+	}
+	c.proposer = proposer
+	c.chainID = new(big.Int).Set(chainID)
+	c.cfg = copyEVMConfig(cfg, c.chainID)
+}
+
+// copyEVMConfig deep copies the params, the chain config and the base fee of the config,
+// so the callers can't modify the cached one. The chain config is rebuilt from the copied
+// params, as it's derived from them.
+func copyEVMConfig(cfg *states.EVMConfig, chainID *big.Int) *states.EVMConfig {
+	bz, err := cfg.Params.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	var params support.Params
+	if err := params.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	cpy := *cfg
+	cpy.Params = params
+	cpy.ChainConfig = params.ChainConfig.EthereumConfig(new(big.Int).Set(chainID))
+	if cfg.BaseFee != nil {
+		cpy.BaseFee = new(big.Int).Set(cfg.BaseFee)
+	}
+	return &cpy
+}
+
+// ResetEVMConfigCache drops the EVMConfig cached for the previous block, it's called at
+// BeginBlock.
+func (k *Keeper) ResetEVMConfigCache(ctx cosmos.Context) {
+	k.configCache.reset(ctx.BlockHeight())
+}
+
 func (k *Keeper) EVMConfigFromCtx(ctx cosmos.Context) (*states.EVMConfig, error) {
 	return k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
 }
 
-// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config support uses the default JumpTable from the EVM.
-func (k Keeper) VMConfig(ctx cosmos.Context, _ *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger) vm.Config {
+// VMOptions defines the node local vm.Config knobs, they only apply to the executions
+// of this node and don't affect consensus.
+type VMOptions struct {
+	// SimulationNoBaseFee forces the base fee to 0 for the simulated messages, e.g. eth_call.
+	SimulationNoBaseFee bool
+	// EnablePreimageRecording enables the recording of the SHA3/keccak preimages.
+	EnablePreimageRecording bool
+	// RPCGasCap is the max gas of the eth_call and eth_estimateGas queries, 0 means no cap.
+	RPCGasCap uint64
+}
+
+// SetVMOptions sets the node local vm.Config knobs.
+func (k *Keeper) SetVMOptions(opts VMOptions) {
+	k.vmOptions = opts
+}
+
+// QueryGasCap returns the gas cap of a simulated execution, the lower one of the cap
+// requested by the client and the node local RPCGasCap, 0 means no cap.
+func (k Keeper) QueryGasCap(requested uint64) uint64 {
+	gasCap := k.vmOptions.RPCGasCap
+	if requested != 0 && (gasCap == 0 || requested < gasCap) {
+		gasCap = requested
+	}
+	return gasCap
+}
+
+// gasCapExceeded returns the error of a query whose gas is higher than the query gas cap.
+func gasCapExceeded(gas, gasCap uint64) error {
+	return types.WrapWithData(types.ErrGasCapExceeded, types.ErrorData{
+		types.ErrorDataProvided: strconv.FormatUint(gas, 10),
+		types.ErrorDataLimit:    strconv.FormatUint(gasCap, 10),
+	}, "gas %d, cap %d", gas, gasCap)
+}
+
+// VMConfig creates an EVM configuration from the debug setting, the node local VM options and
+// the extra EIPs enabled on the module parameters. The config support uses the default JumpTable
+// from the EVM.
+func (k Keeper) VMConfig(ctx cosmos.Context, msg *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger) vm.Config {
 	noBaseFee := true
 	if support.IsLondon(cfg.ChainConfig, ctx.BlockHeight()) {
 		noBaseFee = k.feeKeeper.GetParams(ctx).NoBaseFee
 	}
 
+	// the simulated messages skip the account checks
+	if msg != nil && msg.SkipAccountChecks && k.vmOptions.SimulationNoBaseFee {
+		noBaseFee = true
+	}
+
 	return vm.Config{
-		Tracer:    tracer,
-		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		Tracer:                  tracer,
+		NoBaseFee:               noBaseFee,
+		EnablePreimageRecording: k.vmOptions.EnablePreimageRecording,
+		ExtraEips:               cfg.Params.EIPs(),
 	}
 }
 
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+)
+
+func TestEVMConfigCache(t *testing.T) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+	k.ResetEVMConfigCache(ctx)
+
+	cfg, err := k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Empty(t, cfg.Params.ExtraEIPs)
+
+	// the callers can't modify the cached config
+	cfg.Params.ExtraEIPs = append(cfg.Params.ExtraEIPs, 3855)
+	cfg.Params.ChainConfig.LondonBlock.BigInt().SetInt64(100)
+	cfg.ChainConfig.LondonBlock.SetInt64(100)
+	cached, err := k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Empty(t, cached.Params.ExtraEIPs)
+	require.Zero(t, cached.ChainConfig.LondonBlock.Sign())
+
+	// the params set in the block aren't cached, they may be reverted with the msg
+	params := k.GetParams(ctx)
+	params.EnableCreate = !params.EnableCreate
+	require.NoError(t, k.SetParams(ctx, params))
+	cfg, err = k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.EnableCreate, cfg.Params.EnableCreate)
+
+	params.EnableCreate = !params.EnableCreate
+	require.NoError(t, k.SetParams(ctx, params))
+	cfg, err = k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.EnableCreate, cfg.Params.EnableCreate)
+
+	// the caching is enabled again at BeginBlock of the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.ResetEVMConfigCache(ctx)
+	cfg, err = k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	cfg.Params.EnableCreate = !cfg.Params.EnableCreate
+	cached, err = k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.EnableCreate, cached.Params.EnableCreate)
+
+	// the params stored by other paths, e.g. the upgrades, are picked up at the next block
+	params.EnableCall = !params.EnableCall
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.ResetEVMConfigCache(ctx)
+	cfg, err = k.EVMConfigFromCtx(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.EnableCall, cfg.Params.EnableCall)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/artela-network/artela-evm/vm"
+	dbm "github.com/cometbft/cometbft-db"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// SetContractIndexDB sets the node local db where the creations of the contracts are indexed.
+func (k *Keeper) SetContractIndexDB(db dbm.DB) {
+	k.contractIndexDB = db
+}
+
+// contractIndexEnabled returns true if the creations of the contracts are indexed in the
+// given context, the check txs are skipped.
+func (k *Keeper) contractIndexEnabled(ctx cosmos.Context) bool {
+	return k.contractIndexDB != nil && !ctx.IsCheckTx() && !ctx.IsReCheckTx()
+}
+
+// SetContractCreations stores the creations of the contracts made by a committed tx. The
+// index is node local data and doesn't affect consensus, so a failed write is only logged.
+func (k *Keeper) SetContractCreations(ctx cosmos.Context, txHash common.Hash, creations []*types.ContractCreation) {
+	if k.contractIndexDB == nil || len(creations) == 0 {
+		return
+	}
+
+	batch := k.contractIndexDB.NewBatch()
+	defer batch.Close()
+
+	for _, creation := range creations {
+		creation.TxHash = txHash
+		bz, err := json.Marshal(creation)
+		if err != nil {
+			k.Logger(ctx).Error("failed to encode contract creation", "address", creation.Address.Hex(), "error", err)
+			return
+		}
+		if err := batch.Set(types.ContractCreationKey(creation.Address), bz); err != nil {
+			k.Logger(ctx).Error("failed to index contract creation", "address", creation.Address.Hex(), "error", err)
+			return
+		}
+	}
+	if err := batch.Write(); err != nil {
+		k.Logger(ctx).Error("failed to index contract creations", "error", err)
+	}
+}
+
+// creationFrame is a call frame of the contractCreationTracer, it holds the creations made
+// in the frame and its successful sub frames.
+type creationFrame struct {
+	creations []*types.ContractCreation
+}
+
+// contractCreationTracer wraps the tracer of a tx and collects the contracts created by
+// the tx. The creations made by a reverted call frame are dropped with the frame.
+type contractCreationTracer struct {
+	vm.EVMLogger
+
+	height int64
+	frames []*creationFrame
+	// salt of the CREATE2 opcode being executed
+	salt *common.Hash
+
+	creations []*types.ContractCreation
+}
+
+func newContractCreationTracer(tracer vm.EVMLogger, height int64) *contractCreationTracer {
+	return &contractCreationTracer{
+		EVMLogger: tracer,
+		height:    height,
+	}
+}
+
+// Creations returns the contracts created by the tx, available after the top call frames end.
+func (t *contractCreationTracer) Creations() []*types.ContractCreation {
+	return t.creations
+}
+
+func (t *contractCreationTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.enter(vm.CREATE, from, to, create, input)
+	t.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+func (t *contractCreationTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	if frame := t.exit(); frame != nil && err == nil {
+		t.creations = append(t.creations, frame.creations...)
+	}
+	t.EVMLogger.CaptureEnd(output, gasUsed, err)
+}
+
+func (t *contractCreationTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.enter(typ, from, to, typ == vm.CREATE || typ == vm.CREATE2, input)
+	t.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+func (t *contractCreationTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if frame := t.exit(); frame != nil && err == nil && len(t.frames) > 0 {
+		parent := t.frames[len(t.frames)-1]
+		parent.creations = append(parent.creations, frame.creations...)
+	}
+	t.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+func (t *contractCreationTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	t.salt = nil
+	// CREATE2 pops value, offset, size and salt
+	if op == vm.CREATE2 && scope != nil && scope.Stack != nil && len(scope.Stack.Data()) >= 4 {
+		salt := common.Hash(scope.Stack.Back(3).Bytes32())
+		t.salt = &salt
+	}
+	t.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+func (t *contractCreationTracer) enter(typ vm.OpCode, from, to common.Address, create bool, input []byte) {
+	frame := &creationFrame{}
+	if create {
+		creation := &types.ContractCreation{
+			Address:      to,
+			Creator:      from,
+			BlockNumber:  hexutil.Uint64(t.height),
+			InitCodeHash: crypto.Keccak256Hash(input),
+		}
+		if typ == vm.CREATE2 {
+			creation.Salt = t.salt
+		}
+		frame.creations = append(frame.creations, creation)
+	}
+	t.salt = nil
+	t.frames = append(t.frames, frame)
+}
+
+func (t *contractCreationTracer) exit() *creationFrame {
+	if len(t.frames) == 0 {
+		return nil
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	return frame
+}
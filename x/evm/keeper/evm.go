@@ -42,7 +42,7 @@ func (k *Keeper) NewEVM(
 ) *vm.EVM {
 	blockCtx := vm.BlockContext{
 		CanTransfer: artcore.CanTransfer,
-		Transfer:    artcore.Transfer,
+		Transfer:    k.Transfer,
 		GetHash:     k.GetHashFn(ctx),
 		Coinbase:    cfg.CoinBase,
 		GasLimit:    artela.BlockGasLimit(ctx),
@@ -52,6 +52,7 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := artcore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -98,6 +99,12 @@ func (k Keeper) GetHashFn(ctx cosmos.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The hashes of the recent blocks are looked up first, they are the block hashes served over RPC,
+			// the staking historical info covers the blocks before the hashes were stored.
+			if hash, found := k.GetHeaderHash(ctx, h); found {
+				return hash
+			}
+
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -136,10 +143,7 @@ func (k Keeper) GetHashFn(ctx cosmos.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction) (*txs.MsgEthereumTxResponse, error) {
-	var (
-		bloom        *big.Int
-		bloomReceipt ethereum.Bloom
-	)
+	var bloomReceipt ethereum.Bloom
 
 	// build evm config and txs config
 	evmConfig, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
@@ -185,11 +189,9 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 
 	logs := support.LogsToEthereum(res.Logs)
 
-	// Compute block bloom filter
+	// the bloom of the receipt, it's aggregated to the block bloom at EndBlock
 	if len(logs) > 0 {
-		bloom = k.GetBlockBloomTransient(ctx)
-		bloom.Or(bloom, big.NewInt(0).SetBytes(ethereum.LogsBloom(logs)))
-		bloomReceipt = ethereum.BytesToBloom(bloom.Bytes())
+		bloomReceipt = ethereum.BytesToBloom(ethereum.LogsBloom(logs))
 	}
 
 	cumulativeGasUsed := res.GasUsed
@@ -225,9 +227,9 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		receipt.Status = ethereum.ReceiptStatusSuccessful
 
 		if commit != nil {
+			// the events of tmpCtx are forwarded to ctx by commit
 			commit()
 			res.Logs = support.NewLogsFromEth(receipt.Logs)
-			ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
 		}
 	}
 
@@ -237,13 +239,21 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	}
 
 	if len(receipt.Logs) > 0 {
-		// Update transient block bloom filter
-		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
+		k.SetTxBloomTransient(ctx, uint64(txConfig.TxIndex), receipt.Bloom)
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(receipt.Logs)))
 	}
 
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
 
+	if err := k.AddReceiptToExecutionDigest(ctx, receipt); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add the receipt to the execution digest")
+	}
+
+	// the receipts of the delivered txs are readable by the aspects of the next txs
+	if !ctx.IsCheckTx() && k.BlockContext != nil {
+		k.BlockContext.AddReceipt(receipt)
+	}
+
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
@@ -274,6 +284,16 @@ func (k *Keeper) ApplyMessage(ctx cosmos.Context, msg *core.Message, tracer vm.E
 	return k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commit, evmConfig, txConfig)
 }
 
+// newStateDB creates the states a message is executed against, with the state overrides
+// of the config applied on top of the keeper states.
+func (k *Keeper) newStateDB(ctx cosmos.Context, cfg *states.EVMConfig, txConfig states.TxConfig) (*states.StateDB, error) {
+	stateDB := states.New(ctx, k, txConfig)
+	if err := cfg.Overrides.Apply(stateDB); err != nil {
+		return nil, err
+	}
+	return stateDB, nil
+}
+
 // ApplyMessageWithConfig computes the new states by applying the given message against the existing states.
 // If the message fails, the VM execution error with the reason will be returned to the client
 // and the txs won't be committed to the store.
@@ -312,6 +332,11 @@ func (k *Keeper) ApplyMessage(ctx cosmos.Context, msg *core.Message, tracer vm.E
 // # Commit parameter
 //
 // If commit is true, the `StateDB` will be committed, otherwise discarded.
+//
+// # Gas cap
+//
+// The queried messages, which are not committed, are rejected if their gas is higher than
+// the gas cap of the config, so the simulations can't run unbounded.
 func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	aspectCtx *artelatypes.AspectRuntimeContext,
 	msg *core.Message,
@@ -325,6 +350,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
+	// return error if the calls and creates are paused in an emergency
+	if err := k.CheckPaused(ctx); err != nil {
+		return nil, err
+	}
+
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To == nil {
 		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
@@ -332,7 +362,22 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
-	stateDB := states.New(ctx, k, txConfig)
+	// return error if the gas of a query is higher than the query gas cap
+	if !commit && cfg.GasCap != 0 && msg.GasLimit > cfg.GasCap {
+		return nil, gasCapExceeded(msg.GasLimit, cfg.GasCap)
+	}
+
+	// index the contracts created by the committed txs with the default tracer
+	var creationTracer *contractCreationTracer
+	if tracer == nil && commit && k.contractIndexEnabled(ctx) {
+		creationTracer = newContractCreationTracer(k.Tracer(ctx, msg, cfg.ChainConfig), ctx.BlockHeight())
+		tracer = creationTracer
+	}
+
+	stateDB, err := k.newStateDB(ctx, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	// Aspect Runtime Context Lifecycle: set EVM params.
@@ -361,7 +406,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	contractCreation := msg.To == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
 
-	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation, cfg.Params.CalldataGasPerToken)
 	if err != nil {
 		// should have already been checked on Ante Handler
 		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
@@ -376,7 +421,8 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix())); rules.IsBerlin {
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	if rules.IsBerlin {
 		stateDB.PrepareAccessList(msg.From, msg.To, vm.ActivePrecompiles(rules), msg.AccessList)
 	}
 	lastHeight := uint64(ctx.BlockHeight())
@@ -397,76 +443,93 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		ret, _, leftoverGas, vmErr = evm.Create(aspectCtx, sender, msg.Data, leftoverGas, msg.Value)
 		stateDB.SetNonce(sender.Address(), msg.Nonce+1)
 	} else {
-		// begin pre tx aspect execution
-
-		preTxResult := djpm.AspectInstance().PreTxExecute(aspectCtx, msg.To, ctx.BlockHeight(), leftoverGas, &asptypes.PreTxExecuteInput{
-			Tx: &asptypes.WithFromTxInput{
-				Hash: aspectCtx.EthTxContext().TxContent().Hash().Bytes(),
-				To:   msg.To.Bytes(),
-				From: msg.From.Bytes(),
-			},
-			Block: &asptypes.BlockInput{Number: &lastHeight},
-		})
-
-		leftoverGas = preTxResult.Gas
-		if preTxResult.Err != nil {
-			// short circuit if pre tx failed
-			vmErr = preTxResult.Err
+		// the aspects bound to the target are warmed up before the join points run, their
+		// access is charged as intrinsic gas, see AspectAccessGas. It's checked by the ante
+		// handler in check txs, but the bindings may change until the tx is delivered, running
+		// short of the gas fails the tx like running out of gas in the EVM.
+		aspects, err := k.accessedAspects(ctx, cfg.Params, cfg.ChainConfig, msg.To)
+		if err != nil {
+			return nil, err
+		}
+		for _, aspect := range aspects {
+			stateDB.AddAddressToAccessList(aspect)
+		}
+		if accessGas := aspectAccessGas(aspects, msg.AccessList); leftoverGas < accessGas {
+			vmErr, leftoverGas = vm.ErrOutOfGas, 0
 		} else {
-			// execute evm call
-			ret, leftoverGas, vmErr = evm.Call(aspectCtx, sender, *msg.To, msg.Data, leftoverGas, msg.Value)
-			status := ethereum.ReceiptStatusSuccessful
-			if vmErr != nil {
-				status = ethereum.ReceiptStatusFailed
-			}
+			leftoverGas -= accessGas
 
-			logs := stateDB.Logs()
+			// begin pre tx aspect execution
 
-			// compute block bloom filter
-			var bloomReceipt ethereum.Bloom
-			if len(logs) > 0 {
-				bloom := k.GetBlockBloomTransient(ctx)
-				bloom.Or(bloom, big.NewInt(0).SetBytes(ethereum.LogsBloom(logs)))
-				bloomReceipt = ethereum.BytesToBloom(bloom.Bytes())
-			}
+			preTxResult := djpm.AspectInstance().PreTxExecute(aspectCtx, msg.To, ctx.BlockHeight(), leftoverGas, &asptypes.PreTxExecuteInput{
+				Tx: &asptypes.WithFromTxInput{
+					Hash: aspectCtx.EthTxContext().TxContent().Hash().Bytes(),
+					To:   msg.To.Bytes(),
+					From: msg.From.Bytes(),
+				},
+				Block: &asptypes.BlockInput{Number: &lastHeight},
+			})
 
-			// compute gas
-			gasUsed := msg.GasLimit - leftoverGas
-			cumulativeGasUsed := gasUsed
-			if ctx.BlockGasMeter() != nil {
-				limit := ctx.BlockGasMeter().Limit()
-				cumulativeGasUsed += ctx.BlockGasMeter().GasConsumed()
-				if cumulativeGasUsed > limit {
-					cumulativeGasUsed = limit
+			captureAspectEnd(evm.Config.Tracer, leftoverGas, preTxResult)
+			leftoverGas = preTxResult.Gas
+			if preTxResult.Err != nil {
+				// short circuit if pre tx failed
+				vmErr = preTxResult.Err
+			} else {
+				// execute evm call
+				ret, leftoverGas, vmErr = evm.Call(aspectCtx, sender, *msg.To, msg.Data, leftoverGas, msg.Value)
+				status := ethereum.ReceiptStatusSuccessful
+				if vmErr != nil {
+					status = ethereum.ReceiptStatusFailed
 				}
-			}
 
-			// set receipt
-			aspectCtx.EthTxContext().WithReceipt(&ethereum.Receipt{
-				Status:            status,
-				Bloom:             bloomReceipt,
-				Logs:              logs,
-				GasUsed:           gasUsed,
-				CumulativeGasUsed: cumulativeGasUsed,
-			})
+				logs := stateDB.Logs()
+
+				// compute the bloom of the receipt
+				var bloomReceipt ethereum.Bloom
+				if len(logs) > 0 {
+					bloomReceipt = ethereum.BytesToBloom(ethereum.LogsBloom(logs))
+				}
 
-			// begin post tx aspect execution
-			postTxResult := djpm.AspectInstance().PostTxExecute(aspectCtx, msg.To, ctx.BlockHeight(), leftoverGas,
-				&asptypes.PostTxExecuteInput{
-					Tx: &asptypes.WithFromTxInput{
-						Hash: aspectCtx.EthTxContext().TxContent().Hash().Bytes(),
-						To:   msg.To.Bytes(),
-						From: msg.From.Bytes(),
-					},
-					Block:   &asptypes.BlockInput{Number: &lastHeight},
-					Receipt: &asptypes.ReceiptInput{Status: &status},
+				// compute gas
+				gasUsed := msg.GasLimit - leftoverGas
+				cumulativeGasUsed := gasUsed
+				if ctx.BlockGasMeter() != nil {
+					limit := ctx.BlockGasMeter().Limit()
+					cumulativeGasUsed += ctx.BlockGasMeter().GasConsumed()
+					if cumulativeGasUsed > limit {
+						cumulativeGasUsed = limit
+					}
+				}
+
+				// set receipt
+				aspectCtx.EthTxContext().WithReceipt(&ethereum.Receipt{
+					Status:            status,
+					Bloom:             bloomReceipt,
+					Logs:              logs,
+					GasUsed:           gasUsed,
+					CumulativeGasUsed: cumulativeGasUsed,
 				})
-			if postTxResult.Err != nil {
-				// overwrite vmErr if post tx reverted
-				vmErr = postTxResult.Err
-				ret = postTxResult.Ret
+
+				// begin post tx aspect execution
+				postTxResult := djpm.AspectInstance().PostTxExecute(aspectCtx, msg.To, ctx.BlockHeight(), leftoverGas,
+					&asptypes.PostTxExecuteInput{
+						Tx: &asptypes.WithFromTxInput{
+							Hash: aspectCtx.EthTxContext().TxContent().Hash().Bytes(),
+							To:   msg.To.Bytes(),
+							From: msg.From.Bytes(),
+						},
+						Block:   &asptypes.BlockInput{Number: &lastHeight},
+						Receipt: &asptypes.ReceiptInput{Status: &status},
+					})
+				captureAspectEnd(evm.Config.Tracer, leftoverGas, postTxResult)
+				if postTxResult.Err != nil {
+					// overwrite vmErr if post tx reverted
+					vmErr = postTxResult.Err
+					ret = postTxResult.Ret
+				}
+				leftoverGas = postTxResult.Gas
 			}
-			leftoverGas = postTxResult.Gas
 		}
 	}
 
@@ -500,6 +563,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+		if creationTracer != nil {
+			k.SetContractCreations(ctx, txConfig.TxHash, creationTracer.Creations())
+		}
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
@@ -526,3 +592,18 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 		Hash:    txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// captureAspectEnd reports the result of a tx level join point to the tracer,
+// if it's an AspectTracer. gas is the gas available to the join point.
+func captureAspectEnd(tracer vm.EVMLogger, gas uint64, result *asptypes.AspectExecutionResult) {
+	aspectTracer, ok := tracer.(artelatypes.AspectTracer)
+	if !ok {
+		return
+	}
+
+	var gasUsed uint64
+	if gas > result.Gas {
+		gasUsed = gas - result.Gas
+	}
+	aspectTracer.CaptureAspectEnd(result.Ret, gas, gasUsed, result.Err)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"strconv"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// GetExecutionDigestTransient returns the execution digest of the txs applied so far in the
+// current block.
+func (k Keeper) GetExecutionDigestTransient(ctx cosmos.Context) types.ExecutionDigest {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientExecutionDigest)
+	if len(bz) == 0 {
+		return types.ExecutionDigest{}
+	}
+
+	digest, err := types.UnmarshalExecutionDigest(bz)
+	if err != nil {
+		panic(err)
+	}
+	return digest
+}
+
+// AddReceiptToExecutionDigest folds the receipt of an applied tx into the execution digest
+// of the current block.
+func (k Keeper) AddReceiptToExecutionDigest(ctx cosmos.Context, receipt *ethereum.Receipt) error {
+	digest := k.GetExecutionDigestTransient(ctx)
+	if err := digest.AddReceipt(receipt); err != nil {
+		return err
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientExecutionDigest, digest.Marshal())
+	return nil
+}
+
+// EmitExecutionDigestEvent emits the execution digest of the block, it's called at the end
+// of the block. The digest is emitted even if the block has no ethereum tx.
+func (k Keeper) EmitExecutionDigestEvent(ctx cosmos.Context) {
+	digest := k.GetExecutionDigestTransient(ctx)
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent(
+			types.EventTypeExecutionDigest,
+			cosmos.NewAttribute(types.AttributeKeyDigest, digest.Digest.Hex()),
+			cosmos.NewAttribute(types.AttributeKeyDigestTxs, strconv.FormatUint(digest.Txs, 10)),
+			cosmos.NewAttribute(types.AttributeKeyDigestGasUsed, strconv.FormatUint(digest.GasUsed, 10)),
+		),
+	)
+}
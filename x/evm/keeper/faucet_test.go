@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestFaucetDrip(t *testing.T) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+	denom := k.GetParams(ctx).EvmDenom
+
+	operator := cosmos.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	recipient := cosmos.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000002").Bytes())
+	faucet := authtypes.NewModuleAddress(types.FaucetAccountName)
+
+	// the faucet account is funded at genesis, it's blocked from receiving regular transfers
+	coins := cosmos.NewCoins(cosmos.NewCoin(denom, sdkmath.NewInt(1500)))
+	require.NoError(t, artela.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, artela.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.FaucetAccountName, coins))
+
+	drip := &txs.MsgFaucetDrip{
+		Operator:  operator.String(),
+		Recipient: recipient.String(),
+		Amount:    "1000" + denom,
+	}
+	require.NoError(t, drip.ValidateBasic())
+
+	// the faucet is disabled without an operator
+	_, err := k.FaucetDrip(ctx, drip)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+
+	params := k.GetParams(ctx)
+	params.FaucetOperator = operator.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = k.FaucetDrip(ctx, drip)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(1000), artela.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	require.Equal(t, sdkmath.NewInt(500), artela.BankKeeper.GetBalance(ctx, faucet, denom).Amount)
+
+	// the drips are limited to the funds of the faucet account
+	_, err = k.FaucetDrip(ctx, drip)
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFunds)
+
+	// only the operator sends the funds of the faucet account
+	_, err = k.FaucetDrip(ctx, &txs.MsgFaucetDrip{
+		Operator:  recipient.String(),
+		Recipient: recipient.String(),
+		Amount:    "100" + denom,
+	})
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// secondsPerDay is the length of the UTC days of the daily gas budgets.
+const secondsPerDay = 24 * 60 * 60
+
+// GetGasPolicy returns the gas policy of the account.
+func (k Keeper) GetGasPolicy(ctx cosmos.Context, address common.Address) (support.GasPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var policy support.GasPolicy
+	bz := store.Get(types.GasPolicyKey(address))
+	if len(bz) == 0 {
+		return policy, false
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// setGasPolicy stores the gas policy of the account, the policy without any cap is removed
+// along with the daily gas spent by the account.
+func (k Keeper) setGasPolicy(ctx cosmos.Context, address common.Address, policy support.GasPolicy) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !policy.HasCaps() {
+		store.Delete(types.GasPolicyKey(address))
+		store.Delete(types.GasPolicyUsageKey(address))
+		return
+	}
+	store.Set(types.GasPolicyKey(address), k.cdc.MustMarshal(&policy))
+}
+
+// GetDailyGasUsed returns the total gas limit of the txs of the account accepted in the
+// current UTC day.
+func (k Keeper) GetDailyGasUsed(ctx cosmos.Context, address common.Address) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GasPolicyUsageKey(address))
+	if len(bz) != 16 || cosmos.BigEndianToUint64(bz[:8]) != gasPolicyDay(ctx) {
+		return 0
+	}
+	return cosmos.BigEndianToUint64(bz[8:])
+}
+
+// setDailyGasUsed stores the gas spent by the account in the current UTC day.
+func (k Keeper) setDailyGasUsed(ctx cosmos.Context, address common.Address, gasUsed uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := append(cosmos.Uint64ToBigEndian(gasPolicyDay(ctx)), cosmos.Uint64ToBigEndian(gasUsed)...)
+	store.Set(types.GasPolicyUsageKey(address), bz)
+}
+
+// gasPolicyDay returns the index of the UTC day of the current block.
+func gasPolicyDay(ctx cosmos.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix() / secondsPerDay) // #nosec G701
+}
+
+// CheckGasPolicy checks the ethereum tx against the gas policy of its sender, and adds the
+// gas limit of the tx to the gas spent by the sender today. It's called by the ante handler,
+// so the txs breaking the policy are rejected before they get into the mempool or a block,
+// and the gas limit counts against the budget whether the tx succeeds or not.
+func (k Keeper) CheckGasPolicy(ctx cosmos.Context, from common.Address, tx *ethereum.Transaction) error {
+	policy, found := k.GetGasPolicy(ctx, from)
+	if !found {
+		return nil
+	}
+
+	if policy.MaxGasPrice != "" {
+		maxGasPrice, _ := sdkmath.NewIntFromString(policy.MaxGasPrice)
+		if tx.GasFeeCap().Cmp(maxGasPrice.BigInt()) > 0 {
+			return types.WrapWithData(types.ErrGasPolicyViolated, types.ErrorData{
+				types.ErrorDataAddress:  from.Hex(),
+				types.ErrorDataProvided: tx.GasFeeCap().String(),
+				types.ErrorDataLimit:    policy.MaxGasPrice,
+			}, "gas price %s exceeds the max gas price %s of the gas policy of %s", tx.GasFeeCap(), policy.MaxGasPrice, from.Hex())
+		}
+	}
+
+	if !policy.IsTargetAllowed(tx.To()) {
+		if tx.To() == nil {
+			return types.WrapWithData(types.ErrGasPolicyViolated, types.ErrorData{
+				types.ErrorDataAddress: from.Hex(),
+			}, "contract creation is not allowed by the gas policy of %s", from.Hex())
+		}
+		return types.WrapWithData(types.ErrGasPolicyViolated, types.ErrorData{
+			types.ErrorDataAddress: from.Hex(),
+		}, "target %s is not allowed by the gas policy of %s", tx.To().Hex(), from.Hex())
+	}
+
+	if policy.DailyGasBudget > 0 {
+		// the budget may be lowered below the gas spent today
+		gasUsed, gasLeft := k.GetDailyGasUsed(ctx, from), uint64(0)
+		if gasUsed < policy.DailyGasBudget {
+			gasLeft = policy.DailyGasBudget - gasUsed
+		}
+		if tx.Gas() > gasLeft {
+			return types.WrapWithData(types.ErrGasPolicyViolated, types.ErrorData{
+				types.ErrorDataAddress:  from.Hex(),
+				types.ErrorDataProvided: strconv.FormatUint(tx.Gas(), 10),
+				types.ErrorDataLimit:    strconv.FormatUint(gasLeft, 10),
+			}, "gas limit %d exceeds the %d gas left today in the daily gas budget of %s", tx.Gas(), gasLeft, from.Hex())
+		}
+		k.setDailyGasUsed(ctx, from, gasUsed+tx.Gas())
+	}
+	return nil
+}
+
+// updateGasPolicy sets the gas policy of an account, or removes it if the policy has no cap.
+// An account without a policy sets its own, the policy can then only be updated or removed
+// by its admin, so the policy of a hot key with a cold admin can't be lifted by the hot key.
+func (k Keeper) updateGasPolicy(ctx cosmos.Context, sender string, policy support.GasPolicy) error {
+	addr, err := cosmos.AccAddressFromBech32(policy.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid gas policy address")
+	}
+	address := common.BytesToAddress(addr)
+
+	admin := policy.Address
+	if current, found := k.GetGasPolicy(ctx, address); found {
+		admin = current.EffectiveAdmin()
+	}
+	if sender != admin {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "gas policy of %s can only be set by %s", policy.Address, admin)
+	}
+
+	k.setGasPolicy(ctx, address, policy)
+
+	state := types.GasPolicyStateSet
+	if !policy.HasCaps() {
+		state = types.GasPolicyStateRemoved
+	}
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(
+		types.EventTypeGasPolicy,
+		cosmos.NewAttribute(types.AttributeKeyGasPolicyOwner, policy.Address),
+		cosmos.NewAttribute(types.AttributeKeyGasPolicyAdmin, policy.EffectiveAdmin()),
+		cosmos.NewAttribute(types.AttributeKeyGasPolicyState, state),
+	))
+	return nil
+}
@@ -26,12 +26,17 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	"github.com/artela-network/artela/x/evm/artela/contract"
 	"github.com/artela-network/artela/x/evm/artela/provider"
 	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
 	"github.com/artela-network/artela/x/evm/states"
+	// register the native tracers
+	_ "github.com/artela-network/artela/x/evm/tracers/native"
 	"github.com/artela-network/artela/x/evm/types"
 	inherent "github.com/artela-network/aspect-core/chaincoreext/jit_inherent"
 )
@@ -206,10 +211,11 @@ func (k Keeper) Code(c context.Context, req *txs.QueryCodeRequest) (*txs.QueryCo
 	}, nil
 }
 
-// Params implements the Query/Params gRPC method
+// Params implements the Query/Params gRPC method, it returns the params that were active
+// at the queried height.
 func (k Keeper) Params(c context.Context, _ *txs.QueryParamsRequest) (*txs.QueryParamsResponse, error) {
 	ctx := cosmos.UnwrapSDKContext(c)
-	params := k.GetParams(ctx)
+	params := k.GetParamsAtHeight(ctx, ctx.BlockHeight())
 
 	return &txs.QueryParamsResponse{
 		Params: params,
@@ -241,14 +247,38 @@ func (k Keeper) EthCall(c context.Context, req *txs.EthCallRequest) (*txs.MsgEth
 
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, types.StatusError(codes.Internal, err)
+	}
+	// the call against the pending block sees the state after the pending txs, which are
+	// applied before the overrides of the call
+	k.applyPendingTxs(ctx, cfg, req.Pending)
+
+	if cfg.Overrides, err = parseStateOverride(req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if cfg.BlockOverrides, err = parseBlockOverrides(req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	gasCap := k.QueryGasCap(req.GasCap)
+	if args.Gas != nil && gasCap != 0 && uint64(*args.Gas) > gasCap {
+		return nil, types.StatusError(codes.InvalidArgument, gasCapExceeded(uint64(*args.Gas), gasCap))
 	}
+	cfg.GasCap = gasCap
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if account, ok := cfg.Overrides[args.GetFrom()]; ok && account.Nonce != nil {
+		nonce = uint64(*account.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	// the gas price of the message is derived from the overridden base fee, as the EVM sees it
+	baseFee := cfg.BaseFee
+	if cfg.BlockOverrides != nil && cfg.BlockOverrides.BaseFee != nil {
+		baseFee = cfg.BlockOverrides.BaseFee.ToInt()
+	}
+	msg, err := args.ToMessage(gasCap, baseFee)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -264,12 +294,56 @@ func (k Keeper) EthCall(c context.Context, req *txs.EthCallRequest) (*txs.MsgEth
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, nil, false, cfg, txConfig)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, types.StatusError(codes.Internal, err)
 	}
 
 	return res, nil
 }
 
+// applyPendingTxs applies the pending txs on top of the state of ctx, the txs failing to apply
+// are skipped like they would be dropped from the block. The nonce of the sender is bumped for
+// the calls too, as it's done by the ante handler during the block execution.
+func (k Keeper) applyPendingTxs(ctx cosmos.Context, cfg *states.EVMConfig, pending []*txs.MsgEthereumTx) {
+	if len(pending) == 0 {
+		return
+	}
+
+	signer := ethereum.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for i, tx := range pending {
+		ethTx := tx.AsTransaction()
+		msg, err := txs.ToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		if k.GetNonce(ctx, msg.From) != msg.Nonce {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+
+		// Aspect Runtime Context Lifecycle: create aspect context.
+		// This marks the beginning of running an aspect of the pending tx, creating the aspect context,
+		// and establishing the link with the SDK context.
+		txCtx, aspectCtx := k.WithAspectContext(ctx, ethTx, cfg,
+			artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
+		rsp, err := k.ApplyMessageWithConfig(txCtx, aspectCtx, msg, txs.NewNoOpTracer(), true, cfg, txConfig)
+		aspectCtx.Destroy()
+		if err != nil {
+			continue
+		}
+		if msg.To != nil {
+			account := k.GetAccountOrEmpty(ctx, msg.From)
+			account.Nonce = msg.Nonce + 1
+			if err := k.SetAccount(ctx, msg.From, account); err != nil {
+				continue
+			}
+		}
+
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.EstimateGasResponse, error) {
 	if req == nil {
@@ -282,7 +356,8 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GasCap < ethparams.TxGas {
+	queryGasCap := k.QueryGasCap(req.GasCap)
+	if queryGasCap < ethparams.TxGas {
 		return nil, status.Error(codes.InvalidArgument, "gas cap cannot be lower than 21,000")
 	}
 
@@ -292,6 +367,10 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if args.Gas != nil && uint64(*args.Gas) > queryGasCap {
+		return nil, types.StatusError(codes.InvalidArgument, gasCapExceeded(uint64(*args.Gas), queryGasCap))
+	}
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -299,22 +378,12 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		gasCap uint64
 	)
 
-	// Determine the highest gas limit can be used during the estimation.
+	// Determine the highest gas limit can be used during the estimation, the query gas cap
+	// is used instead of the block gas limit, so the estimation is independent from consensus.
 	if args.Gas != nil && uint64(*args.Gas) >= ethparams.TxGas {
 		hi = uint64(*args.Gas)
 	} else {
-		// Query block gas limit
-		params := ctx.ConsensusParams()
-		if params != nil && params.Block != nil && params.Block.MaxGas > 0 {
-			hi = uint64(params.Block.MaxGas)
-		} else {
-			hi = req.GasCap
-		}
-	}
-
-	// Recap the highest gas allowance with specified gascap.
-	if req.GasCap != 0 && hi > req.GasCap {
-		hi = req.GasCap
+		hi = queryGasCap
 	}
 	txMsg := args.ToTransaction()
 
@@ -323,6 +392,10 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if cfg.Overrides, err = parseStateOverride(req.Overrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg.GasCap = queryGasCap
 
 	// Aspect Runtime Context Lifecycle: create aspect context.
 	// This marks the beginning of running an aspect of EstimateGas, creating the aspect context,
@@ -331,16 +404,30 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
 	defer aspectCtx.Destroy()
 
+	// The bound aspects run as for the tx, with their side effects, so the estimation includes
+	// the gas they consume at the join points. The side effects of each run are discarded.
+	aspectCtx.SetReadOnly(false)
+	aspectCtx.EthTxContext().WithEstimate(true)
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if account, ok := cfg.Overrides[args.GetFrom()]; ok && account.Nonce != nil {
+		nonce = uint64(*account.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
 	// convert the txs args to an ethereum message
-	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	msg, err := args.ToMessage(queryGasCap, cfg.BaseFee)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, types.StatusError(codes.Internal, err)
+	}
+
+	// the validation data of the txs verified by aspects is not executed, as for the tx
+	msg.Data, err = k.processMsgData(txMsg.AsTransaction())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// NOTE: the errors from the executable below should be consistent with go-ethereum,
@@ -350,8 +437,11 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	executable := func(gas uint64) (vmError bool, rsp *txs.MsgEthereumTxResponse, err error) {
 		// update the message with the new gas value
 		msg.GasLimit = gas
+		// run in a temp ctx, so the aspect states written by a run don't leak into the next one
+		tmpCtx, _ := ctx.CacheContext()
+		aspectCtx.WithCosmosContext(tmpCtx)
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(ctx, aspectCtx, msg, nil, false, cfg, txConfig)
+		rsp, err = k.ApplyMessageWithConfig(tmpCtx, aspectCtx, msg, nil, false, cfg, txConfig)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -377,7 +467,9 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 		if failed {
 			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
 				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
+					// the revert data is returned to the caller in the response, it's lost in
+					// the gRPC errors
+					return &txs.EstimateGasResponse{Ret: result.Ret, VmError: result.VmError}, nil
 				}
 				return nil, errors.New(result.VmError)
 			}
@@ -388,6 +480,118 @@ func (k Keeper) EstimateGas(c context.Context, req *txs.EthCallRequest) (*txs.Es
 	return &txs.EstimateGasResponse{Gas: hi}, nil
 }
 
+// parseStateOverride parses the json state overrides of a query, nil if there is none.
+func parseStateOverride(bz []byte) (states.StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var overrides states.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// parseBlockOverrides parses the json block context overrides of a query, nil if there is none.
+func parseBlockOverrides(bz []byte) (*states.BlockOverrides, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	overrides := &states.BlockOverrides{}
+	if err := json.Unmarshal(bz, overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// CreateAccessList implements eth_createAccessList rpc api. The message is run with an
+// access list tracer against the current state, the run is repeated with the generated
+// access list until the list doesn't change, as the access list affects the gas of the
+// calls and so the execution path.
+func (k Keeper) CreateAccessList(c context.Context, req *txs.EthCallRequest) (*txs.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args txs.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, types.StatusError(codes.Internal, err)
+	}
+
+	gasCap := k.QueryGasCap(req.GasCap)
+	if args.Gas != nil && gasCap != 0 && uint64(*args.Gas) > gasCap {
+		return nil, types.StatusError(codes.InvalidArgument, gasCapExceeded(uint64(*args.Gas), gasCap))
+	}
+	cfg.GasCap = gasCap
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the created contract is the recipient of a contract creation
+	to := crypto.CreateAddress(from, nonce)
+	if args.To != nil {
+		to = *args.To
+	}
+
+	// the precompiles are warm and don't need to be in the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	precompiles := vm.ActivePrecompiles(rules)
+
+	var initial ethereum.AccessList
+	if args.AccessList != nil {
+		initial = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(initial, from, to, precompiles)
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// This marks the beginning of running an aspect of CreateAccessList, creating the aspect context,
+	// and establishing the link with the SDK context.
+	ctx, aspectCtx := k.WithAspectContext(ctx, args.ToTransaction().AsEthCallTransaction(), cfg,
+		artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
+	defer aspectCtx.Destroy()
+
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to apply transaction: %s", err.Error()))
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &txs.CreateAccessListResponse{
+				AccessList: txs.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -415,7 +619,7 @@ func (k Keeper) TraceTx(c context.Context, req *txs.QueryTraceTxRequest) (*txs.Q
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	cfg, err := k.EVMConfigAtHeight(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, contextHeight)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
@@ -467,7 +671,7 @@ func (k Keeper) TraceTx(c context.Context, req *txs.QueryTraceTxRequest) (*txs.Q
 
 	resultData, err := json.Marshal(result)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, types.StatusError(codes.Internal, err)
 	}
 
 	return &txs.QueryTraceTxResponse{
@@ -503,7 +707,7 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	cfg, err := k.EVMConfigAtHeight(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, contextHeight)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
@@ -529,7 +733,7 @@ func (k Keeper) TraceBlock(c context.Context, req *txs.QueryTraceBlockRequest) (
 
 	resultData, err := json.Marshal(results)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, types.StatusError(codes.Internal, err)
 	}
 
 	return &txs.QueryTraceBlockResponse{
@@ -571,7 +775,7 @@ func (k *Keeper) traceTx(
 
 	msg, err := txs.ToMessage(tx, signer, cfg.BaseFee)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, types.StatusError(codes.Internal, err)
 	}
 
 	if traceConfig == nil {
@@ -602,7 +806,7 @@ func (k *Keeper) traceTx(
 
 	if traceConfig.Tracer != "" {
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
-			return nil, 0, status.Error(codes.Internal, err.Error())
+			return nil, 0, types.StatusError(codes.Internal, err)
 		}
 	}
 
@@ -626,12 +830,12 @@ func (k *Keeper) traceTx(
 
 	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commitMessage, cfg, txConfig)
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, types.StatusError(codes.Internal, err)
 	}
 	var result interface{}
 	result, err = tracer.GetResult()
 	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
+		return nil, 0, types.StatusError(codes.Internal, err)
 	}
 
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
@@ -684,6 +888,8 @@ func (k Keeper) WithAspectContext(ctx cosmos.Context, tx *ethereum.Transaction,
 	ethTxContext.WithEVMConfig(evmConf)
 
 	aspectCtx := artelatypes.NewAspectRuntimeContext()
+	// the queries simulate the txs, the aspects must not have side effects
+	aspectCtx.SetReadOnly(true)
 	protocol := provider.NewAspectProtocolProvider(aspectCtx.EthTxContext)
 	jitManager := inherent.NewManager(protocol)
 
@@ -701,3 +907,195 @@ func getChainID(ctx cosmos.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// AspectMetadata implements the Query/AspectMetadata gRPC method, it returns the json
+// encoded metadata of the aspect for the aspect tooling.
+func (k Keeper) AspectMetadata(c context.Context, req *txs.QueryAspectMetadataRequest) (*txs.QueryAspectMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := artela.ValidateAddress(req.AspectId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	store := contract.NewAspectStore(k.storeKey, k.Logger(ctx))
+	metadata := store.GetAspectMetadata(ctx, common.HexToAddress(req.AspectId))
+	if metadata == nil {
+		return nil, status.Errorf(codes.NotFound, "aspect %s not found", req.AspectId)
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, types.StatusError(codes.Internal, err)
+	}
+	return &txs.QueryAspectMetadataResponse{
+		Data: data,
+	}, nil
+}
+
+// ScheduledCall implements the Query/ScheduledCall gRPC method, it returns a pending
+// scheduled call by its id.
+func (k Keeper) ScheduledCall(c context.Context, req *txs.QueryScheduledCallRequest) (*txs.QueryScheduledCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	call, found := k.GetScheduledCall(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scheduled call %d not found", req.Id)
+	}
+	return &txs.QueryScheduledCallResponse{Call: call}, nil
+}
+
+// ScheduledCalls implements the Query/ScheduledCalls gRPC method, it returns the pending
+// scheduled calls of a sender given by its bech32 or hex address.
+func (k Keeper) ScheduledCalls(c context.Context, req *txs.QueryScheduledCallsRequest) (*txs.QueryScheduledCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var sender common.Address
+	if addr, err := cosmos.AccAddressFromBech32(req.Sender); err == nil {
+		sender = common.BytesToAddress(addr)
+	} else if err := artela.ValidateAddress(req.Sender); err == nil {
+		sender = common.HexToAddress(req.Sender)
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address %s", req.Sender)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	return &txs.QueryScheduledCallsResponse{Calls: k.GetSenderScheduledCalls(ctx, sender)}, nil
+}
+
+// GasPolicy implements the Query/GasPolicy gRPC method, it returns the gas policy of an
+// account given by its bech32 or hex address, and the gas it spent today.
+func (k Keeper) GasPolicy(c context.Context, req *txs.QueryGasPolicyRequest) (*txs.QueryGasPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var address common.Address
+	if addr, err := cosmos.AccAddressFromBech32(req.Address); err == nil {
+		address = common.BytesToAddress(addr)
+	} else if err := artela.ValidateAddress(req.Address); err == nil {
+		address = common.HexToAddress(req.Address)
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	policy, found := k.GetGasPolicy(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "gas policy of %s not found", req.Address)
+	}
+	return &txs.QueryGasPolicyResponse{Policy: policy, GasUsedToday: k.GetDailyGasUsed(ctx, address)}, nil
+}
+
+// StorageRangeAt implements the Query/StorageRangeAt gRPC method, it replays the predecessors
+// of the tx index in the block and returns a range of the storage of the contract after them.
+func (k Keeper) StorageRangeAt(c context.Context, req *txs.QueryStorageRangeAtRequest) (*txs.QueryStorageRangeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := artela.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.KeyStart) > common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "start key too long, got %d bytes", len(req.KeyStart))
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := req.BlockNumber - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfigAtHeight(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID, contextHeight)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	signer := ethereum.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	for i, tx := range req.Predecessors {
+		ethTx := tx.AsTransaction()
+
+		// Aspect Runtime Context Lifecycle: create aspect context.
+		ctx, aspectCtx := k.WithAspectContext(ctx, ethTx, cfg,
+			artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
+		defer aspectCtx.Destroy()
+
+		msg, err := txs.ToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+
+		rsp, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, txs.NewNoOpTracer(), true, cfg, txConfig)
+		if err != nil {
+			continue
+		}
+
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	result := txs.StorageRangeResult{Storage: txs.StorageMap{}}
+	k.ForEachStorageFrom(ctx, common.HexToAddress(req.Address), common.BytesToHash(req.KeyStart), func(key, value common.Hash) bool {
+		if uint64(len(result.Storage)) >= req.MaxResult {
+			result.NextKey = &key
+			return false
+		}
+		preimage := key
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = txs.StorageEntry{Key: &preimage, Value: value}
+		return true
+	})
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, types.StatusError(codes.Internal, err)
+	}
+	return &txs.QueryStorageRangeAtResponse{Data: resultData}, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method, it dumps a range of the
+// accounts at the queried height, in the order of the raw addresses.
+func (k Keeper) AccountRange(c context.Context, req *txs.QueryAccountRangeRequest) (*txs.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Start) > common.AddressLength {
+		return nil, status.Errorf(codes.InvalidArgument, "start address too long, got %d bytes", len(req.Start))
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	// the start is right padded, like a key prefix
+	var start common.Address
+	copy(start[:], req.Start)
+
+	result := state.IteratorDump{}
+	var next *common.Address
+	result.Accounts, next = k.DumpAccounts(ctx, start, req.MaxResults, req.NoCode, req.NoStorage)
+	if next != nil {
+		result.Next = next.Bytes()
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, types.StatusError(codes.Internal, err)
+	}
+	return &txs.QueryAccountRangeResponse{Data: resultData}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// HeaderHashRetention is the number of the recent blocks the hashes are kept for, the
+// BLOCKHASH opcode doesn't reach further.
+const HeaderHashRetention = 256
+
+// TrackHeaderHash stores the hash of the block at the current height, which is the block
+// hash served over RPC, and prunes the hash leaving the retention window.
+func (k Keeper) TrackHeaderHash(ctx cosmos.Context) {
+	headerHash := ctx.HeaderHash()
+	if len(headerHash) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HeaderHashKey(ctx.BlockHeight()), headerHash)
+	if pruned := ctx.BlockHeight() - HeaderHashRetention; pruned > 0 {
+		store.Delete(types.HeaderHashKey(pruned))
+	}
+}
+
+// GetHeaderHash returns the stored hash of the block at the given height, false if the
+// hash isn't stored.
+func (k Keeper) GetHeaderHash(ctx cosmos.Context, height int64) (common.Hash, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HeaderHashKey(height))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// RecentBlockHeader returns the header and the hash of a block of the BLOCKHASH window, i.e.
+// the 256 blocks before the current one, false if the block is out of the window or its
+// header isn't kept in the staking historical info. The hash is the one BLOCKHASH returns.
+func (k Keeper) RecentBlockHeader(ctx cosmos.Context, height int64) (*ethereum.Header, common.Hash, bool) {
+	if height >= ctx.BlockHeight() || height < ctx.BlockHeight()-HeaderHashRetention || height <= 0 {
+		return nil, common.Hash{}, false
+	}
+
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return nil, common.Hash{}, false
+	}
+	hash := k.GetHashFn(ctx)(uint64(height)) // #nosec G701 -- height is positive
+
+	txHash := ethereum.EmptyTxsHash
+	if len(histInfo.Header.DataHash) != 0 {
+		txHash = common.BytesToHash(histInfo.Header.DataHash)
+	}
+	return &ethereum.Header{
+		ParentHash: common.BytesToHash(histInfo.Header.LastBlockId.Hash),
+		Coinbase:   common.BytesToAddress(histInfo.Header.ProposerAddress),
+		TxHash:     txHash,
+		Number:     big.NewInt(height),
+		Time:       uint64(histInfo.Header.Time.UTC().Unix()), // #nosec G701
+	}, hash, true
+}
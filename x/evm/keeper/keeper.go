@@ -15,11 +15,13 @@ import (
 	"github.com/artela-network/artela-evm/vm"
 
 	artela "github.com/artela-network/artela/ethereum/types"
+	"github.com/artela-network/artela/x/evm/exported"
 	"github.com/artela-network/artela/x/evm/states"
 	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/txs/support"
 
 	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -37,6 +39,8 @@ import (
 	"github.com/artela-network/artela/x/evm/types"
 )
 
+var _ exported.EVMKeeper = &Keeper{}
+
 // Keeper grants access to the EVM module states and implements the go-ethereum StateDB interface.
 type Keeper struct {
 	// logger saves the logger instance of evm module
@@ -69,9 +73,34 @@ type Keeper struct {
 	// chain ID number obtained from the context's chain id
 	eip155ChainID *big.Int
 
+	// EVMConfig of the current block
+	configCache *evmConfigCache
+
 	// tracer used to collect execution traces from the EVM txs execution
 	tracer string
 
+	// node local vm.Config knobs
+	vmOptions VMOptions
+
+	// module accounts the EVM can't transfer funds to
+	blockedAddrs map[common.Address]bool
+
+	// node local db of the SHA3 preimages recorded by the VM, nil if not recording
+	preimageDB dbm.DB
+
+	// node local db of the per block storage access counters, nil if not recording
+	accessStatsDB dbm.DB
+	// storage access counters of the current block
+	blockAccessStats *blockAccessStats
+
+	// node local db of the contract creations, nil if not indexing
+	contractIndexDB dbm.DB
+
+	// node local db of the per block execution witnesses, nil if not recording
+	witnessDB dbm.DB
+	// state read by the EVM in the current block
+	blockWitness *states.Witness
+
 	// legacy subspace
 	ss paramsmodule.Subspace
 
@@ -131,6 +160,7 @@ func NewKeeper(
 		ss:                   subSpace,
 		aspectRuntimeContext: aspectRuntimeContext,
 		aspect:               aspect,
+		configCache:          newEVMConfigCache(),
 	}
 	k.WithChainID(app.ChainId())
 
@@ -173,12 +203,9 @@ func (k Keeper) Logger(ctx cosmos.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// WithChainID sets the chain id to the local variable in the keeper
+// WithChainID sets the chain id to the local variable in the keeper, it panics if
+// the keeper has been set with a different chain id.
 func (k *Keeper) WithChainID(chainId string) {
-	if k.eip155ChainID != nil {
-		return
-	}
-
 	chainID, err := artela.ParseChainID(chainId)
 	if err != nil {
 		panic(err)
@@ -216,30 +243,28 @@ func (k Keeper) EmitBlockBloomEvent(ctx cosmos.Context, bloom ethereum.Bloom) {
 	)
 }
 
-// GetBlockBloomTransient returns bloom bytes for the current block height
-func (k Keeper) GetBlockBloomTransient(ctx cosmos.Context) *big.Int {
-	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
-	heightBz := cosmos.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
-	bz := store.Get(heightBz)
-	if len(bz) == 0 {
-		return big.NewInt(0)
-	}
+// GetBlockBloomTransient returns the bloom of the current block, the OR of the receipt blooms
+// of the txs set to the transient store. The blooms are read from the store in order, and
+// ORed in parallel.
+func (k Keeper) GetBlockBloomTransient(ctx cosmos.Context) ethereum.Bloom {
+	iterator := k.txBloomStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
 
-	return new(big.Int).SetBytes(bz)
+	var blooms [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		blooms = append(blooms, iterator.Value())
+	}
+	return aggregateBlooms(blooms)
 }
 
-// SetBlockBloomTransient sets the given bloom bytes to the transient store. This value is reset on
-// every block.
-func (k Keeper) SetBlockBloomTransient(ctx cosmos.Context, bloom *big.Int) {
-	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
-	heightBz := cosmos.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
-	store.Set(heightBz, bloom.Bytes())
+// SetTxBloomTransient sets the receipt bloom of a txs to the transient store, keyed by the index
+// of the txs. The blooms are aggregated to the block bloom at EndBlock.
+func (k Keeper) SetTxBloomTransient(ctx cosmos.Context, txIndex uint64, bloom ethereum.Bloom) {
+	k.txBloomStore(ctx).Set(cosmos.Uint64ToBigEndian(txIndex), bloom.Bytes())
+}
 
-	k.Logger(ctx).Debug(
-		fmt.Sprintf("setState: SetBlockBloomTransient"),
-		"block-height", fmt.Sprintf("%d", ctx.BlockHeight()),
-		"bloom", bloom.String(),
-	)
+func (k Keeper) txBloomStore(ctx cosmos.Context) prefix.Store {
+	return prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
 }
 
 // ----------------------------------------------------------------------------
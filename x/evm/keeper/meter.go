@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"math/big"
+	"strconv"
 
 	"github.com/artela-network/artela/x/evm/txs"
 	"github.com/artela-network/artela/x/evm/types"
@@ -19,14 +21,42 @@ import (
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction.
-func (k *Keeper) GetEthIntrinsicGas(ctx cosmos.Context, msg *core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
+func (k *Keeper) GetEthIntrinsicGas(ctx cosmos.Context, msg *core.Message, cfg *params.ChainConfig, isContractCreation bool, calldataGasPerToken uint64) (uint64, error) {
 	blockHeight := big.NewInt(ctx.BlockHeight())
 
 	homestead := cfg.IsHomestead(blockHeight)
 	istanbul := cfg.IsIstanbul(blockHeight)
 
+	return IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, calldataGasPerToken)
+}
+
+// IntrinsicGas returns the intrinsic gas of a transaction, with the data repriced to
+// calldataGasPerToken gas per token if it's higher than the standard price. A zero byte
+// of the data counts as one token and a non-zero byte as four tokens, as EIP-7623 does.
+func IntrinsicGas(data []byte, accessList ethereum.AccessList, isContractCreation, homestead, istanbul bool, calldataGasPerToken uint64) (uint64, error) {
 	// EIP3860(limit and meter initcode): https://eips.ethereum.org/EIPS/eip-3860
-	return core.IntrinsicGas(msg.Data, msg.AccessList, isContractCreation, homestead, istanbul, false)
+	gas, err := core.IntrinsicGas(data, accessList, isContractCreation, homestead, istanbul, false)
+	if err != nil || calldataGasPerToken == 0 || len(data) == 0 {
+		return gas, err
+	}
+
+	nonZeroGas := params.TxDataNonZeroGasFrontier
+	if istanbul {
+		nonZeroGas = params.TxDataNonZeroGasEIP2028
+	}
+	nonZero := uint64(len(data) - bytes.Count(data, []byte{0}))
+	zero := uint64(len(data)) - nonZero
+	standard := zero*params.TxDataZeroGas + nonZero*nonZeroGas
+
+	// the data size is bounded by the tx size, the gas per token by the params
+	repriced := (zero + nonZero*4) * calldataGasPerToken
+	if repriced <= standard {
+		return gas, nil
+	}
+	if gas+(repriced-standard) < gas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + (repriced - standard), nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -118,9 +148,13 @@ func CheckSenderBalance(
 	}
 
 	if balance.IsNegative() || balance.BigInt().Cmp(cost) < 0 {
-		return errorsmod.Wrapf(
+		return types.WrapWithData(
 			errortypes.ErrInsufficientFunds,
-			"sender balance < tx cost (%s < %s)", balance, txData.Cost(),
+			types.ErrorData{
+				types.ErrorDataProvided: balance.String(),
+				types.ErrorDataRequired: cost.String(),
+			},
+			"sender balance < tx cost (%s < %s)", balance, cost,
 		)
 	}
 
@@ -129,12 +163,15 @@ func CheckSenderBalance(
 
 // VerifyFee is used to return the fee for the given transaction data in cosmos.Coins. It checks that the
 // gas limit is not reached, the gas limit is higher than the intrinsic gas and that the
-// base fee is higher than the gas fee cap.
+// base fee is higher than the gas fee cap. The intrinsic gas includes the aspectAccessGas
+// of the aspects bound to the called contract, see Keeper.AspectAccessGas.
 func VerifyFee(
 	txData txs.TxData,
 	denom string,
 	baseFee *big.Int,
 	homestead, istanbul, isCheckTx bool,
+	calldataGasPerToken uint64,
+	aspectAccessGas uint64,
 ) (cosmos.Coins, error) {
 	gasLimit := txData.GetGas()
 	isContractCreation := txData.GetTo() == nil
@@ -144,7 +181,7 @@ func VerifyFee(
 		accessList = txData.GetAccessList()
 	}
 
-	intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul, false)
+	intrinsicGas, err := IntrinsicGas(txData.GetData(), accessList, isContractCreation, homestead, istanbul, calldataGasPerToken)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
 			err,
@@ -152,17 +189,29 @@ func VerifyFee(
 			isContractCreation, homestead, istanbul,
 		)
 	}
+	if intrinsicGas+aspectAccessGas < intrinsicGas {
+		return nil, errorsmod.Wrap(core.ErrGasUintOverflow, "failed to add the aspect access gas to the intrinsic gas")
+	}
+	intrinsicGas += aspectAccessGas
 
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
-		return nil, errorsmod.Wrapf(
+		return nil, types.WrapWithData(
 			errortypes.ErrOutOfGas,
+			types.ErrorData{
+				types.ErrorDataProvided: strconv.FormatUint(gasLimit, 10),
+				types.ErrorDataRequired: strconv.FormatUint(intrinsicGas, 10),
+			},
 			"gas limit too low: %d (gas limit) < %d (intrinsic gas)", gasLimit, intrinsicGas,
 		)
 	}
 
 	if baseFee != nil && txData.GetGasFeeCap().Cmp(baseFee) < 0 {
-		return nil, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+		return nil, types.WrapWithData(errortypes.ErrInsufficientFee,
+			types.ErrorData{
+				types.ErrorDataProvided: txData.GetGasFeeCap().String(),
+				types.ErrorDataRequired: baseFee.String(),
+			},
 			"the tx gasfeecap is lower than the tx baseFee: %s (gasfeecap), %s (basefee) ",
 			txData.GetGasFeeCap(),
 			baseFee)
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/keeper"
+)
+
+func TestIntrinsicGas(t *testing.T) {
+	// 10 zero bytes and 10 non-zero bytes, 50 tokens
+	data := append(make([]byte, 10), bytes.Repeat([]byte{0x1}, 10)...)
+	accessList := ethtypes.AccessList{{Address: common.HexToAddress("0x01"), StorageKeys: []common.Hash{{}}}}
+
+	testCases := []struct {
+		name                string
+		data                []byte
+		accessList          ethtypes.AccessList
+		create              bool
+		istanbul            bool
+		calldataGasPerToken uint64
+		expGas              uint64
+	}{
+		{"repricing off", data, nil, false, true, 0, 21_000 + 10*4 + 10*16},
+		{"repricing off, create", data, nil, true, true, 0, 53_000 + 10*4 + 10*16},
+		{"repriced below the standard price", data, nil, false, true, 3, 21_000 + 10*4 + 10*16},
+		{"repriced at the standard price", data, nil, false, true, 4, 21_000 + 10*4 + 10*16},
+		{"repriced above the standard price", data, nil, false, true, 10, 21_000 + 50*10},
+		{"repriced above the standard price, create", data, nil, true, true, 10, 53_000 + 50*10},
+		{"repriced with an access list", data, accessList, false, true, 10, 21_000 + 50*10 + 2400 + 1900},
+		{"repriced before istanbul", data, nil, false, false, 10, 21_000 + 10*4 + 10*68},
+		{"repriced above the standard price before istanbul", data, nil, false, false, 20, 21_000 + 50*20},
+		{"repriced without data", nil, nil, false, true, 10, 21_000},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gas, err := keeper.IntrinsicGas(tc.data, tc.accessList, tc.create, true, tc.istanbul, tc.calldataGasPerToken)
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, gas)
+		})
+	}
+}
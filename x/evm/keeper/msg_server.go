@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
 
 	govmodule "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/artela-network/artela/x/evm/types"
 )
@@ -146,3 +148,135 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *txs.MsgUpdateParams) (
 
 	return &txs.MsgUpdateParamsResponse{}, nil
 }
+
+// PauseEVM implements the gRPC MsgServer interface. The pause authority of the params pauses
+// all the EVM calls and creates for at most the pause_blocks param, the pause expires at the
+// end of it unless the governance module account extends it, or lifts it with zero blocks.
+func (k *Keeper) PauseEVM(goCtx context.Context, req *txs.MsgPauseEVM) (*txs.MsgPauseEVMResponse, error) {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	blocks := req.Blocks
+	switch {
+	case req.Authority == k.authority.String():
+		// governance is free to extend or lift the pause, up to the MaxPauseBlocks at once
+		if blocks > support.MaxPauseBlocks {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "pause blocks %d exceeds the max %d", blocks, support.MaxPauseBlocks)
+		}
+	case params.PauseAuthority != "" && req.Authority == params.PauseAuthority:
+		if k.IsPaused(ctx) {
+			return nil, errorsmod.Wrapf(types.ErrEVMPaused, "already paused until block %d, only governance can extend it", k.GetPausedUntil(ctx))
+		}
+		// the pause authority alone can't keep the EVM paused by pausing it again on expiry
+		if last := k.GetLastAuthorityPause(ctx); last > 0 && ctx.BlockHeight() < last+int64(params.PauseIntervalBlocks) { // #nosec G701
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "paused at block %d, the pause authority can pause again at block %d", last, last+int64(params.PauseIntervalBlocks)) // #nosec G701
+		}
+		if blocks == 0 {
+			blocks = params.PauseBlocks
+		}
+		if blocks > params.PauseBlocks {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "pause blocks %d exceeds the pause authority max %d", blocks, params.PauseBlocks)
+		}
+	default:
+		return nil, errorsmod.Wrapf(govmodule.ErrInvalidSigner, "invalid authority, expected %s or the pause authority, got %s", k.authority.String(), req.Authority)
+	}
+
+	pausedUntil := ctx.BlockHeight() + int64(blocks) // #nosec G701
+	k.setPausedUntil(ctx, pausedUntil)
+	if req.Authority != k.authority.String() {
+		k.setLastAuthorityPause(ctx, ctx.BlockHeight())
+	}
+	k.Logger(ctx).Info("EVM pause updated", "authority", req.Authority, "paused-until", pausedUntil)
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(
+		types.EventTypeEVMPause,
+		cosmos.NewAttribute(types.AttributeKeyPauseAuthority, req.Authority),
+		cosmos.NewAttribute(types.AttributeKeyPausedUntil, strconv.FormatInt(pausedUntil, 10)),
+	))
+
+	return &txs.MsgPauseEVMResponse{PausedUntil: pausedUntil}, nil
+}
+
+// ScheduleCall implements the gRPC MsgServer interface. It escrows the fee of the call,
+// gas_limit * gas_price, from the sender until the call is executed in the begin blocker
+// at or after its height, cancelled by the sender, or refunded when it expires.
+func (k *Keeper) ScheduleCall(goCtx context.Context, req *txs.MsgScheduleCall) (*txs.MsgScheduleCallResponse, error) {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+
+	call, err := k.scheduleCall(ctx, support.ScheduledCall{
+		Sender:    req.Sender,
+		To:        req.To,
+		Data:      req.Data,
+		Value:     req.Value,
+		GasLimit:  req.GasLimit,
+		GasPrice:  req.GasPrice,
+		ExecuteAt: req.ExecuteAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	k.Logger(ctx).Debug("call scheduled", "id", call.Id, "sender", call.Sender, "execute-at", call.ExecuteAt)
+
+	return &txs.MsgScheduleCallResponse{Id: call.Id, ExpiresAt: call.ExpiresAt}, nil
+}
+
+// CancelScheduledCall implements the gRPC MsgServer interface. It refunds the escrowed fee
+// of a pending scheduled call to its sender and deletes it.
+func (k *Keeper) CancelScheduledCall(goCtx context.Context, req *txs.MsgCancelScheduledCall) (*txs.MsgCancelScheduledCallResponse, error) {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+
+	if err := k.cancelScheduledCall(ctx, req.Sender, req.Id); err != nil {
+		return nil, err
+	}
+	return &txs.MsgCancelScheduledCallResponse{}, nil
+}
+
+// SetGasPolicy implements the gRPC MsgServer interface. It sets the gas policy of an account
+// checked by the ante handler for its ethereum txs, or removes it if the policy has no cap.
+// The policy of an account is set by the account itself first, and by its admin afterwards.
+func (k *Keeper) SetGasPolicy(goCtx context.Context, req *txs.MsgSetGasPolicy) (*txs.MsgSetGasPolicyResponse, error) {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+
+	if err := k.updateGasPolicy(ctx, req.Sender, req.Policy); err != nil {
+		return nil, err
+	}
+	return &txs.MsgSetGasPolicyResponse{}, nil
+}
+
+// FaucetDrip implements the gRPC MsgServer interface. The faucet operator of the params sends
+// funds of the faucet module account of a test network, the account is funded at genesis so
+// the operator key only signs the drips and holds no funds, a leaked key can't drain more
+// than the faucet account.
+func (k *Keeper) FaucetDrip(goCtx context.Context, req *txs.MsgFaucetDrip) (*txs.MsgFaucetDripResponse, error) {
+	ctx := cosmos.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if params.FaucetOperator == "" {
+		return nil, errorsmod.Wrap(errortypes.ErrUnauthorized, "faucet is disabled")
+	}
+	if req.Operator != params.FaucetOperator {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid faucet operator, expected %s, got %s", params.FaucetOperator, req.Operator)
+	}
+
+	recipient, err := cosmos.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+	amount, err := cosmos.ParseCoinsNormalized(req.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FaucetAccountName, recipient, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(
+		types.EventTypeFaucetDrip,
+		cosmos.NewAttribute(types.AttributeKeyFaucetOperator, req.Operator),
+		cosmos.NewAttribute(types.AttributeKeyRecipient, req.Recipient),
+		cosmos.NewAttribute(types.AttributeKeyFaucetAmount, amount.String()),
+	))
+
+	return &txs.MsgFaucetDripResponse{}, nil
+}
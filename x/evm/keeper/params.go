@@ -21,6 +21,24 @@ func (k Keeper) GetParams(ctx cosmos.Context) (params support.Params) {
 	return
 }
 
+// GetParamsAtHeight returns the evm parameters that were active at the end of the given
+// height, i.e. the last params set at or before it. The current params are returned if no
+// params change is recorded before the height, e.g. the params set before the history.
+func (k Keeper) GetParamsAtHeight(ctx cosmos.Context, height int64) support.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(types.KeyPrefixParamsHistory, types.ParamsHistoryKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return k.GetParams(ctx)
+	}
+
+	var params support.Params
+	k.cdc.MustUnmarshal(iterator.Value(), &params)
+	return params
+}
+
 func (k *Keeper) GetChainConfig(ctx cosmos.Context) *params.ChainConfig {
 	chainParams := k.GetParams(ctx)
 	ethCfg := chainParams.ChainConfig.EthereumConfig(k.ChainID())
@@ -40,6 +58,9 @@ func (k Keeper) SetParams(ctx cosmos.Context, params support.Params) error {
 	}
 
 	store.Set(types.KeyPrefixParams, bz)
+	// keep the params by the height they take effect at, for the replays of the old blocks
+	store.Set(types.ParamsHistoryKey(ctx.BlockHeight()), bz)
+	k.configCache.disable()
 	k.Logger(ctx).Debug("setState: SetParams",
 		"key", "KeyPrefixParams",
 		"value", fmt.Sprintf("%+v", params))
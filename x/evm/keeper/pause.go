@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"strconv"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// GetPausedUntil returns the height the emergency pause of the EVM expires at, the EVM
+// calls and creates are paused in the blocks before it.
+func (k Keeper) GetPausedUntil(ctx cosmos.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyPrefixPausedUntil)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(cosmos.BigEndianToUint64(bz)) // #nosec G701
+}
+
+// setPausedUntil pauses the EVM calls and creates until the given height, a height not
+// above the current one resumes them.
+func (k Keeper) setPausedUntil(ctx cosmos.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if height <= ctx.BlockHeight() {
+		store.Delete(types.KeyPrefixPausedUntil)
+		return
+	}
+	store.Set(types.KeyPrefixPausedUntil, cosmos.Uint64ToBigEndian(uint64(height))) // #nosec G701
+}
+
+// GetLastAuthorityPause returns the height the pause authority last paused the EVM at, 0 if
+// it never did.
+func (k Keeper) GetLastAuthorityPause(ctx cosmos.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyPrefixLastAuthorityPause)
+	if len(bz) == 0 {
+		return 0
+	}
+	return int64(cosmos.BigEndianToUint64(bz)) // #nosec G701
+}
+
+// setLastAuthorityPause records the height the pause authority paused the EVM at.
+func (k Keeper) setLastAuthorityPause(ctx cosmos.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixLastAuthorityPause, cosmos.Uint64ToBigEndian(uint64(height))) // #nosec G701
+}
+
+// IsPaused returns if the EVM calls and creates are paused at the current height.
+func (k Keeper) IsPaused(ctx cosmos.Context) bool {
+	return ctx.BlockHeight() < k.GetPausedUntil(ctx)
+}
+
+// CheckPaused returns an error telling the height the EVM resumes at if the EVM calls
+// and creates are paused at the current height.
+func (k Keeper) CheckPaused(ctx cosmos.Context) error {
+	if pausedUntil := k.GetPausedUntil(ctx); ctx.BlockHeight() < pausedUntil {
+		return types.WrapWithData(types.ErrEVMPaused, types.ErrorData{
+			types.ErrorDataHeight: strconv.FormatInt(pausedUntil, 10),
+		}, "calls and creates are resumed at block %d", pausedUntil)
+	}
+	return nil
+}
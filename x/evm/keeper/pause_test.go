@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govmodule "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestPauseEVM(t *testing.T) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	gov := k.GetAuthority().String()
+	pauser := cosmos.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes()).String()
+
+	// only governance pauses the EVM without a pause authority
+	_, err := k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: pauser, Blocks: 10})
+	require.ErrorIs(t, err, govmodule.ErrInvalidSigner)
+	require.False(t, k.IsPaused(ctx))
+
+	params := k.GetParams(ctx)
+	params.PauseAuthority = pauser
+	params.PauseBlocks = 10
+	params.PauseIntervalBlocks = 10
+	require.Error(t, params.Validate(), "the pause authority could chain its pauses")
+	params.PauseIntervalBlocks = 50
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the pause authority is limited to the pause blocks param
+	_, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: pauser, Blocks: 11})
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+	require.False(t, k.IsPaused(ctx))
+
+	// zero blocks pauses for the pause blocks param
+	res, err := k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: pauser})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+10, res.PausedUntil)
+	require.True(t, k.IsPaused(ctx))
+	require.ErrorIs(t, k.CheckPaused(ctx), types.ErrEVMPaused)
+
+	// the pause authority can't extend the pause, only governance can
+	_, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: pauser, Blocks: 5})
+	require.ErrorIs(t, err, types.ErrEVMPaused)
+	_, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: gov, Blocks: support.MaxPauseBlocks + 1})
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+	res, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: gov, Blocks: 100})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+100, res.PausedUntil)
+
+	// the EVM is resumed at the paused until height
+	paused := ctx.WithBlockHeight(res.PausedUntil - 1)
+	require.ErrorIs(t, k.CheckPaused(paused), types.ErrEVMPaused)
+	require.NoError(t, k.CheckPaused(ctx.WithBlockHeight(res.PausedUntil)))
+
+	// zero blocks from governance lifts the pause
+	_, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: gov})
+	require.NoError(t, err)
+	require.False(t, k.IsPaused(ctx))
+	require.NoError(t, k.CheckPaused(ctx))
+
+	// the pause authority pauses again only after the pause interval
+	_, err = k.PauseEVM(ctx, &txs.MsgPauseEVM{Authority: pauser})
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+	_, err = k.PauseEVM(ctx.WithBlockHeight(ctx.BlockHeight()+49), &txs.MsgPauseEVM{Authority: pauser})
+	require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+	res, err = k.PauseEVM(ctx.WithBlockHeight(ctx.BlockHeight()+50), &txs.MsgPauseEVM{Authority: pauser})
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+60, res.PausedUntil)
+	require.Equal(t, ctx.BlockHeight()+50, k.GetLastAuthorityPause(ctx))
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	dbm "github.com/cometbft/cometbft-db"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+var _ states.PreimageKeeper = &Keeper{}
+
+// SetPreimageDB sets the node local db where the SHA3 preimages recorded by the
+// VM are stored, the preimages are keyed by their hash.
+func (k *Keeper) SetPreimageDB(db dbm.DB) {
+	k.preimageDB = db
+}
+
+// SetPreimages stores the SHA3 preimages recorded by the VM. The preimages are
+// node local data and don't affect consensus, so a failed write is only logged.
+func (k *Keeper) SetPreimages(ctx cosmos.Context, preimages map[common.Hash][]byte) {
+	if k.preimageDB == nil {
+		return
+	}
+
+	batch := k.preimageDB.NewBatch()
+	defer batch.Close()
+
+	for hash, preimage := range preimages {
+		if err := batch.Set(hash.Bytes(), preimage); err != nil {
+			k.Logger(ctx).Error("failed to record preimage", "hash", hash.Hex(), "error", err)
+			return
+		}
+	}
+	if err := batch.Write(); err != nil {
+		k.Logger(ctx).Error("failed to record preimages", "error", err)
+	}
+}
@@ -0,0 +1,325 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authmodule "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// GetScheduledCall returns the pending scheduled call with the given id.
+func (k Keeper) GetScheduledCall(ctx cosmos.Context, id uint64) (support.ScheduledCall, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	var call support.ScheduledCall
+	bz := store.Get(types.ScheduledCallKey(id))
+	if len(bz) == 0 {
+		return call, false
+	}
+	k.cdc.MustUnmarshal(bz, &call)
+	return call, true
+}
+
+// GetSenderScheduledCalls returns the pending scheduled calls of the sender ordered by id.
+func (k Keeper) GetSenderScheduledCalls(ctx cosmos.Context, sender common.Address) []support.ScheduledCall {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledCallSenderPrefix(sender))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var calls []support.ScheduledCall
+	for ; iterator.Valid(); iterator.Next() {
+		if call, found := k.GetScheduledCall(ctx, cosmos.BigEndianToUint64(iterator.Key())); found {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// setScheduledCall stores the scheduled call, and indexes it by its height and sender.
+func (k Keeper) setScheduledCall(ctx cosmos.Context, call support.ScheduledCall) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ScheduledCallKey(call.Id), k.cdc.MustMarshal(&call))
+	store.Set(types.ScheduledCallQueueKey(call.ExecuteAt, call.Id), []byte{})
+	store.Set(types.ScheduledCallSenderKey(scheduledCallSender(call), call.Id), []byte{})
+}
+
+// deleteScheduledCall deletes the scheduled call and its indexes.
+func (k Keeper) deleteScheduledCall(ctx cosmos.Context, call support.ScheduledCall) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ScheduledCallKey(call.Id))
+	store.Delete(types.ScheduledCallQueueKey(call.ExecuteAt, call.Id))
+	store.Delete(types.ScheduledCallSenderKey(scheduledCallSender(call), call.Id))
+}
+
+// nextScheduledCallID returns the id of a new scheduled call, the ids start from 1.
+func (k Keeper) nextScheduledCallID(ctx cosmos.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := uint64(1)
+	if bz := store.Get(types.KeyPrefixScheduledCallSequence); len(bz) != 0 {
+		id = cosmos.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeyPrefixScheduledCallSequence, cosmos.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// scheduleCall validates the call against the current block, escrows its fee from the
+// sender and stores it with a new id, the call is executed at or after its height.
+func (k *Keeper) scheduleCall(ctx cosmos.Context, call support.ScheduledCall) (support.ScheduledCall, error) {
+	height := ctx.BlockHeight()
+	if call.ExecuteAt <= height || call.ExecuteAt-height > types.ScheduledCallMaxDelay {
+		return call, errorsmod.Wrapf(types.ErrInvalidScheduledCall, "execute at %d, must be after %d and at most %d blocks ahead",
+			call.ExecuteAt, height, types.ScheduledCallMaxDelay)
+	}
+
+	params := k.GetParams(ctx)
+	if call.GasLimit > params.ScheduledCallBlockGasBudget || call.GasLimit > params.SystemTxBlockGasBudget {
+		// the call would never fit in the budgets of a block
+		return call, errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit %d exceeds the scheduled call budget %d or the system tx budget %d",
+			call.GasLimit, params.ScheduledCallBlockGasBudget, params.SystemTxBlockGasBudget)
+	}
+	if params.MaxTxDataSize != 0 && uint64(len(call.Data)) > params.MaxTxDataSize {
+		return call, errorsmod.Wrapf(types.ErrTxDataTooLarge, "data size %d, max %d", len(call.Data), params.MaxTxDataSize)
+	}
+
+	if to := common.HexToAddress(call.To); k.IsBlockedAddr(to) {
+		return call, errorsmod.Wrapf(types.ErrBlockedAddress, "%s is a module account", to.Hex())
+	}
+
+	sender := scheduledCallSender(call)
+	if pending := len(k.GetSenderScheduledCalls(ctx, sender)); pending >= types.MaxScheduledCallsPerSender {
+		return call, errorsmod.Wrapf(types.ErrInvalidScheduledCall, "sender %s has %d pending scheduled calls, max %d",
+			sender.Hex(), pending, types.MaxScheduledCallsPerSender)
+	}
+
+	gasPrice := parseScheduledCallAmount(call.GasPrice)
+	if baseFee := k.GetBaseFee(ctx, k.GetChainConfig(ctx)); baseFee != nil && gasPrice.Cmp(baseFee) < 0 {
+		return call, errorsmod.Wrapf(types.ErrInvalidGasPrice, "gas price %s is lower than the base fee %s", gasPrice, baseFee)
+	}
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(call.GasLimit))
+	if fee.Sign() > 0 {
+		coins := cosmos.Coins{cosmos.NewCoin(params.EvmDenom, sdkmath.NewIntFromBigInt(fee))}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender.Bytes(), types.ModuleName, coins); err != nil {
+			return call, errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "failed to escrow the scheduled call fee %s: %s", coins, err.Error())
+		}
+	}
+
+	call.Id = k.nextScheduledCallID(ctx)
+	call.ExpiresAt = call.ExecuteAt + types.ScheduledCallExpiryBlocks
+	k.setScheduledCall(ctx, call)
+
+	k.emitScheduledCallEvent(ctx, call, types.ScheduledCallStatusScheduled, nil)
+	return call, nil
+}
+
+// cancelScheduledCall refunds the escrowed fee of a pending scheduled call of the sender
+// and deletes it.
+func (k *Keeper) cancelScheduledCall(ctx cosmos.Context, sender string, id uint64) error {
+	call, found := k.GetScheduledCall(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidScheduledCall, "scheduled call %d not found", id)
+	}
+	if call.Sender != sender {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "scheduled call %d is not sent from %s", id, sender)
+	}
+
+	if err := k.settleScheduledCall(ctx, call, 0); err != nil {
+		return err
+	}
+	k.deleteScheduledCall(ctx, call)
+
+	k.emitScheduledCallEvent(ctx, call, types.ScheduledCallStatusCancelled, nil)
+	return nil
+}
+
+// ExecuteScheduledCalls executes the calls scheduled at or before the current height in
+// the order of their height and id, against the ScheduledCallBlockGasBudget of the block,
+// as the system txs they also use the SystemTxBlockGasBudget of the block. At most
+// MaxDueScheduledCalls of the due calls are visited in a block.
+// The calls not fitting in the remaining budgets, due while the EVM is paused or failing
+// to be settled are moved to the next block, behind the calls already due, so they don't
+// hold back the rest of the queue. They're retried until they expire, the expired calls
+// are refunded.
+func (k *Keeper) ExecuteScheduledCalls(ctx cosmos.Context) {
+	// the scheduled calls run in the begin blocker, the sdk gas is not charged for them
+	ctx = ctx.WithGasMeter(cosmos.NewInfiniteGasMeter())
+
+	params := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixScheduledCallQueue, types.ScheduledCallQueuePrefix(ctx.BlockHeight()+1))
+	var due []uint64
+	for ; iterator.Valid() && uint64(len(due)) < params.MaxDueScheduledCalls; iterator.Next() {
+		key := iterator.Key()
+		due = append(due, cosmos.BigEndianToUint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	paused := k.IsPaused(ctx)
+	budget := params.ScheduledCallBlockGasBudget
+	for _, id := range due {
+		call, found := k.GetScheduledCall(ctx, id)
+		if !found {
+			continue
+		}
+
+		switch {
+		case ctx.BlockHeight() >= call.ExpiresAt:
+			if err := k.expireScheduledCall(ctx, call); err != nil {
+				k.Logger(ctx).Error("failed to refund expired scheduled call", "id", call.Id, "error", err)
+				k.requeueScheduledCall(ctx, call)
+			}
+		case paused || call.GasLimit > budget || call.GasLimit > k.systemTxGasLeft(ctx):
+			k.requeueScheduledCall(ctx, call)
+		default:
+			res, err := k.executeScheduledCall(ctx, call)
+			if err != nil {
+				k.Logger(ctx).Error("failed to execute scheduled call", "id", call.Id, "error", err)
+				k.requeueScheduledCall(ctx, call)
+				continue
+			}
+			budget -= res.GasUsed
+		}
+	}
+}
+
+// requeueScheduledCall moves a due scheduled call to the next block, behind the calls
+// already due.
+func (k *Keeper) requeueScheduledCall(ctx cosmos.Context, call support.ScheduledCall) {
+	ctx.KVStore(k.storeKey).Delete(types.ScheduledCallQueueKey(call.ExecuteAt, call.Id))
+	call.ExecuteAt = ctx.BlockHeight() + 1
+	k.setScheduledCall(ctx, call)
+}
+
+// expireScheduledCall refunds the escrowed fee of an expired scheduled call and deletes it,
+// nothing is changed if the refund fails.
+func (k *Keeper) expireScheduledCall(ctx cosmos.Context, call support.ScheduledCall) error {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.settleScheduledCall(cacheCtx, call, 0); err != nil {
+		return err
+	}
+	k.deleteScheduledCall(cacheCtx, call)
+	k.emitScheduledCallEvent(cacheCtx, call, types.ScheduledCallStatusExpired, nil)
+	commit()
+	return nil
+}
+
+// executeScheduledCall applies the scheduled call as a system txs from its sender, but it
+// pays the fee at its gas price and its id stands for the nonce, so the pending txs of the
+// sender aren't affected and the hashes of the calls are unique. The fee of the gas used
+// is paid to the fee collector, the rest of the escrowed fee is refunded, and the call is
+// deleted whether it succeeds or not. The settlement and the deletion are committed along
+// with the execution, if the settlement fails nothing of the call is committed.
+func (k *Keeper) executeScheduledCall(ctx cosmos.Context, call support.ScheduledCall) (*txs.MsgEthereumTxResponse, error) {
+	to := common.HexToAddress(call.To)
+	return k.CallEVM(ctx, SystemCall{
+		From:     scheduledCallSender(call),
+		To:       &to,
+		Value:    parseScheduledCallAmount(call.Value),
+		Data:     call.Data,
+		GasLimit: call.GasLimit,
+		GasPrice: parseScheduledCallAmount(call.GasPrice),
+		Nonce:    &call.Id,
+		Settle: func(ctx cosmos.Context, res *txs.MsgEthereumTxResponse) error {
+			return k.settleExecutedScheduledCall(ctx, call, res)
+		},
+	})
+}
+
+// settleExecutedScheduledCall settles the fee of an executed scheduled call, deletes it and
+// emits its status.
+func (k *Keeper) settleExecutedScheduledCall(ctx cosmos.Context, call support.ScheduledCall, res *txs.MsgEthereumTxResponse) error {
+	if err := k.settleScheduledCall(ctx, call, res.GasUsed); err != nil {
+		return err
+	}
+	k.deleteScheduledCall(ctx, call)
+
+	status := types.ScheduledCallStatusExecuted
+	if res.Failed() {
+		status = types.ScheduledCallStatusFailed
+	}
+	k.emitScheduledCallEvent(ctx, call, status, res)
+	return nil
+}
+
+// settleScheduledCall pays the fee of the gas used by the scheduled call from the escrow
+// to the fee collector, and refunds the rest of the escrowed fee to the sender.
+func (k *Keeper) settleScheduledCall(ctx cosmos.Context, call support.ScheduledCall, gasUsed uint64) error {
+	if gasUsed > call.GasLimit {
+		return errorsmod.Wrapf(types.ErrGasOverflow, "gas used %d, gas limit %d", gasUsed, call.GasLimit)
+	}
+
+	denom := k.GetParams(ctx).EvmDenom
+	gasPrice := parseScheduledCallAmount(call.GasPrice)
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed))
+	if fee.Sign() > 0 {
+		coins := cosmos.Coins{cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(fee))}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authmodule.FeeCollectorName, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to pay the scheduled call fee %s", coins)
+		}
+	}
+
+	refund := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(call.GasLimit-gasUsed))
+	if refund.Sign() > 0 {
+		coins := cosmos.Coins{cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(refund))}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, scheduledCallSender(call).Bytes(), coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to refund the scheduled call fee %s", coins)
+		}
+	}
+	return nil
+}
+
+// emitScheduledCallEvent emits the event of a change of the status of a scheduled call, with
+// the result of the execution if it's executed.
+func (k *Keeper) emitScheduledCallEvent(ctx cosmos.Context, call support.ScheduledCall,
+	status string, res *txs.MsgEthereumTxResponse,
+) {
+	attrs := []cosmos.Attribute{
+		cosmos.NewAttribute(types.AttributeKeyScheduledCallId, strconv.FormatUint(call.Id, 10)),
+		cosmos.NewAttribute(types.AttributeKeyScheduledCallSender, call.Sender),
+		cosmos.NewAttribute(types.AttributeKeyScheduledCallStatus, status),
+	}
+
+	if res != nil {
+		attrs = append(attrs,
+			cosmos.NewAttribute(types.AttributeKeyEthereumTxHash, res.Hash),
+			cosmos.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		)
+		if res.Failed() {
+			attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyEthereumTxFailed, res.VmError))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(types.EventTypeScheduledCall, attrs...))
+}
+
+// scheduledCallSender returns the address of the sender of the call, it's validated when
+// the call is scheduled.
+func scheduledCallSender(call support.ScheduledCall) common.Address {
+	// #nosec G703 -- the sender is validated by the ValidateBasic of the message
+	addr, _ := cosmos.AccAddressFromBech32(call.Sender)
+	return common.BytesToAddress(addr)
+}
+
+// parseScheduledCallAmount parses an amount of the call, it's validated when the call is
+// scheduled, an empty amount is zero.
+func parseScheduledCallAmount(amount string) *big.Int {
+	value, ok := sdkmath.NewIntFromString(amount)
+	if !ok {
+		return new(big.Int)
+	}
+	return value.BigInt()
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+var scheduledCallRecipient = common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+// setupScheduledCalls returns an app with a funded sender of the scheduled calls, and the
+// gas price of the calls.
+func setupScheduledCalls(t *testing.T) (*app.Artela, cosmos.Context, cosmos.AccAddress, *big.Int) {
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	sender := cosmos.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	coins := cosmos.NewCoins(cosmos.NewCoin(k.GetParams(ctx).EvmDenom, sdkmath.NewInt(1e18)))
+	require.NoError(t, artela.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, artela.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins))
+
+	gasPrice := k.GetBaseFee(ctx, k.GetChainConfig(ctx))
+	if gasPrice == nil || gasPrice.Sign() == 0 {
+		gasPrice = big.NewInt(1)
+	}
+	return artela, ctx, sender, gasPrice
+}
+
+func scheduleCall(t *testing.T, artela *app.Artela, ctx cosmos.Context, sender cosmos.AccAddress,
+	gasPrice *big.Int, gasLimit uint64, executeAt int64,
+) uint64 {
+	res, err := artela.EvmKeeper.ScheduleCall(ctx, &txs.MsgScheduleCall{
+		Sender:    sender.String(),
+		To:        scheduledCallRecipient.Hex(),
+		Value:     "1000",
+		GasLimit:  gasLimit,
+		GasPrice:  gasPrice.String(),
+		ExecuteAt: executeAt,
+	})
+	require.NoError(t, err)
+	return res.Id
+}
+
+func TestExecuteScheduledCalls(t *testing.T) {
+	artela, ctx, sender, gasPrice := setupScheduledCalls(t)
+	k := artela.EvmKeeper
+	denom := k.GetParams(ctx).EvmDenom
+
+	executeAt := ctx.BlockHeight() + 1
+	id := scheduleCall(t, artela, ctx, sender, gasPrice, 30_000, executeAt)
+	balance := artela.BankKeeper.GetBalance(ctx, sender, denom).Amount
+
+	ctx = ctx.WithBlockHeight(executeAt).WithEventManager(cosmos.NewEventManager())
+	k.ExecuteScheduledCalls(ctx)
+
+	_, found := k.GetScheduledCall(ctx, id)
+	require.False(t, found)
+	require.Equal(t, big.NewInt(1000), k.GetBalance(ctx, scheduledCallRecipient))
+
+	// the fee of the unused gas is refunded, the value is sent from the sender
+	refund := new(big.Int).Mul(gasPrice, big.NewInt(30_000-21_000))
+	expected := balance.Add(sdkmath.NewIntFromBigInt(refund)).SubRaw(1000)
+	require.Equal(t, expected, artela.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	// the call is indexable as a system tx taking the next tx index
+	require.Equal(t, uint64(1), k.GetTxIndexTransient(ctx))
+	systemTxs, err := rpctypes.ParseSystemTxs(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, systemTxs, 1)
+	require.Equal(t, id, systemTxs[0].Tx.Nonce())
+	require.Equal(t, common.BytesToAddress(sender), systemTxs[0].From)
+	require.False(t, systemTxs[0].Failed)
+}
+
+func TestExecuteScheduledCallSettleFailure(t *testing.T) {
+	artela, ctx, sender, gasPrice := setupScheduledCalls(t)
+	k := artela.EvmKeeper
+
+	executeAt := ctx.BlockHeight() + 1
+	id := scheduleCall(t, artela, ctx, sender, gasPrice, 30_000, executeAt)
+
+	// the escrowed fee is gone, the call can't be settled
+	moduleAddr := artela.AccountKeeper.GetModuleAddress(types.ModuleName)
+	escrow := artela.BankKeeper.GetAllBalances(ctx, moduleAddr)
+	require.NoError(t, artela.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmos.AccAddress(scheduledCallRecipient.Bytes()), escrow))
+	recipientBalance := k.GetBalance(ctx, scheduledCallRecipient)
+
+	ctx = ctx.WithBlockHeight(executeAt).WithEventManager(cosmos.NewEventManager())
+	k.ExecuteScheduledCalls(ctx)
+
+	// nothing of the execution is committed, the call is retried in the next block
+	require.Equal(t, recipientBalance, k.GetBalance(ctx, scheduledCallRecipient))
+	require.Equal(t, uint64(0), k.GetTxIndexTransient(ctx))
+	require.Equal(t, uint64(0), k.GetSystemGasUsedTransient(ctx))
+	systemTxs, err := rpctypes.ParseSystemTxs(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Empty(t, systemTxs)
+
+	call, found := k.GetScheduledCall(ctx, id)
+	require.True(t, found)
+	require.Equal(t, executeAt+1, call.ExecuteAt)
+}
+
+func TestExecuteScheduledCallsCap(t *testing.T) {
+	artela, ctx, sender, gasPrice := setupScheduledCalls(t)
+	k := artela.EvmKeeper
+
+	params := k.GetParams(ctx)
+	params.MaxDueScheduledCalls = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	executeAt := ctx.BlockHeight() + 1
+	ids := make([]uint64, 3)
+	for i := range ids {
+		ids[i] = scheduleCall(t, artela, ctx, sender, gasPrice, 30_000, executeAt)
+	}
+
+	ctx = ctx.WithBlockHeight(executeAt)
+	k.ExecuteScheduledCalls(ctx)
+	for i, id := range ids {
+		_, found := k.GetScheduledCall(ctx, id)
+		require.Equal(t, i >= 2, found, "call %d", i)
+	}
+
+	ctx = ctx.WithBlockHeight(executeAt + 1)
+	k.ExecuteScheduledCalls(ctx)
+	_, found := k.GetScheduledCall(ctx, ids[2])
+	require.False(t, found)
+}
+
+func TestExecuteScheduledCallsSkipped(t *testing.T) {
+	artela, ctx, sender, gasPrice := setupScheduledCalls(t)
+	k := artela.EvmKeeper
+
+	params := k.GetParams(ctx)
+	params.MaxDueScheduledCalls = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	executeAt := ctx.BlockHeight() + 1
+	large := scheduleCall(t, artela, ctx, sender, gasPrice, 60_000, executeAt)
+	small := scheduleCall(t, artela, ctx, sender, gasPrice, 30_000, executeAt)
+
+	// the large call doesn't fit in the budget anymore
+	params.ScheduledCallBlockGasBudget = 50_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(executeAt)
+	k.ExecuteScheduledCalls(ctx)
+	call, found := k.GetScheduledCall(ctx, large)
+	require.True(t, found)
+	require.Equal(t, executeAt+1, call.ExecuteAt)
+
+	// the skipped call is behind the small one, which isn't held back by it
+	ctx = ctx.WithBlockHeight(executeAt + 1)
+	k.ExecuteScheduledCalls(ctx)
+	_, found = k.GetScheduledCall(ctx, small)
+	require.False(t, found)
+	_, found = k.GetScheduledCall(ctx, large)
+	require.True(t, found)
+}
+
+func TestScheduleCallGasLimit(t *testing.T) {
+	artela, ctx, sender, gasPrice := setupScheduledCalls(t)
+	k := artela.EvmKeeper
+
+	params := k.GetParams(ctx)
+	params.ScheduledCallBlockGasBudget = 50_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := k.ScheduleCall(ctx, &txs.MsgScheduleCall{
+		Sender:    sender.String(),
+		To:        scheduledCallRecipient.Hex(),
+		GasLimit:  60_000,
+		GasPrice:  gasPrice.String(),
+		ExecuteAt: ctx.BlockHeight() + 1,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidGasLimit)
+}
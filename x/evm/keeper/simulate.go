@@ -0,0 +1,371 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/artela-network/artela-evm/vm"
+
+	artela "github.com/artela-network/artela/ethereum/types"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// simulateTimeIncrement is the seconds between the simulated blocks whose time is not
+// overridden, the same as go-ethereum.
+const simulateTimeIncrement = 12
+
+// simulateHeader is the header of a simulated block.
+type simulateHeader struct {
+	number     uint64
+	hash       common.Hash
+	parentHash common.Hash
+	time       uint64
+	gasLimit   uint64
+	coinbase   common.Address
+	random     *common.Hash
+	baseFee    *big.Int
+}
+
+// EthSimulate implements the `eth_simulateV1` rpc api. The blocks of calls are simulated in
+// sequence on top of the requested state, the calls are committed to a branch of the query
+// state, so each call sees the effects of the calls and the state overrides before it. The
+// gas cap of the query caps the total gas of all the calls.
+func (k Keeper) EthSimulate(c context.Context, req *txs.EthCallRequest) (*txs.EthSimulateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var opts txs.SimulateOptions
+	if err := json.Unmarshal(req.Args, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(opts.BlockStateCalls) > txs.MaxSimulateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks, %d exceeds the max %d", len(opts.BlockStateCalls), txs.MaxSimulateBlocks)
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the calls are committed to a branch of the query state, which is discarded along with it
+	ctx, _ = ctx.CacheContext()
+	k.applyPendingTxs(ctx, cfg, req.Pending)
+
+	gasLeft := k.QueryGasCap(req.GasCap)
+	if gasLeft == 0 {
+		gasLeft = math.MaxUint64
+	}
+	blockGasLimit := artela.BlockGasLimit(ctx)
+	if blockGasLimit == 0 {
+		blockGasLimit = gasLeft
+	}
+
+	parent := &simulateHeader{
+		number:   uint64(ctx.BlockHeight()), // #nosec G701
+		hash:     common.BytesToHash(ctx.HeaderHash()),
+		time:     uint64(ctx.BlockTime().Unix()), // #nosec G701
+		gasLimit: blockGasLimit,
+		coinbase: cfg.CoinBase,
+		baseFee:  cfg.BaseFee,
+	}
+
+	results := make([]*txs.SimulateBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		header, err := simulateBlockHeader(parent, block.BlockOverrides, opts.Validation)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err)
+		}
+
+		overrides, err := parseStateOverride(block.StateOverrides)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err)
+		}
+		if err := k.commitStateOverride(ctx, overrides); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err)
+		}
+
+		result, err := k.simulateBlock(ctx, cfg, header, block.Calls, opts.Validation, &gasLeft)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err)
+		}
+		results = append(results, result)
+		parent = header
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &txs.EthSimulateResponse{Data: bz}, nil
+}
+
+// simulateBlockHeader returns the header of a simulated block following the parent, with the
+// block overrides applied. The base fee is zero if not overridden, unless the calls are
+// validated.
+func simulateBlockHeader(parent *simulateHeader, overrides *txs.SimulateBlockOverrides, validation bool) (*simulateHeader, error) {
+	header := &simulateHeader{
+		number:     parent.number + 1,
+		parentHash: parent.hash,
+		time:       parent.time + simulateTimeIncrement,
+		gasLimit:   parent.gasLimit,
+		coinbase:   parent.coinbase,
+		baseFee:    parent.baseFee,
+	}
+	if !validation {
+		header.baseFee = big.NewInt(0)
+	}
+	if overrides == nil {
+		return header, nil
+	}
+
+	if overrides.Number != nil {
+		number := overrides.Number.ToInt()
+		if !number.IsUint64() || number.Uint64() <= parent.number {
+			return nil, fmt.Errorf("block number %s must be greater than %d", number, parent.number)
+		}
+		header.number = number.Uint64()
+	}
+	if overrides.Time != nil {
+		if uint64(*overrides.Time) <= parent.time {
+			return nil, fmt.Errorf("block time %d must be greater than %d", uint64(*overrides.Time), parent.time)
+		}
+		header.time = uint64(*overrides.Time)
+	}
+	if overrides.GasLimit != nil {
+		header.gasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.FeeRecipient != nil {
+		header.coinbase = *overrides.FeeRecipient
+	}
+	header.random = overrides.PrevRandao
+	if overrides.BaseFeePerGas != nil {
+		header.baseFee = overrides.BaseFeePerGas.ToInt()
+	}
+	return header, nil
+}
+
+// simulateBlock runs the calls of a simulated block in sequence, committing each of them to
+// ctx. The calls without gas get the gas left in the block, capped by the gas left of the
+// simulation, which is reduced by the gas used of the calls.
+func (k Keeper) simulateBlock(
+	ctx cosmos.Context,
+	cfg *states.EVMConfig,
+	header *simulateHeader,
+	calls []txs.TransactionArgs,
+	validation bool,
+	gasLeft *uint64,
+) (*txs.SimulateBlockResult, error) {
+	number := new(big.Int).SetUint64(header.number)
+	blockCfg := *cfg
+	blockCfg.BaseFee = header.baseFee
+	blockCfg.BlockOverrides = &states.BlockOverrides{
+		Number:   (*hexutil.Big)(number),
+		Time:     (*hexutil.Uint64)(&header.time),
+		GasLimit: (*hexutil.Uint64)(&header.gasLimit),
+		Coinbase: &header.coinbase,
+		Random:   header.random,
+		BaseFee:  (*hexutil.Big)(header.baseFee),
+	}
+
+	result := &txs.SimulateBlockResult{
+		Number:        hexutil.Uint64(header.number),
+		Timestamp:     hexutil.Uint64(header.time),
+		GasLimit:      hexutil.Uint64(header.gasLimit),
+		FeeRecipient:  header.coinbase,
+		BaseFeePerGas: (*hexutil.Big)(header.baseFee),
+		Calls:         make([]txs.SimulateCallResult, 0, len(calls)),
+	}
+
+	var gasUsed uint64
+	var logs []*ethereum.Log
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for i, args := range calls {
+		gas := header.gasLimit - gasUsed
+		if *gasLeft < gas {
+			gas = *gasLeft
+		}
+		if args.Gas == nil {
+			args.Gas = (*hexutil.Uint64)(&gas)
+		} else if uint64(*args.Gas) > gas {
+			return nil, fmt.Errorf("call %d: gas %d exceeds the %d gas left", i, uint64(*args.Gas), gas)
+		}
+
+		from := args.GetFrom()
+		nonce := k.GetNonce(ctx, from)
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		} else if validation && uint64(*args.Nonce) != nonce {
+			return nil, fmt.Errorf("call %d: invalid nonce %d of %s, expected %d", i, uint64(*args.Nonce), from.Hex(), nonce)
+		}
+
+		msg, err := args.ToMessage(uint64(*args.Gas), header.baseFee)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		if validation {
+			if header.baseFee != nil && msg.GasFeeCap.Cmp(header.baseFee) < 0 {
+				return nil, fmt.Errorf("call %d: max fee per gas %s less than block base fee %s", i, msg.GasFeeCap, header.baseFee)
+			}
+			cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+			cost.Add(cost, msg.Value)
+			if balance := k.GetBalance(ctx, from); balance.Cmp(cost) < 0 {
+				return nil, fmt.Errorf("call %d: insufficient funds for gas * price + value of %s, have %s want %s", i, from.Hex(), balance, cost)
+			}
+		}
+
+		ethTx := args.ToTransaction().AsTransaction()
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+
+		// Aspect Runtime Context Lifecycle: create aspect context.
+		// This marks the beginning of running an aspect of the simulated call, creating the aspect context,
+		// and establishing the link with the SDK context.
+		callCtx, aspectCtx := k.WithAspectContext(ctx, ethTx, &blockCfg,
+			artelatypes.NewEthBlockContextFromQuery(ctx, k.clientContext))
+		rsp, err := k.ApplyMessageWithConfig(callCtx, aspectCtx, msg, txs.NewNoOpTracer(), true, &blockCfg, txConfig)
+		aspectCtx.Destroy()
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		// the nonce of the calls is bumped by the ante handler for the txs
+		if msg.To != nil {
+			account := k.GetAccountOrEmpty(ctx, from)
+			account.Nonce = msg.Nonce + 1
+			if err := k.SetAccount(ctx, from, account); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+
+		gasUsed += rsp.GasUsed
+		*gasLeft -= rsp.GasUsed
+
+		callLogs := support.LogsToEthereum(rsp.Logs)
+		for _, log := range callLogs {
+			log.BlockNumber = header.number
+			log.TxHash = ethTx.Hash()
+			log.TxIndex = uint(i)
+			log.Index = uint(len(logs))
+			logs = append(logs, log)
+		}
+		txConfig.LogIndex += uint(len(callLogs))
+
+		call := txs.SimulateCallResult{
+			ReturnData: rsp.Ret,
+			Logs:       callLogs,
+			GasUsed:    hexutil.Uint64(rsp.GasUsed),
+			Status:     hexutil.Uint64(ethereum.ReceiptStatusSuccessful),
+		}
+		if rsp.Failed() {
+			call.Status = hexutil.Uint64(ethereum.ReceiptStatusFailed)
+			call.Error = &txs.SimulateCallError{Code: txs.SimulateErrCodeVMError, Message: rsp.VmError}
+			if rsp.VmError == vm.ErrExecutionReverted.Error() {
+				revertErr := types.NewExecErrorWithReason(rsp.Ret)
+				call.Error = &txs.SimulateCallError{
+					Code:    txs.SimulateErrCodeReverted,
+					Message: revertErr.Error(),
+					Data:    hexutil.Encode(rsp.Ret),
+				}
+			}
+		}
+		if call.Logs == nil {
+			call.Logs = []*ethereum.Log{}
+		}
+		result.Calls = append(result.Calls, call)
+	}
+
+	// the hash of the simulated block is the hash of its header, it's not a block of the chain
+	ethHeader := &ethereum.Header{
+		ParentHash: header.parentHash,
+		Coinbase:   header.coinbase,
+		Number:     number,
+		GasLimit:   header.gasLimit,
+		GasUsed:    gasUsed,
+		Time:       header.time,
+		Difficulty: big.NewInt(0),
+		BaseFee:    header.baseFee,
+	}
+	if header.random != nil {
+		ethHeader.MixDigest = *header.random
+	}
+	header.hash = ethHeader.Hash()
+	for _, log := range logs {
+		log.BlockHash = header.hash
+	}
+
+	result.Hash = header.hash
+	result.ParentHash = ethHeader.ParentHash
+	result.GasUsed = hexutil.Uint64(gasUsed)
+	return result, nil
+}
+
+// commitStateOverride writes the state overrides to the state of ctx, so they're seen by
+// all the calls after them. The overridden state replaces the entire storage of the account,
+// while the overridden state diff is written on top of it.
+func (k Keeper) commitStateOverride(ctx cosmos.Context, overrides states.StateOverride) error {
+	for addr, override := range overrides {
+		if override.State != nil && override.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		account := k.GetAccountOrEmpty(ctx, addr)
+		if override.Nonce != nil {
+			account.Nonce = uint64(*override.Nonce)
+		}
+		if override.Balance != nil {
+			account.Balance = new(big.Int).Set((*big.Int)(*override.Balance))
+		}
+		if override.Code != nil {
+			account.CodeHash = crypto.Keccak256(*override.Code)
+			k.SetCode(ctx, account.CodeHash, *override.Code)
+		}
+		if err := k.SetAccount(ctx, addr, account); err != nil {
+			return err
+		}
+
+		if override.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.SetState(ctx, addr, key, nil)
+			}
+		}
+		for _, storage := range []*map[common.Hash]common.Hash{override.State, override.StateDiff} {
+			if storage == nil {
+				continue
+			}
+			for key, value := range *storage {
+				if value == (common.Hash{}) {
+					k.SetState(ctx, addr, key, nil)
+					continue
+				}
+				k.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+	}
+	return nil
+}
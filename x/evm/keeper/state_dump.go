@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// DumpAccounts dumps at most max accounts from the start address, in the order of the raw
+// addresses. It returns the address following the dumped accounts, nil if the iteration is
+// done. The storage root of an account is computed from its storage, as the root of the
+// storage trie of the account in ethereum.
+func (k *Keeper) DumpAccounts(ctx cosmos.Context, start common.Address, max uint64, noCode, noStorage bool) (map[common.Address]state.DumpAccount, *common.Address) {
+	accounts := make(map[common.Address]state.DumpAccount)
+	var next *common.Address
+
+	// the accounts are iterated in the order of their store keys, the raw addresses
+	k.accountKeeper.IterateAccounts(ctx, func(acct authtypes.AccountI) bool {
+		address := common.BytesToAddress(acct.GetAddress())
+		if bytes.Compare(address.Bytes(), start.Bytes()) < 0 {
+			return false
+		}
+		if uint64(len(accounts)) >= max {
+			next = &address
+			return true
+		}
+
+		stateAcct := k.GetAccount(ctx, address)
+		if stateAcct == nil {
+			return false
+		}
+		dumpAcct := state.DumpAccount{
+			Balance:  stateAcct.Balance.String(),
+			Nonce:    stateAcct.Nonce,
+			CodeHash: stateAcct.CodeHash,
+		}
+		if !noCode {
+			dumpAcct.Code = k.GetCode(ctx, common.BytesToHash(stateAcct.CodeHash))
+		}
+
+		var storage map[common.Hash]string
+		if !noStorage {
+			storage = make(map[common.Hash]string)
+		}
+		dumpAcct.Root = k.storageRoot(ctx, address, storage).Bytes()
+		if len(storage) > 0 {
+			dumpAcct.Storage = storage
+		}
+
+		accounts[address] = dumpAcct
+		return false
+	})
+	return accounts, next
+}
+
+// storageRoot computes the root of the storage trie of the account, the storage is
+// collected into storage if it's not nil, the values are hex encoded without the
+// leading zeroes as geth dumps them.
+func (k *Keeper) storageRoot(ctx cosmos.Context, address common.Address, storage map[common.Hash]string) common.Hash {
+	type slot struct {
+		hashedKey common.Hash
+		value     []byte
+	}
+	var slots []slot
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		trimmed := common.TrimLeftZeroes(value.Bytes())
+		if len(trimmed) == 0 {
+			return true
+		}
+		slots = append(slots, slot{hashedKey: crypto.Keccak256Hash(key.Bytes()), value: trimmed})
+		if storage != nil {
+			storage[key] = common.Bytes2Hex(trimmed)
+		}
+		return true
+	})
+
+	// the stack trie requires the keys inserted in order
+	sort.Slice(slots, func(i, j int) bool {
+		return bytes.Compare(slots[i].hashedKey.Bytes(), slots[j].hashedKey.Bytes()) < 0
+	})
+	st := trie.NewStackTrie(nil)
+	for _, s := range slots {
+		// the values can't fail to be encoded
+		value, _ := rlp.EncodeToBytes(s.value)
+		_ = st.Update(s.hashedKey.Bytes(), value)
+	}
+	return st.Hash()
+}
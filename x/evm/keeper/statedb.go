@@ -181,6 +181,22 @@ func (k *Keeper) ForEachStorage(ctx cosmos.Context, addr common.Address, cb func
 	}
 }
 
+// ForEachStorageFrom iterate contract storage in the key order from the start key, callback
+// return false to break early
+func (k *Keeper) ForEachStorageFrom(ctx cosmos.Context, addr common.Address, start common.Hash, cb func(key, value common.Hash) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+
+	iterator := store.Iterator(start.Bytes(), nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// check if iteration stops
+		if !cb(common.BytesToHash(iterator.Key()), common.BytesToHash(iterator.Value())) {
+			return
+		}
+	}
+}
+
 // DeleteAccount handles contract's suicide call:
 // - clear balance
 // - remove code
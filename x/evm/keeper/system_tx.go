@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela/x/evm/artela/provider"
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/txs"
+	"github.com/artela-network/artela/x/evm/txs/support"
+	"github.com/artela-network/artela/x/evm/types"
+	inherent "github.com/artela-network/aspect-core/chaincoreext/jit_inherent"
+)
+
+// GetSystemGasUsedTransient returns the gas used by the system txs of the current block.
+func (k Keeper) GetSystemGasUsedTransient(ctx cosmos.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientSystemGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return cosmos.BigEndianToUint64(bz)
+}
+
+// SetSystemGasUsedTransient sets the gas used by the system txs of the current block.
+func (k Keeper) SetSystemGasUsedTransient(ctx cosmos.Context, gasUsed uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientSystemGasUsed, cosmos.Uint64ToBigEndian(gasUsed))
+}
+
+// SystemCall is a call applied as a system txs by CallEVM.
+type SystemCall struct {
+	From     common.Address
+	To       *common.Address
+	Value    *big.Int
+	Data     []byte
+	GasLimit uint64
+	// GasPrice is the gas price seen by the contracts, zero if it's nil. The fee isn't
+	// charged by CallEVM, the callers paying a fee for the system txs settle it themselves.
+	GasPrice *big.Int
+	// Nonce is the nonce of the txs, the nonce of the sender is used and increased if it's
+	// nil. Otherwise the nonce of the sender is left as it is, e.g. the scheduled calls use
+	// their ids, so the pending txs of the sender aren't affected.
+	Nonce *uint64
+	// Settle is called after the execution with the result, in the same cached context as the
+	// EVM state changes, e.g. to pay the fee. If it fails, nothing of the call is committed.
+	Settle func(ctx cosmos.Context, res *txs.MsgEthereumTxResponse) error
+}
+
+// systemTxGasLeft returns the gas left in the SystemTxBlockGasBudget of the block.
+func (k Keeper) systemTxGasLeft(ctx cosmos.Context) uint64 {
+	budget := k.GetParams(ctx).SystemTxBlockGasBudget
+	systemGasUsed := k.GetSystemGasUsedTransient(ctx)
+	if systemGasUsed >= budget {
+		return 0
+	}
+	return budget - systemGasUsed
+}
+
+// CallEVM applies a system txs originated by the protocol, e.g. a scheduled call executed in
+// the begin blocker. The system txs skip the ante handlers and the fee deduction, and are
+// metered against the SystemTxBlockGasBudget of the block instead. They take the next txs
+// index of the block like the ethereum txs, and their receipts are emitted with the events
+// of the ethereum txs marked with the systemTx attribute, along with the raw txs, so they're
+// indexed as the ethereum txs of the block. It must be called from the begin and end
+// blockers only, so the events are not mixed up with the ones of the cosmos txs.
+//
+// A system txs failing in the EVM or rejected before the execution is included with a
+// failed receipt, an error is returned only if it doesn't fit in the budget, can't be
+// applied at all or can't be settled, and then nothing of it is committed.
+func (k *Keeper) CallEVM(ctx cosmos.Context, call SystemCall) (*txs.MsgEthereumTxResponse, error) {
+	if gasLeft := k.systemTxGasLeft(ctx); call.GasLimit > gasLeft {
+		return nil, errorsmod.Wrapf(types.ErrSystemTxGasBudget, "gas limit %d, %d left of %d",
+			call.GasLimit, gasLeft, k.GetParams(ctx).SystemTxBlockGasBudget)
+	}
+	if call.To == nil && call.Nonce != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "a contract creation must use the nonce of the sender")
+	}
+
+	// the system txs run in begin and end blockers, the sdk gas is not charged for them
+	ctx = ctx.WithGasMeter(cosmos.NewInfiniteGasMeter())
+
+	evmConfig, err := k.EVMConfigFromCtx(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	value, gasPrice := call.Value, call.GasPrice
+	if value == nil {
+		value = new(big.Int)
+	}
+	if gasPrice == nil {
+		gasPrice = new(big.Int)
+	}
+
+	senderNonce := k.GetNonce(ctx, call.From)
+	nonce := senderNonce
+	if call.Nonce != nil {
+		nonce = *call.Nonce
+	}
+
+	tx := txs.NewSystemTx(call.From, nonce, call.To, value, call.GasLimit, gasPrice, call.Data)
+	msg := &core.Message{
+		From:              call.From,
+		To:                call.To,
+		Nonce:             senderNonce,
+		Value:             value,
+		GasLimit:          call.GasLimit,
+		GasPrice:          gasPrice,
+		GasFeeCap:         gasPrice,
+		GasTipCap:         gasPrice,
+		Data:              call.Data,
+		SkipAccountChecks: true,
+	}
+	txConfig := k.TxConfig(ctx, tx.Hash(), tx.Type())
+
+	// the EVM state changes are committed to the settle context if the execution succeeds,
+	// which is committed to ctx if the settlement succeeds
+	settleCtx, commitSettlement := ctx.CacheContext()
+	evmCtx, commitExecution := settleCtx.CacheContext()
+	aspectCtx := k.newSystemTxAspectContext(evmCtx, tx, evmConfig, txConfig)
+	defer aspectCtx.Destroy()
+
+	res, err := k.ApplyMessageWithConfig(evmCtx.WithValue(artelatypes.AspectContextKey, aspectCtx),
+		aspectCtx, msg, nil, true, evmConfig, txConfig)
+	switch {
+	case err != nil:
+		// the txs is rejected before the execution, e.g. the calls are disabled by the
+		// params, it's included as a failed txs using no gas
+		res = &txs.MsgEthereumTxResponse{Hash: tx.Hash().Hex(), VmError: err.Error()}
+	case !res.Failed():
+		commitExecution()
+	default:
+		res.Logs = nil
+	}
+
+	if call.Nonce == nil {
+		// take over the nonce management from the ante handler
+		account := k.GetAccountOrEmpty(settleCtx, call.From)
+		account.Nonce = senderNonce + 1
+		if err := k.SetAccount(settleCtx, call.From, account); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to increase system txs sender nonce")
+		}
+	}
+
+	if call.Settle != nil {
+		if err := call.Settle(settleCtx, res); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to settle system txs")
+		}
+	}
+	commitSettlement()
+
+	k.addBlockLogs(ctx, txConfig, support.LogsToEthereum(res.Logs))
+	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
+	k.SetSystemGasUsedTransient(ctx, k.GetSystemGasUsedTransient(ctx)+res.GasUsed)
+
+	if err := k.emitSystemTxEvents(ctx, call.From, tx, txConfig.TxIndex, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// newSystemTxAspectContext creates the aspect runtime context of a system txs, the same way
+// the ante handler does for the ethereum txs in deliver txs.
+func (k *Keeper) newSystemTxAspectContext(ctx cosmos.Context, tx *ethereum.Transaction,
+	evmConfig *states.EVMConfig, txConfig states.TxConfig,
+) *artelatypes.AspectRuntimeContext {
+	stateDB := states.New(ctx, k, txConfig)
+	ethTxContext := artelatypes.NewEthTxContext(tx).WithEVMConfig(evmConfig).WithStateDB(stateDB)
+
+	aspectCtx := artelatypes.NewAspectRuntimeContext()
+	protocol := provider.NewAspectProtocolProvider(aspectCtx.EthTxContext)
+	aspectCtx.SetEthTxContext(ethTxContext, inherent.NewManager(protocol))
+
+	blockCtx := k.GetBlockContext()
+	if blockCtx == nil {
+		// the block context is released in the evm end blocker
+		blockCtx = artelatypes.NewEthBlockContextFromHeight(ctx.BlockHeight())
+	}
+	aspectCtx.SetEthBlockContext(blockCtx)
+	aspectCtx.WithCosmosContext(ctx)
+	aspectCtx.CreateStateObject()
+	return aspectCtx
+}
+
+// addBlockLogs adds the logs of a txs applied outside of the ethereum txs, e.g. a system txs,
+// to the bloom filter and the log size of the block.
+func (k *Keeper) addBlockLogs(ctx cosmos.Context, txConfig states.TxConfig, logs []*ethereum.Log) {
+	if len(logs) == 0 {
+		return
+	}
+
+	k.SetTxBloomTransient(ctx, uint64(txConfig.TxIndex), ethereum.BytesToBloom(ethereum.LogsBloom(logs)))
+	k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(logs)))
+}
+
+// emitSystemTxEvents emits the receipt of a system txs with the events of the ethereum txs,
+// with the raw txs as it's not in the block, so it can be indexed from the block events.
+func (k *Keeper) emitSystemTxEvents(ctx cosmos.Context, from common.Address,
+	tx *ethereum.Transaction, txIndex uint, res *txs.MsgEthereumTxResponse,
+) error {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode system txs")
+	}
+
+	attrs := []cosmos.Attribute{
+		cosmos.NewAttribute(cosmos.AttributeKeyAmount, tx.Value().String()),
+		cosmos.NewAttribute(types.AttributeKeyEthereumTxHash, res.Hash),
+		cosmos.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(uint64(txIndex), 10)),
+		cosmos.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		cosmos.NewAttribute(types.AttributeKeySystemTx, "true"),
+		cosmos.NewAttribute(types.AttributeKeyTxData, hexutil.Encode(rawTx)),
+	}
+
+	if to := tx.To(); to != nil {
+		attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
+	}
+
+	if res.Failed() {
+		attrs = append(attrs, cosmos.NewAttribute(types.AttributeKeyEthereumTxFailed, res.VmError))
+	}
+
+	txLogAttrs := make([]cosmos.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = cosmos.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(cosmos.Events{
+		cosmos.NewEvent(types.EventTypeEthereumTx, attrs...),
+		cosmos.NewEvent(types.EventTypeTxLog, txLogAttrs...),
+		cosmos.NewEvent(
+			cosmos.EventTypeMessage,
+			cosmos.NewAttribute(cosmos.AttributeKeyModule, types.AttributeValueCategory),
+			cosmos.NewAttribute(cosmos.AttributeKeySender, from.Hex()),
+			cosmos.NewAttribute(types.AttributeKeyTxType, strconv.FormatUint(uint64(tx.Type()), 10)),
+		),
+	})
+	return nil
+}
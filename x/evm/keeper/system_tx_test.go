@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/keeper"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+func TestCallEVM(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		recipient = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	// the txs of the block before the system txs
+	k.SetTxIndexTransient(ctx, 2)
+
+	res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 30_000})
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, uint64(21_000), res.GasUsed)
+
+	// the system txs uses and increases the nonce of the sender, and takes the next tx index
+	require.Equal(t, uint64(1), k.GetNonce(ctx, sender))
+	require.Equal(t, uint64(3), k.GetTxIndexTransient(ctx))
+	require.Equal(t, uint64(21_000), k.GetSystemGasUsedTransient(ctx))
+
+	// a given nonce leaves the one of the sender untouched
+	nonce := uint64(100)
+	res2, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 30_000, Nonce: &nonce})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetNonce(ctx, sender))
+	require.Equal(t, uint64(4), k.GetTxIndexTransient(ctx))
+
+	// the receipts are indexable from the events
+	systemTxs, err := rpctypes.ParseSystemTxs(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, systemTxs, 2)
+	for i, expected := range []struct {
+		hash    string
+		index   int32
+		nonce   uint64
+		gasUsed uint64
+	}{
+		{res.Hash, 2, 0, res.GasUsed},
+		{res2.Hash, 3, 100, res2.GasUsed},
+	} {
+		systemTx := systemTxs[i]
+		require.Equal(t, expected.hash, systemTx.Tx.Hash().Hex())
+		require.Equal(t, expected.index, systemTx.EthTxIndex)
+		require.Equal(t, expected.nonce, systemTx.Tx.Nonce())
+		require.Equal(t, expected.gasUsed, systemTx.GasUsed)
+		require.Equal(t, sender, systemTx.From)
+		require.False(t, systemTx.Failed)
+	}
+	require.NotEqual(t, systemTxs[0].Tx.Hash(), systemTxs[1].Tx.Hash())
+
+	// a contract creation must use the nonce of the sender
+	_, err = k.CallEVM(ctx, keeper.SystemCall{From: sender, GasLimit: 30_000, Nonce: &nonce})
+	require.Error(t, err)
+}
+
+func TestCallEVMFailure(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		recipient = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	// the sender can't pay the value, the system txs is included with a failed receipt
+	res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, Value: big.NewInt(1), GasLimit: 30_000})
+	require.NoError(t, err)
+	require.True(t, res.Failed())
+	require.Equal(t, uint64(1), k.GetTxIndexTransient(ctx))
+
+	systemTxs, err := rpctypes.ParseSystemTxs(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, systemTxs, 1)
+	require.True(t, systemTxs[0].Failed)
+	require.Equal(t, int32(0), systemTxs[0].EthTxIndex)
+}
+
+func TestCallEVMGasBudget(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		recipient = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+
+	params := k.GetParams(ctx)
+	params.SystemTxBlockGasBudget = 60_000
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 30_000})
+	require.NoError(t, err)
+
+	// the gas limit is checked against the gas left in the budget, not the gas used
+	_, err = k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 40_000})
+	require.ErrorIs(t, err, types.ErrSystemTxGasBudget)
+	_, err = k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 39_000})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetTxIndexTransient(ctx))
+
+	// a zero budget disables the system txs
+	params.SystemTxBlockGasBudget = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &recipient, GasLimit: 21_000})
+	require.ErrorIs(t, err, types.ErrSystemTxGasBudget)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	artcore "github.com/artela-network/artela-evm/core"
+	"github.com/artela-network/artela-evm/vm"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+// SetBlockedAddrs sets the module accounts the EVM is not allowed to send funds to,
+// the keys are the bech32 account addresses, e.g. the ones the bank keeper blocks.
+func (k *Keeper) SetBlockedAddrs(addrs map[string]bool) {
+	k.blockedAddrs = make(map[common.Address]bool, len(addrs))
+	for addr, blocked := range addrs {
+		if !blocked {
+			continue
+		}
+		accAddr, err := cosmos.AccAddressFromBech32(addr)
+		if err != nil {
+			panic(err)
+		}
+		k.blockedAddrs[common.BytesToAddress(accAddr)] = true
+	}
+}
+
+// IsBlockedAddr returns true if the EVM is not allowed to send funds to the address.
+func (k *Keeper) IsBlockedAddr(addr common.Address) bool {
+	return k.blockedAddrs[addr]
+}
+
+// Transfer is the vm.TransferFunc wired into the block context. Funds sent to a blocked
+// module account from the EVM are unrecoverable, so such a transfer is not applied and
+// the call receiving it reverts instead of running the code of the account, which fails
+// the call frame like any reverted call, see states.StateDB.RevertCall.
+func (k *Keeper) Transfer(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+	if amount.Sign() != 0 && k.IsBlockedAddr(recipient) {
+		if stateDB, ok := db.(*states.StateDB); ok {
+			stateDB.RevertCall(recipient, types.ErrBlockedAddress.Error())
+			return
+		}
+		panic(errorsmod.Wrapf(types.ErrBlockedAddress, "%s is a module account", recipient.Hex()))
+	}
+	artcore.Transfer(db, sender, recipient, amount)
+}
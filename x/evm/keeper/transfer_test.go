@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/app"
+	"github.com/artela-network/artela/x/evm/keeper"
+	"github.com/artela-network/artela/x/evm/states"
+	"github.com/artela-network/artela/x/evm/types"
+)
+
+var blockedAddr = common.BytesToAddress(authtypes.NewModuleAddress(distrtypes.ModuleName))
+
+func TestTransferToBlockedAddress(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		caller = common.HexToAddress("0x1000000000000000000000000000000000000005")
+	)
+
+	artela := app.Setup(t)
+	ctx := app.NewTestContext(t, artela)
+	k := artela.EvmKeeper
+	require.True(t, k.IsBlockedAddr(blockedAddr))
+
+	// the caller sends 1 to the blocked address and returns the success of the call
+	code := []byte{
+		byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.PUSH1), 1,
+		byte(vm.PUSH20),
+	}
+	code = append(code, blockedAddr.Bytes()...)
+	code = append(code,
+		byte(vm.GAS), byte(vm.CALL),
+		byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	stateDB := states.New(ctx, k, states.NewEmptyTxConfig(common.Hash{}))
+	stateDB.SetCode(caller, code)
+	stateDB.AddBalance(caller, big.NewInt(10))
+	stateDB.AddBalance(sender, big.NewInt(10))
+	require.NoError(t, stateDB.Commit())
+	blockedBalance := k.GetBalance(ctx, blockedAddr)
+
+	t.Run("top-level call", func(t *testing.T) {
+		res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &blockedAddr, Value: big.NewInt(1), GasLimit: 30_000})
+		require.NoError(t, err)
+		require.True(t, res.Failed())
+		require.Equal(t, vm.ErrExecutionReverted.Error(), res.VmError)
+		reason, err := abi.UnpackRevert(res.Ret)
+		require.NoError(t, err)
+		require.Equal(t, types.ErrBlockedAddress.Error(), reason)
+
+		require.Equal(t, blockedBalance, k.GetBalance(ctx, blockedAddr))
+		require.Equal(t, big.NewInt(10), k.GetBalance(ctx, sender))
+	})
+
+	t.Run("inner call", func(t *testing.T) {
+		res, err := k.CallEVM(ctx, keeper.SystemCall{From: sender, To: &caller, GasLimit: 100_000})
+		require.NoError(t, err)
+		require.False(t, res.Failed(), res.VmError)
+
+		// only the frame of the inner call fails
+		require.Equal(t, common.Hash{}, common.BytesToHash(res.Ret))
+		require.Equal(t, blockedBalance, k.GetBalance(ctx, blockedAddr))
+		require.Equal(t, big.NewInt(10), k.GetBalance(ctx, caller))
+		require.Empty(t, k.GetCode(ctx, common.BytesToHash(k.GetAccountOrEmpty(ctx, blockedAddr).CodeHash)))
+	})
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela/x/evm/states"
+)
+
+var _ states.WitnessKeeper = &Keeper{}
+
+// SetWitnessDB sets the node local db where the execution witnesses of the blocks
+// are stored.
+func (k *Keeper) SetWitnessDB(db dbm.DB) {
+	k.witnessDB = db
+	k.blockWitness = states.NewWitness()
+}
+
+// WitnessEnabled returns true if the execution witnesses are recorded.
+func (k *Keeper) WitnessEnabled() bool {
+	return k.witnessDB != nil
+}
+
+// AddWitness adds the state read by a committed tx to the witness of the current
+// block, the check txs and simulations are skipped.
+func (k *Keeper) AddWitness(ctx cosmos.Context, witness *states.Witness) {
+	if k.witnessDB == nil || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+	k.blockWitness.Merge(witness)
+}
+
+// FlushWitness stores the execution witness of the current block and resets it.
+// The witness is node local data and doesn't affect consensus, so a failed
+// write is only logged.
+func (k *Keeper) FlushWitness(ctx cosmos.Context) {
+	if k.witnessDB == nil {
+		return
+	}
+
+	witness := k.blockWitness
+	k.blockWitness = states.NewWitness()
+	if witness.Empty() {
+		return
+	}
+
+	bz, err := json.Marshal(witness)
+	if err != nil {
+		k.Logger(ctx).Error("failed to encode witness", "height", ctx.BlockHeight(), "error", err)
+		return
+	}
+	if err := k.witnessDB.Set(states.WitnessKey(ctx.BlockHeight()), bz); err != nil {
+		k.Logger(ctx).Error("failed to record witness", "height", ctx.BlockHeight(), "error", err)
+	}
+}
@@ -0,0 +1,41 @@
+package states
+
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccessCount is the number of the storage reads and writes of a contract.
+type AccessCount struct {
+	Reads  uint64 `json:"reads"`
+	Writes uint64 `json:"writes"`
+}
+
+// AccessStats counts the storage accesses by contract address.
+type AccessStats map[common.Address]*AccessCount
+
+// Merge adds the counters of other to s.
+func (s AccessStats) Merge(other AccessStats) {
+	for addr, count := range other {
+		if c, ok := s[addr]; ok {
+			c.Reads += count.Reads
+			c.Writes += count.Writes
+		} else {
+			s[addr] = &AccessCount{Reads: count.Reads, Writes: count.Writes}
+		}
+	}
+}
+
+func (s AccessStats) count(addr common.Address) *AccessCount {
+	c, ok := s[addr]
+	if !ok {
+		c = &AccessCount{}
+		s[addr] = c
+	}
+	return c
+}
+
+// AccessStatsKey returns the key of the access stats of a block in the access stats db.
+func AccessStatsKey(height int64) []byte {
+	return cosmos.Uint64ToBigEndian(uint64(height)) // #nosec G701
+}
@@ -15,6 +15,12 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides is the state overrides of the queried executions, nil for the txs
+	Overrides StateOverride
+	// BlockOverrides is the block context overrides of the queried executions, nil for the txs
+	BlockOverrides *BlockOverrides
+	// GasCap is the max gas of the queried executions, 0 for the txs or no cap
+	GasCap uint64
 }
 
 // TxConfig encapulates the readonly information of current txs for `StateDB`.
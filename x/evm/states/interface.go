@@ -31,3 +31,23 @@ type Keeper interface {
 	SetCode(ctx cosmos.Context, codeHash []byte, code []byte)
 	DeleteAccount(ctx cosmos.Context, addr common.Address) error
 }
+
+// PreimageKeeper is implemented by the keepers recording the SHA3 preimages seen
+// by the VM, the preimages are written by `StateDB.Commit()`.
+type PreimageKeeper interface {
+	SetPreimages(ctx cosmos.Context, preimages map[common.Hash][]byte)
+}
+
+// AccessStatsKeeper is implemented by the keepers recording the storage access
+// counters of the contracts, the counters are added by `StateDB.Commit()`.
+type AccessStatsKeeper interface {
+	AccessStatsEnabled() bool
+	AddAccessStats(ctx cosmos.Context, stats AccessStats)
+}
+
+// WitnessKeeper is implemented by the keepers recording the execution witnesses of the
+// blocks, the state read by a tx is added by `StateDB.Commit()`.
+type WitnessKeeper interface {
+	WitnessEnabled() bool
+	AddWitness(ctx cosmos.Context, witness *Witness)
+}
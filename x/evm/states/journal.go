@@ -127,6 +127,13 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the calls reverted by RevertCall
+	revertCallChange struct {
+		account    *common.Address
+		prev       []byte
+		prevExists bool
+	}
 )
 
 // ----------------------------------------------------------------------------
@@ -273,3 +280,19 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// 					          revertCallChange
+// ----------------------------------------------------------------------------
+
+func (ch revertCallChange) Revert(s *StateDB) {
+	if ch.prevExists {
+		s.revertedCalls[*ch.account] = ch.prev
+		return
+	}
+	delete(s.revertedCalls, *ch.account)
+}
+
+func (ch revertCallChange) Dirtied() *common.Address {
+	return nil
+}
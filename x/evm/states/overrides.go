@@ -0,0 +1,104 @@
+package states
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// OverrideAccount is the overridden fields of an account during the execution of a
+// message call, with the json format of the json rpc api.
+// Note, state and stateDiff can't be specified at the same time. If state is set, the
+// execution only uses the given storage of the account. Otherwise if stateDiff is set,
+// the given slots are overridden on top of the account storage.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// Apply overrides the fields of the accounts in the states. The overrides are applied as
+// the committed states, so they are not reverted by the snapshots and are seen as the
+// original values by the gas metering.
+func (diff StateOverride) Apply(s *StateDB) error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		obj := s.getOrNewStateObject(addr)
+		if account.Nonce != nil {
+			obj.setNonce(uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			obj.setCode(crypto.Keccak256Hash(*account.Code), *account.Code)
+		}
+		if account.Balance != nil {
+			obj.setBalance(new(big.Int).Set((*big.Int)(*account.Balance)))
+		}
+		// replace the entire storage
+		if account.State != nil {
+			obj.originStorage = make(Storage, len(*account.State))
+			obj.dirtyStorage = make(Storage)
+			obj.storageOverridden = true
+		}
+		for _, storage := range []*map[common.Hash]common.Hash{account.State, account.StateDiff} {
+			if storage == nil {
+				continue
+			}
+			for key, value := range *storage {
+				obj.originStorage[key] = value
+			}
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is the overridden fields of the block context of a message call, with
+// the json format of the json rpc api.
+type BlockOverrides struct {
+	Number     *hexutil.Big    `json:"number"`
+	Difficulty *hexutil.Big    `json:"difficulty"`
+	Time       *hexutil.Uint64 `json:"time"`
+	GasLimit   *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase   *common.Address `json:"coinbase"`
+	Random     *common.Hash    `json:"random"`
+	BaseFee    *hexutil.Big    `json:"baseFee"`
+}
+
+// Apply overrides the fields of the block context.
+func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		blockCtx.BlockNumber = diff.Number.ToInt()
+	}
+	if diff.Difficulty != nil {
+		blockCtx.Difficulty = diff.Difficulty.ToInt()
+	}
+	if diff.Time != nil {
+		blockCtx.Time = uint64(*diff.Time)
+	}
+	if diff.GasLimit != nil {
+		blockCtx.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		blockCtx.Coinbase = *diff.Coinbase
+	}
+	if diff.Random != nil {
+		blockCtx.Random = diff.Random
+	}
+	if diff.BaseFee != nil {
+		blockCtx.BaseFee = diff.BaseFee.ToInt()
+	}
+}
@@ -0,0 +1,42 @@
+package states
+
+import (
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// revertSelector is the selector of Error(string), the revert reason of solidity.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+// RevertCall makes the pending call to the account revert with the reason instead of running
+// the code of the account, e.g. when the value transferred by the call must not be received
+// by the account. It's journaled, so it's undone with the reverted call frame.
+func (s *StateDB) RevertCall(addr common.Address, reason string) {
+	prev, ok := s.revertedCalls[addr]
+	s.journal.append(revertCallChange{account: &addr, prev: prev, prevExists: ok})
+	s.revertedCalls[addr] = revertCallCode(reason)
+}
+
+// revertCallCode returns the code reverting with the reason as the revert data:
+//
+//	PUSH1 len(data) PUSH1 offset PUSH1 0 CODECOPY PUSH1 len(data) PUSH1 0 REVERT data
+func revertCallCode(reason string) []byte {
+	stringType, _ := abi.NewType("string", "", nil)
+	packed, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		panic(err)
+	}
+	data := append(append([]byte{}, revertSelector...), packed...)
+	if len(data) > 0xff {
+		panic("revert reason too long")
+	}
+
+	code := []byte{
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(data)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	code[3] = byte(len(code))
+	return append(code, data...)
+}
@@ -31,6 +31,10 @@ type stateObject struct {
 	// during the "update" phase of the state transition.
 	dirtyCode bool // true if the code was updated
 	suicided  bool
+
+	// true if the storage is replaced by a state override, the slots out of originStorage
+	// are empty instead of loaded from the keeper
+	storageOverridden bool
 }
 
 // empty returns whether the account is considered empty.
@@ -103,8 +107,14 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.storageOverridden {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
+	if s.db.witness != nil {
+		s.db.witness.addSlot(s.Address(), key, value)
+	}
 	s.originStorage[key] = value
 	return value
 }
@@ -155,6 +165,9 @@ func (s *stateObject) Code() []byte {
 		return nil
 	}
 	code := s.db.keeper.GetCode(s.db.ctx, common.BytesToHash(s.CodeHash()))
+	if s.db.witness != nil {
+		s.db.witness.addCode(common.BytesToHash(s.CodeHash()), code)
+	}
 	s.code = code
 	return code
 }
@@ -51,19 +51,40 @@ type StateDB struct {
 	journal        *journal
 	validRevisions []revision
 	nextRevisionId int
+
+	// SHA3 preimages seen by the VM, recorded if EnablePreimageRecording is set
+	preimages map[common.Hash][]byte
+
+	// storage access counters, nil if the keeper doesn't record them
+	accessStats AccessStats
+
+	// state read from the keeper, nil if the keeper doesn't record the witnesses
+	witness *Witness
+
+	// code run instead of the code of the accounts by the pending calls, see RevertCall
+	revertedCalls map[common.Address][]byte
 }
 
 // New creates a new states from a given trie.
 func New(ctx cosmos.Context, keeper Keeper, txConfig TxConfig) *StateDB {
-	return &StateDB{
+	s := &StateDB{
 		keeper:       keeper,
 		ctx:          ctx,
 		stateObjects: make(map[common.Address]*stateObject),
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		revertedCalls: make(map[common.Address][]byte),
+
 		txConfig: txConfig,
 	}
+	if ak, ok := keeper.(AccessStatsKeeper); ok && ak.AccessStatsEnabled() {
+		s.accessStats = make(AccessStats)
+	}
+	if wk, ok := keeper.(WitnessKeeper); ok && wk.WitnessEnabled() {
+		s.witness = NewWitness()
+	}
+	return s
 }
 
 func (s *StateDB) Prepare(rules params.Rules, sender, coinbase common.Address, dest *common.Address, precompiles []common.Address, txAccesses ethereum.AccessList) {
@@ -160,6 +181,9 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 
 // GetCode returns the code of account, nil if not exists.
 func (s *StateDB) GetCode(addr common.Address) []byte {
+	if code, ok := s.revertedCalls[addr]; ok {
+		return code
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.Code()
@@ -178,6 +202,9 @@ func (s *StateDB) GetCodeSize(addr common.Address) int {
 
 // GetCodeHash returns the code hash of account.
 func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
+	if code, ok := s.revertedCalls[addr]; ok {
+		return crypto.Keccak256Hash(code)
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		return common.Hash{}
@@ -187,6 +214,9 @@ func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
 
 // GetState retrieves a value from the given account's storage trie.
 func (s *StateDB) GetState(addr common.Address, hash common.Hash) common.Hash {
+	if s.accessStats != nil {
+		s.accessStats.count(addr).Reads++
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.GetState(hash)
@@ -212,11 +242,32 @@ func (s *StateDB) HasSuicided(addr common.Address) bool {
 	return false
 }
 
-// AddPreimage records a SHA3 preimage seen by the VM.
-// AddPreimage performs a no-op since the EnablePreimageRecording flag is disabled
-// on the vm.Config during states transitions. No store trie preimages are written
-// to the database.
-func (s *StateDB) AddPreimage(_ common.Hash, _ []byte) {}
+// AddPreimage records a SHA3 preimage seen by the VM, it's only called if the
+// EnablePreimageRecording flag is set on the vm.Config. The preimages are not
+// journaled, they are written by Commit to the keeper if it's a PreimageKeeper.
+func (s *StateDB) AddPreimage(hash common.Hash, preimage []byte) {
+	if s.preimages == nil {
+		s.preimages = make(map[common.Hash][]byte)
+	}
+	if _, ok := s.preimages[hash]; !ok {
+		s.preimages[hash] = common.CopyBytes(preimage)
+	}
+}
+
+// Witness returns the state read from the keeper, nil if it's not recorded.
+func (s *StateDB) Witness() *Witness {
+	return s.witness
+}
+
+// AccessStats returns the storage access counters, nil if they are not recorded.
+func (s *StateDB) AccessStats() AccessStats {
+	return s.accessStats
+}
+
+// Preimages returns the SHA3 preimages recorded by the VM.
+func (s *StateDB) Preimages() map[common.Hash][]byte {
+	return s.preimages
+}
 
 // getStateObject retrieves a states object given by the address, returning nil if
 // the object is not found.
@@ -227,6 +278,9 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 	}
 	// If no live objects are available, load it from keeper
 	account := s.keeper.GetAccount(s.ctx, addr)
+	if s.witness != nil {
+		s.witness.addAccount(addr, account)
+	}
 	if account == nil {
 		return nil
 	}
@@ -286,6 +340,17 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.storageOverridden {
+		for key, value := range so.originStorage {
+			if dirty, ok := so.dirtyStorage[key]; ok {
+				value = dirty
+			}
+			if !cb(key, value) {
+				return nil
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -336,6 +401,9 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) {
 
 // SetState sets the contract states.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
+	if s.accessStats != nil {
+		s.accessStats.count(addr).Writes++
+	}
 	stateObject := s.getOrNewStateObject(addr)
 	if stateObject != nil {
 		stateObject.SetState(key, value)
@@ -453,7 +521,13 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 func (s *StateDB) Commit() error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
+		if s.witness != nil {
+			s.witness.writtenAccounts[addr] = struct{}{}
+		}
 		if obj.suicided {
+			if s.witness != nil {
+				s.witness.wipedStorage[addr] = struct{}{}
+			}
 			if err := s.keeper.DeleteAccount(s.ctx, obj.Address()); err != nil {
 				return errorsmod.Wrap(err, "failed to delete account")
 			}
@@ -471,8 +545,20 @@ func (s *StateDB) Commit() error {
 					continue
 				}
 				s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+				if s.witness != nil {
+					s.witness.markSlotWritten(addr, key)
+				}
 			}
 		}
 	}
+	if pk, ok := s.keeper.(PreimageKeeper); ok && len(s.preimages) > 0 {
+		pk.SetPreimages(s.ctx, s.preimages)
+	}
+	if ak, ok := s.keeper.(AccessStatsKeeper); ok && len(s.accessStats) > 0 {
+		ak.AddAccessStats(s.ctx, s.accessStats)
+	}
+	if wk, ok := s.keeper.(WitnessKeeper); ok && s.witness != nil {
+		wk.AddWitness(s.ctx, s.witness)
+	}
 	return nil
 }
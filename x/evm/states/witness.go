@@ -0,0 +1,137 @@
+package states
+
+import (
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// WitnessAccount is an account read during the execution of a block.
+type WitnessAccount struct {
+	Nonce    hexutil.Uint64 `json:"nonce"`
+	Balance  *hexutil.Big   `json:"balance"`
+	CodeHash common.Hash    `json:"codeHash"`
+}
+
+// Witness is the state read by the EVM during the execution of a block, it's the set of
+// the accounts, storage slots and codes a stateless client needs to re-execute the block.
+// The values are the ones before they are first written in the block, a nil account is
+// an account that didn't exist when it was read.
+type Witness struct {
+	Accounts map[common.Address]*WitnessAccount             `json:"accounts"`
+	Storage  map[common.Address]map[common.Hash]common.Hash `json:"storage"`
+	Codes    map[common.Hash]hexutil.Bytes                  `json:"codes"`
+
+	// state written by the merged txs, the later reads of it are not pre-state
+	writtenAccounts map[common.Address]struct{}
+	writtenSlots    map[common.Address]map[common.Hash]struct{}
+	wipedStorage    map[common.Address]struct{}
+}
+
+// NewWitness returns an empty witness.
+func NewWitness() *Witness {
+	return &Witness{
+		Accounts:        make(map[common.Address]*WitnessAccount),
+		Storage:         make(map[common.Address]map[common.Hash]common.Hash),
+		Codes:           make(map[common.Hash]hexutil.Bytes),
+		writtenAccounts: make(map[common.Address]struct{}),
+		writtenSlots:    make(map[common.Address]map[common.Hash]struct{}),
+		wipedStorage:    make(map[common.Address]struct{}),
+	}
+}
+
+// Merge adds the reads of a tx witness to w, the reads of the state written by the txs
+// merged before are skipped. The writes of other are added to the writes of w.
+func (w *Witness) Merge(other *Witness) {
+	for addr, account := range other.Accounts {
+		if _, written := w.writtenAccounts[addr]; written {
+			continue
+		}
+		if _, ok := w.Accounts[addr]; !ok {
+			w.Accounts[addr] = account
+		}
+	}
+	for addr, slots := range other.Storage {
+		if _, wiped := w.wipedStorage[addr]; wiped {
+			continue
+		}
+		for key, value := range slots {
+			if _, written := w.writtenSlots[addr][key]; written {
+				continue
+			}
+			w.addSlot(addr, key, value)
+		}
+	}
+	for hash, code := range other.Codes {
+		w.Codes[hash] = code
+	}
+
+	for addr := range other.writtenAccounts {
+		w.writtenAccounts[addr] = struct{}{}
+	}
+	for addr, keys := range other.writtenSlots {
+		for key := range keys {
+			w.markSlotWritten(addr, key)
+		}
+	}
+	for addr := range other.wipedStorage {
+		w.wipedStorage[addr] = struct{}{}
+	}
+}
+
+// Empty returns true if nothing is read.
+func (w *Witness) Empty() bool {
+	return len(w.Accounts) == 0 && len(w.Storage) == 0 && len(w.Codes) == 0
+}
+
+func (w *Witness) addAccount(addr common.Address, account *StateAccount) {
+	if _, ok := w.Accounts[addr]; ok {
+		return
+	}
+	if account == nil {
+		w.Accounts[addr] = nil
+		return
+	}
+	balance := new(big.Int)
+	if account.Balance != nil {
+		balance.Set(account.Balance)
+	}
+	w.Accounts[addr] = &WitnessAccount{
+		Nonce:    hexutil.Uint64(account.Nonce),
+		Balance:  (*hexutil.Big)(balance),
+		CodeHash: common.BytesToHash(account.CodeHash),
+	}
+}
+
+func (w *Witness) addSlot(addr common.Address, key, value common.Hash) {
+	slots, ok := w.Storage[addr]
+	if !ok {
+		slots = make(map[common.Hash]common.Hash)
+		w.Storage[addr] = slots
+	}
+	if _, ok := slots[key]; !ok {
+		slots[key] = value
+	}
+}
+
+func (w *Witness) addCode(codeHash common.Hash, code []byte) {
+	if _, ok := w.Codes[codeHash]; !ok {
+		w.Codes[codeHash] = common.CopyBytes(code)
+	}
+}
+
+func (w *Witness) markSlotWritten(addr common.Address, key common.Hash) {
+	keys, ok := w.writtenSlots[addr]
+	if !ok {
+		keys = make(map[common.Hash]struct{})
+		w.writtenSlots[addr] = keys
+	}
+	keys[key] = struct{}{}
+}
+
+// WitnessKey returns the key of the witness of a block in the witness db.
+func WitnessKey(height int64) []byte {
+	return cosmos.Uint64ToBigEndian(uint64(height)) // #nosec G701
+}
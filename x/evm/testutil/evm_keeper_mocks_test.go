@@ -0,0 +1,22 @@
+package testutil
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela/x/evm/exported"
+)
+
+func TestMockEVMKeeper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := NewMockEVMKeeper(ctrl)
+	mock.EXPECT().ChainID().Return(big.NewInt(11822))
+
+	var keeper exported.EVMKeeper = mock
+	require.Equal(t, big.NewInt(11822), keeper.ChainID())
+}
@@ -0,0 +1,414 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+)
+
+// AspectFrameType is the type of the synthetic frames of aspect executions.
+const AspectFrameType = "ASPECT"
+
+func init() {
+	tracers.DefaultDirectory.Register("callTracer", newCallTracer, false)
+}
+
+var _ artelatypes.AspectTracer = (*callTracer)(nil)
+
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+type callFrame struct {
+	Type         vm.OpCode
+	From         common.Address
+	Gas          uint64
+	GasUsed      uint64
+	To           *common.Address
+	Input        []byte
+	Output       []byte
+	Error        string
+	RevertReason string
+	Calls        []callFrame
+	Logs         []callLog
+	Value        *big.Int
+
+	// aspect frame fields, JoinPoint is only set for the aspect frames
+	JoinPoint asptypes.PointCut
+	AspectIDs []string
+	// gasReported is set if the gas of the aspect frame is known
+	gasReported bool
+}
+
+func (f callFrame) isAspect() bool {
+	return f.JoinPoint != ""
+}
+
+func (f callFrame) TypeString() string {
+	if f.isAspect() {
+		return AspectFrameType
+	}
+	return f.Type.String()
+}
+
+func (f callFrame) failed() bool {
+	return len(f.Error) > 0
+}
+
+func (f *callFrame) processOutput(output []byte, err error) {
+	output = common.CopyBytes(output)
+	if err == nil {
+		f.Output = output
+		return
+	}
+	f.Error = err.Error()
+	if f.Type == vm.CREATE || f.Type == vm.CREATE2 {
+		f.To = nil
+	}
+	if !errors.Is(err, vm.ErrExecutionReverted) || len(output) == 0 {
+		return
+	}
+	f.Output = output
+	if len(output) < 4 {
+		return
+	}
+	if unpacked, err := abi.UnpackRevert(output); err == nil {
+		f.RevertReason = unpacked
+	}
+}
+
+// MarshalJSON marshals the frame in the callTracer format of geth, the aspect
+// frames additionally carry the join point and the ids of the executed aspects,
+// their gas is only present if it's known.
+func (f callFrame) MarshalJSON() ([]byte, error) {
+	type callFrame0 struct {
+		Type         string            `json:"type"`
+		JoinPoint    asptypes.PointCut `json:"joinPoint,omitempty"`
+		AspectIDs    []string          `json:"aspectIds,omitempty"`
+		From         common.Address    `json:"from"`
+		Gas          *hexutil.Uint64   `json:"gas,omitempty"`
+		GasUsed      *hexutil.Uint64   `json:"gasUsed,omitempty"`
+		To           *common.Address   `json:"to,omitempty"`
+		Input        hexutil.Bytes     `json:"input,omitempty"`
+		Output       hexutil.Bytes     `json:"output,omitempty"`
+		Error        string            `json:"error,omitempty"`
+		RevertReason string            `json:"revertReason,omitempty"`
+		Calls        []callFrame       `json:"calls,omitempty"`
+		Logs         []callLog         `json:"logs,omitempty"`
+		Value        *hexutil.Big      `json:"value,omitempty"`
+	}
+	enc := callFrame0{
+		Type:         f.TypeString(),
+		JoinPoint:    f.JoinPoint,
+		AspectIDs:    f.AspectIDs,
+		From:         f.From,
+		To:           f.To,
+		Output:       f.Output,
+		Error:        f.Error,
+		RevertReason: f.RevertReason,
+		Calls:        f.Calls,
+		Logs:         f.Logs,
+		Value:        (*hexutil.Big)(f.Value),
+	}
+	if !f.isAspect() {
+		// the input of regular frames is always present, even if empty
+		enc.Input = hexutil.Bytes(f.Input)
+		if enc.Input == nil {
+			enc.Input = hexutil.Bytes{}
+		}
+	}
+	if !f.isAspect() || f.gasReported {
+		gas, gasUsed := hexutil.Uint64(f.Gas), hexutil.Uint64(f.GasUsed)
+		enc.Gas, enc.GasUsed = &gas, &gasUsed
+	}
+	return json.Marshal(&enc)
+}
+
+// callTracer is a native go tracer which tracks the call frames of a tx, it's
+// compatible with the callTracer of geth, and interleaves the aspect executions
+// at their join points as frames of type ASPECT:
+//   - preTxExecute frames are the first children of the top call;
+//   - preContractCall frames are placed before the frame of the callee;
+//   - postContractCall frames are the last children of the frame of the callee;
+//   - postTxExecute frames are the last children of the top call.
+//
+// Only the tx level join points report the gas of the aspect frames, the gas
+// of the contract call join points is accounted in the gas of the call frames.
+type callTracer struct {
+	noopTracer
+	callstack []callFrame
+	config    callTracerConfig
+	gasLimit  uint64
+	txStarted bool
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+
+	// started is set once the top call frame is entered, the aspect frames
+	// captured before it are held in pending.
+	started bool
+	pending []callFrame
+	// txAspectOpen is set if the latest aspect frame is a tx level join point
+	// whose result is not reported yet.
+	txAspectOpen bool
+}
+
+type callTracerConfig struct {
+	OnlyTopCall bool `json:"onlyTopCall"` // If true, call tracer won't collect any subcalls
+	WithLog     bool `json:"withLog"`     // If true, call tracer will collect event logs
+}
+
+// newCallTracer returns a native go tracer which tracks
+// call frames of a tx, and implements vm.EVMLogger.
+func newCallTracer(_ *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config callTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	// First callframe contains tx context info
+	// and is populated on start and end.
+	return &callTracer{callstack: make([]callFrame, 1), config: config}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	toCopy := to
+	t.callstack[0] = callFrame{
+		Type:  vm.CALL,
+		From:  from,
+		To:    &toCopy,
+		Input: common.CopyBytes(input),
+		Gas:   t.gasLimit,
+		Value: value,
+		Calls: t.pending,
+	}
+	if !t.txStarted {
+		// the tx level events are not captured, fallback to the gas of the top call
+		t.callstack[0].Gas = gas
+	}
+	if create {
+		t.callstack[0].Type = vm.CREATE
+	}
+	t.started = true
+	t.pending = nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	t.callstack[0].processOutput(output, err)
+	if !t.txStarted {
+		t.callstack[0].GasUsed = gasUsed
+	}
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *callTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	// skip if the previous op caused an error
+	if err != nil {
+		return
+	}
+	// Only logs need to be captured via opcode processing
+	if !t.config.WithLog {
+		return
+	}
+	// Avoid processing nested calls when only caring about top call
+	if t.config.OnlyTopCall && depth > 0 {
+		return
+	}
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+	switch op {
+	case vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4:
+		size := int(op - vm.LOG0)
+
+		stack := scope.Stack
+		stackData := stack.Data()
+
+		// Don't modify the stack
+		mStart := stackData[len(stackData)-1]
+		mSize := stackData[len(stackData)-2]
+		topics := make([]common.Hash, size)
+		for i := 0; i < size; i++ {
+			topic := stackData[len(stackData)-2-(i+1)]
+			topics[i] = common.Hash(topic.Bytes32())
+		}
+
+		data, err := tracers.GetMemoryCopyPadded(scope.Memory, int64(mStart.Uint64()), int64(mSize.Uint64()))
+		if err != nil {
+			// mSize was unrealistically large
+			return
+		}
+
+		log := callLog{Address: scope.Contract.Address(), Topics: topics, Data: hexutil.Bytes(data)}
+		t.callstack[len(t.callstack)-1].Logs = append(t.callstack[len(t.callstack)-1].Logs, log)
+	}
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *callTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+
+	toCopy := to
+	call := callFrame{
+		Type:  typ,
+		From:  from,
+		To:    &toCopy,
+		Input: common.CopyBytes(input),
+		Gas:   gas,
+		Value: value,
+	}
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	// pop call
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	size -= 1
+
+	call.GasUsed = gasUsed
+	call.processOutput(output, err)
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
+}
+
+func (t *callTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+	t.txStarted = true
+}
+
+func (t *callTracer) CaptureTxEnd(restGas uint64) {
+	t.callstack[0].GasUsed = t.gasLimit - restGas
+	if t.config.WithLog {
+		// Logs are not emitted when the call fails
+		clearFailedLogs(&t.callstack[0], false)
+	}
+}
+
+// CaptureAspect implements the AspectTracer interface, it adds an aspect frame
+// as the next child of the current call frame.
+func (t *callTracer) CaptureAspect(joinPoint asptypes.PointCut, contract common.Address, aspectIDs []string) {
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() {
+		return
+	}
+
+	contractCopy := contract
+	frame := callFrame{
+		From:      contract,
+		To:        &contractCopy,
+		JoinPoint: joinPoint,
+		AspectIDs: append([]string(nil), aspectIDs...),
+	}
+	t.txAspectOpen = joinPoint == asptypes.PRE_TX_EXECUTE_METHOD || joinPoint == asptypes.POST_TX_EXECUTE_METHOD
+
+	if !t.started {
+		t.pending = append(t.pending, frame)
+		return
+	}
+	current := &t.callstack[len(t.callstack)-1]
+	current.Calls = append(current.Calls, frame)
+}
+
+// CaptureAspectEnd implements the AspectTracer interface, it completes the
+// latest aspect frame of a tx level join point with its result.
+func (t *callTracer) CaptureAspectEnd(output []byte, gas, gasUsed uint64, err error) {
+	if !t.txAspectOpen {
+		return
+	}
+	t.txAspectOpen = false
+
+	var frame *callFrame
+	if !t.started {
+		frame = &t.pending[len(t.pending)-1]
+	} else {
+		calls := t.callstack[len(t.callstack)-1].Calls
+		frame = &calls[len(calls)-1]
+	}
+	frame.Gas = gas
+	frame.GasUsed = gasUsed
+	frame.gasReported = true
+	frame.processOutput(output, err)
+}
+
+// GetResult returns the json-encoded nested list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	if !t.started {
+		// the top call is short-circuited, e.g. by a failed pre tx aspect
+		t.callstack[0].Calls = t.pending
+	}
+
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// clearFailedLogs clears the logs of a callframe and all its children
+// in case of execution failure.
+func clearFailedLogs(cf *callFrame, parentFailed bool) {
+	failed := cf.failed() || parentFailed
+	// Clear own logs
+	if failed {
+		cf.Logs = nil
+	}
+	for i := range cf.Calls {
+		clearFailedLogs(&cf.Calls[i], failed)
+	}
+}
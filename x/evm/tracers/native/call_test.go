@@ -0,0 +1,80 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallTracerAspectFrames(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x01")
+		callee = common.HexToAddress("0x02")
+		inner  = common.HexToAddress("0x03")
+	)
+
+	tr, err := newCallTracer(nil, nil)
+	require.NoError(t, err)
+	tracer := tr.(*callTracer)
+
+	// pre tx and the top level pre call are executed before the top call frame
+	tracer.CaptureAspect(asptypes.PRE_TX_EXECUTE_METHOD, callee, []string{"0xa1"})
+	tracer.CaptureAspectEnd(nil, 1000, 100, nil)
+	tracer.CaptureAspect(asptypes.PRE_CONTRACT_CALL_METHOD, callee, []string{"0xa2"})
+	tracer.CaptureStart(nil, sender, callee, false, nil, 900, big.NewInt(0))
+
+	tracer.CaptureAspect(asptypes.PRE_CONTRACT_CALL_METHOD, inner, []string{"0xa3"})
+	tracer.CaptureEnter(vm.CALL, callee, inner, nil, 500, big.NewInt(0))
+	tracer.CaptureAspect(asptypes.POST_CONTRACT_CALL_METHOD, inner, []string{"0xa4"})
+	tracer.CaptureExit(nil, 50, nil)
+
+	tracer.CaptureEnd(nil, 300, nil)
+	tracer.CaptureAspect(asptypes.POST_TX_EXECUTE_METHOD, callee, []string{"0xa5"})
+	tracer.CaptureAspectEnd([]byte{0x1}, 600, 20, errors.New("post tx failed"))
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var root struct {
+		Type    string `json:"type"`
+		GasUsed string `json:"gasUsed"`
+		Calls   []struct {
+			Type      string   `json:"type"`
+			JoinPoint string   `json:"joinPoint"`
+			AspectIDs []string `json:"aspectIds"`
+			GasUsed   *string  `json:"gasUsed"`
+			Error     string   `json:"error"`
+			Calls     []struct {
+				JoinPoint string `json:"joinPoint"`
+			} `json:"calls"`
+		} `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(res, &root))
+
+	require.Equal(t, "CALL", root.Type)
+	require.Equal(t, "0x12c", root.GasUsed)
+	require.Len(t, root.Calls, 5)
+
+	require.Equal(t, string(asptypes.PRE_TX_EXECUTE_METHOD), root.Calls[0].JoinPoint)
+	require.Equal(t, AspectFrameType, root.Calls[0].Type)
+	require.Equal(t, "0x64", *root.Calls[0].GasUsed)
+
+	require.Equal(t, string(asptypes.PRE_CONTRACT_CALL_METHOD), root.Calls[1].JoinPoint)
+	require.Nil(t, root.Calls[1].GasUsed)
+
+	require.Equal(t, string(asptypes.PRE_CONTRACT_CALL_METHOD), root.Calls[2].JoinPoint)
+	require.Equal(t, []string{"0xa3"}, root.Calls[2].AspectIDs)
+
+	require.Equal(t, "CALL", root.Calls[3].Type)
+	require.Len(t, root.Calls[3].Calls, 1)
+	require.Equal(t, string(asptypes.POST_CONTRACT_CALL_METHOD), root.Calls[3].Calls[0].JoinPoint)
+
+	require.Equal(t, string(asptypes.POST_TX_EXECUTE_METHOD), root.Calls[4].JoinPoint)
+	require.Equal(t, "post tx failed", root.Calls[4].Error)
+}
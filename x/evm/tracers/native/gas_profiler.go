@@ -0,0 +1,295 @@
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	artelatypes "github.com/artela-network/artela/x/evm/artela/types"
+)
+
+const (
+	// gasProfilerFormatJSON outputs the aggregated gas as a json object.
+	gasProfilerFormatJSON = "json"
+	// gasProfilerFormatFolded outputs the gas of the call stacks in the folded stack
+	// format of the flamegraph tools, one `frame;frame;frame gas` line per stack.
+	gasProfilerFormatFolded = "folded"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("gasProfiler", newGasProfiler, false)
+}
+
+var _ artelatypes.AspectTracer = (*gasProfiler)(nil)
+
+type gasProfilerConfig struct {
+	Format string `json:"format"` // json by default, or folded
+}
+
+// profileFrame is an open call frame of the gas profiler.
+type profileFrame struct {
+	// stack is the folded stack of the frame, from the tx down to the frame
+	stack string
+	// function is the contract and the function selector of the frame
+	function string
+	contract common.Address
+	// childGasUsed is the gas used by the calls of the frame
+	childGasUsed uint64
+}
+
+// gasProfiler is a native go tracer which aggregates the gas used by a tx by the call
+// stacks, the contracts and the function selectors. The gas of a frame excludes the gas
+// of its calls, so the gas of the stacks adds up to the gas used by the execution.
+//
+// The gas of the aspects executed at the tx level join points is profiled under the
+// aspect frames of the tx, the gas of the contract call join points is accounted in the
+// gas of the call frames, the same as the callTracer.
+type gasProfiler struct {
+	noopTracer
+	config gasProfilerConfig
+	root   string
+
+	callstack []profileFrame
+	stacks    map[string]uint64
+	contracts map[common.Address]uint64
+	functions map[string]uint64
+
+	gasLimit  uint64
+	gasUsed   uint64
+	txStarted bool
+	started   bool
+	// startGas is the gas of the top call, topGasUsed is the gas used by it
+	startGas   uint64
+	topGasUsed uint64
+	// aspectGas is the gas used by the tx level aspects, preAspectGas is the part of it
+	// used before the top call
+	aspectGas    uint64
+	preAspectGas uint64
+	// aspectStack is the stack of the latest tx level join point, empty if the latest
+	// join point is not at the tx level or its result is reported already
+	aspectStack string
+
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+// newGasProfiler returns a native go tracer which profiles the gas used by a tx.
+func newGasProfiler(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	config := gasProfilerConfig{Format: gasProfilerFormatJSON}
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	if config.Format != gasProfilerFormatJSON && config.Format != gasProfilerFormatFolded {
+		return nil, fmt.Errorf("unsupported gas profiler format %q", config.Format)
+	}
+
+	// the stacks of the txs are distinguished by the tx hash, so the folded stacks of
+	// the txs of a block can be merged into one flamegraph
+	root := "tx"
+	if ctx != nil && ctx.TxHash != (common.Hash{}) {
+		root = "tx:" + ctx.TxHash.Hex()
+	}
+	return &gasProfiler{
+		config:    config,
+		root:      root,
+		stacks:    make(map[string]uint64),
+		contracts: make(map[common.Address]uint64),
+		functions: make(map[string]uint64),
+	}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *gasProfiler) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.started = true
+	t.startGas = gas
+	t.enter(t.root, to, create, input)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *gasProfiler) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	t.topGasUsed = gasUsed
+	t.exit(gasUsed)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *gasProfiler) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if t.interrupt.Load() || len(t.callstack) == 0 {
+		return
+	}
+	t.enter(t.callstack[len(t.callstack)-1].stack, to, typ == vm.CREATE || typ == vm.CREATE2, input)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *gasProfiler) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// the top call is only exited by CaptureEnd
+	if len(t.callstack) <= 1 {
+		return
+	}
+	t.exit(gasUsed)
+}
+
+func (t *gasProfiler) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+	t.txStarted = true
+}
+
+func (t *gasProfiler) CaptureTxEnd(restGas uint64) {
+	t.gasUsed = t.gasLimit - restGas
+}
+
+// CaptureAspect implements the AspectTracer interface, the gas of the tx level join
+// points is profiled once reported by CaptureAspectEnd.
+func (t *gasProfiler) CaptureAspect(joinPoint asptypes.PointCut, contract common.Address, aspectIDs []string) {
+	t.aspectStack = ""
+	if joinPoint != asptypes.PRE_TX_EXECUTE_METHOD && joinPoint != asptypes.POST_TX_EXECUTE_METHOD {
+		return
+	}
+	t.aspectStack = t.root + ";aspect:" + string(joinPoint) + ":" + strings.Join(aspectIDs, ",")
+}
+
+// CaptureAspectEnd implements the AspectTracer interface, it profiles the gas used by
+// the aspects of the latest tx level join point.
+func (t *gasProfiler) CaptureAspectEnd(output []byte, gas, gasUsed uint64, err error) {
+	if t.aspectStack == "" {
+		return
+	}
+	t.stacks[t.aspectStack] += gasUsed
+	t.aspectStack = ""
+
+	t.aspectGas += gasUsed
+	if !t.started {
+		t.preAspectGas += gasUsed
+	}
+}
+
+// enter opens the frame of a call to the contract below the parent stack.
+func (t *gasProfiler) enter(parent string, contract common.Address, create bool, input []byte) {
+	function := contract.Hex()
+	switch {
+	case create:
+		function = "create:" + function
+	case len(input) >= 4:
+		function += ":" + hexutil.Encode(input[:4])
+	}
+	t.callstack = append(t.callstack, profileFrame{
+		stack:    parent + ";" + function,
+		function: function,
+		contract: contract,
+	})
+}
+
+// exit closes the current frame, the gas used by the frame itself is profiled.
+func (t *gasProfiler) exit(gasUsed uint64) {
+	if len(t.callstack) == 0 {
+		return
+	}
+	frame := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+
+	var self uint64
+	if gasUsed > frame.childGasUsed {
+		self = gasUsed - frame.childGasUsed
+	}
+	t.stacks[frame.stack] += self
+	t.contracts[frame.contract] += self
+	t.functions[frame.function] += self
+
+	if len(t.callstack) > 0 {
+		t.callstack[len(t.callstack)-1].childGasUsed += gasUsed
+	}
+}
+
+// gasProfile is the json output of the gas profiler, the gas used by the tx is the sum
+// of the intrinsic gas, the gas of the aspects and the gas of the stacks, minus the refund.
+type gasProfile struct {
+	GasUsed      uint64            `json:"gasUsed"`
+	IntrinsicGas uint64            `json:"intrinsicGas"`
+	AspectGas    uint64            `json:"aspectGas"`
+	Refund       uint64            `json:"refund"`
+	Stacks       map[string]uint64 `json:"stacks"`
+	Contracts    map[string]uint64 `json:"contracts"`
+	Functions    map[string]uint64 `json:"functions"`
+}
+
+// GetResult returns the json-encoded gas profile, or the folded stacks as a json string,
+// and any error arising from the encoding or forceful termination (via `Stop`).
+func (t *gasProfiler) GetResult() (json.RawMessage, error) {
+	profile := gasProfile{
+		GasUsed:   t.gasUsed,
+		AspectGas: t.aspectGas,
+		Stacks:    make(map[string]uint64, len(t.stacks)+1),
+		Contracts: make(map[string]uint64, len(t.contracts)),
+		Functions: t.functions,
+	}
+	if !t.txStarted {
+		// the tx level events are not captured, fallback to the gas of the execution
+		profile.GasUsed = t.topGasUsed + t.aspectGas
+	}
+	if t.txStarted && t.started && t.gasLimit > t.startGas+t.preAspectGas {
+		profile.IntrinsicGas = t.gasLimit - t.startGas - t.preAspectGas
+	}
+	if spent := profile.IntrinsicGas + t.aspectGas + t.topGasUsed; spent > profile.GasUsed {
+		profile.Refund = spent - profile.GasUsed
+	}
+
+	for stack, gas := range t.stacks {
+		profile.Stacks[stack] = gas
+	}
+	if profile.IntrinsicGas > 0 {
+		profile.Stacks[t.root+";intrinsic"] = profile.IntrinsicGas
+	}
+	for contract, gas := range t.contracts {
+		profile.Contracts[contract.Hex()] += gas
+	}
+
+	var (
+		res []byte
+		err error
+	)
+	if t.config.Format == gasProfilerFormatFolded {
+		res, err = json.Marshal(foldStacks(profile.Stacks))
+	} else {
+		res, err = json.Marshal(profile)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *gasProfiler) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// foldStacks formats the stacks in the folded stack format, sorted by the stacks, the
+// stacks of zero gas are omitted.
+func foldStacks(stacks map[string]uint64) string {
+	keys := make([]string, 0, len(stacks))
+	for stack, gas := range stacks {
+		if gas > 0 {
+			keys = append(keys, stack)
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, stack := range keys {
+		fmt.Fprintf(&sb, "%s %d\n", stack, stacks[stack])
+	}
+	return sb.String()
+}
@@ -0,0 +1,79 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasProfiler(t *testing.T) {
+	var (
+		sender = common.HexToAddress("0x01")
+		callee = common.HexToAddress("0x02")
+		inner  = common.HexToAddress("0x03")
+		input  = []byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}
+	)
+
+	run := func(cfg json.RawMessage) json.RawMessage {
+		tr, err := newGasProfiler(&tracers.Context{}, cfg)
+		require.NoError(t, err)
+		tracer := tr.(*gasProfiler)
+
+		tracer.CaptureTxStart(100000)
+		tracer.CaptureAspect(asptypes.PRE_TX_EXECUTE_METHOD, callee, []string{"0xa1"})
+		tracer.CaptureAspectEnd(nil, 79000, 1000, nil)
+		tracer.CaptureStart(nil, sender, callee, false, input, 78000, big.NewInt(0))
+
+		tracer.CaptureAspect(asptypes.PRE_CONTRACT_CALL_METHOD, inner, []string{"0xa2"})
+		tracer.CaptureEnter(vm.CALL, callee, inner, input[:4], 50000, big.NewInt(0))
+		tracer.CaptureExit(nil, 3000, nil)
+		tracer.CaptureEnter(vm.STATICCALL, callee, inner, input[:4], 40000, big.NewInt(0))
+		tracer.CaptureExit(nil, 2000, nil)
+
+		tracer.CaptureEnd(nil, 10000, nil)
+		tracer.CaptureAspect(asptypes.POST_TX_EXECUTE_METHOD, callee, []string{"0xa1"})
+		tracer.CaptureAspectEnd(nil, 68000, 500, nil)
+		// 21000 intrinsic + 1500 aspects + 10000 execution - 1000 refund
+		tracer.CaptureTxEnd(100000 - 31500)
+
+		res, err := tracer.GetResult()
+		require.NoError(t, err)
+		return res
+	}
+
+	var profile gasProfile
+	require.NoError(t, json.Unmarshal(run(nil), &profile))
+	require.Equal(t, uint64(31500), profile.GasUsed)
+	require.Equal(t, uint64(21000), profile.IntrinsicGas)
+	require.Equal(t, uint64(1500), profile.AspectGas)
+	require.Equal(t, uint64(1000), profile.Refund)
+
+	calleeFn := callee.Hex() + ":0xa9059cbb"
+	innerFn := inner.Hex() + ":0xa9059cbb"
+	require.Equal(t, map[string]uint64{
+		"tx;intrinsic":                   21000,
+		"tx;aspect:preTxExecute:0xa1":    1000,
+		"tx;aspect:postTxExecute:0xa1":   500,
+		"tx;" + calleeFn:                 5000,
+		"tx;" + calleeFn + ";" + innerFn: 5000,
+	}, profile.Stacks)
+	require.Equal(t, map[string]uint64{callee.Hex(): 5000, inner.Hex(): 5000}, profile.Contracts)
+	require.Equal(t, map[string]uint64{calleeFn: 5000, innerFn: 5000}, profile.Functions)
+
+	var folded string
+	require.NoError(t, json.Unmarshal(run(json.RawMessage(`{"format":"folded"}`)), &folded))
+	require.Equal(t, "tx;"+calleeFn+" 5000\n"+
+		"tx;"+calleeFn+";"+innerFn+" 5000\n"+
+		"tx;aspect:postTxExecute:0xa1 500\n"+
+		"tx;aspect:preTxExecute:0xa1 1000\n"+
+		"tx;intrinsic 21000\n", folded)
+
+	_, err := newGasProfiler(nil, json.RawMessage(`{"format":"svg"}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package txs
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+)
+
+// MaxSimulateBlocks is the max number of the blocks simulated by an eth_simulateV1 call.
+const MaxSimulateBlocks = 256
+
+// SimulateOptions is the options of the eth_simulateV1 rpc api, the blocks of calls are
+// simulated in sequence on top of the requested block.
+type SimulateOptions struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+	// Validation enables the checks of the nonce, the base fee and the balance of the calls
+	// as for the txs, the base fee is zero by default otherwise.
+	Validation bool `json:"validation"`
+}
+
+// SimulateBlock is a block of calls of eth_simulateV1, the block context and the states are
+// overridden before the calls of the block.
+type SimulateBlock struct {
+	BlockOverrides *SimulateBlockOverrides `json:"blockOverrides,omitempty"`
+	// StateOverrides uses the same json format as the state overrides of eth_call
+	StateOverrides json.RawMessage   `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimulateBlockOverrides is the overridden fields of a simulated block, the number and time
+// follow the previous block if not set.
+type SimulateBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimulateBlockResult is the result of a simulated block.
+type SimulateBlockResult struct {
+	Number        hexutil.Uint64       `json:"number"`
+	Hash          common.Hash          `json:"hash"`
+	ParentHash    common.Hash          `json:"parentHash"`
+	Timestamp     hexutil.Uint64       `json:"timestamp"`
+	GasLimit      hexutil.Uint64       `json:"gasLimit"`
+	GasUsed       hexutil.Uint64       `json:"gasUsed"`
+	FeeRecipient  common.Address       `json:"miner"`
+	BaseFeePerGas *hexutil.Big         `json:"baseFeePerGas"`
+	Calls         []SimulateCallResult `json:"calls"`
+}
+
+// SimulateCallResult is the result of a simulated call.
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethereum.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateCallError is the error of a failed call, with the codes of the eth_simulateV1 spec.
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+const (
+	// SimulateErrCodeReverted is the error code of the reverted calls
+	SimulateErrCodeReverted = 3
+	// SimulateErrCodeVMError is the error code of the calls failed by the other vm errors
+	SimulateErrCodeVMError = -32015
+)
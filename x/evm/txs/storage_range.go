@@ -0,0 +1,22 @@
+package txs
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StorageRangeResult is the result of the debug_storageRangeAt rpc api. The storage is iterated
+// in the order of the raw keys, so NextKey is a raw key to be passed as the start of the next
+// range, unlike geth which iterates the secure trie in the order of the key hashes.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key in the storage.
+}
+
+// StorageMap is the storage entries of a range indexed by the hashes of their keys.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot with its preimage key.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
@@ -40,6 +40,40 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the states machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// pause_authority is the address allowed to pause the EVM calls and creates in
+	// emergencies, the emergency pause is disabled if it's empty.
+	PauseAuthority string `protobuf:"bytes,7,opt,name=pause_authority,json=pauseAuthority,proto3" json:"pause_authority,omitempty" yaml:"pause_authority"`
+	// pause_blocks is the number of blocks an emergency pause lasts before it expires,
+	// unless it's extended by governance.
+	PauseBlocks uint64 `protobuf:"varint,8,opt,name=pause_blocks,json=pauseBlocks,proto3" json:"pause_blocks,omitempty" yaml:"pause_blocks"`
+	// pause_interval_blocks is the min number of blocks between two pauses of the pause
+	// authority, it exceeds pause_blocks so the EVM is resumed in between.
+	PauseIntervalBlocks uint64 `protobuf:"varint,16,opt,name=pause_interval_blocks,json=pauseIntervalBlocks,proto3" json:"pause_interval_blocks,omitempty" yaml:"pause_interval_blocks"`
+	// max_tx_data_size is the max size in bytes of the data of a transaction, 0 for
+	// no limit.
+	MaxTxDataSize uint64 `protobuf:"varint,9,opt,name=max_tx_data_size,json=maxTxDataSize,proto3" json:"max_tx_data_size,omitempty" yaml:"max_tx_data_size"`
+	// calldata_gas_per_token reprices the data of a transaction in the intrinsic gas,
+	// a zero byte counts as one token and a non-zero byte as four tokens. 0 keeps the
+	// standard pricing of 4 gas per token.
+	CalldataGasPerToken uint64 `protobuf:"varint,10,opt,name=calldata_gas_per_token,json=calldataGasPerToken,proto3" json:"calldata_gas_per_token,omitempty" yaml:"calldata_gas_per_token"`
+	// sender_gas_quota is the max share in basis points of the block gas limit the
+	// transactions of a single sender can consume in a block, 0 for no quota.
+	SenderGasQuota uint64 `protobuf:"varint,11,opt,name=sender_gas_quota,json=senderGasQuota,proto3" json:"sender_gas_quota,omitempty" yaml:"sender_gas_quota"`
+	// system_tx_block_gas_budget is the max gas the system transactions originated by the
+	// protocol can use in a block, 0 disables them.
+	SystemTxBlockGasBudget uint64 `protobuf:"varint,12,opt,name=system_tx_block_gas_budget,json=systemTxBlockGasBudget,proto3" json:"system_tx_block_gas_budget,omitempty" yaml:"system_tx_block_gas_budget"`
+	// scheduled_call_block_gas_budget is the max gas the scheduled calls can use in a block, it's
+	// also counted in the system_tx_block_gas_budget, 0 disables the execution of the calls.
+	ScheduledCallBlockGasBudget uint64 `protobuf:"varint,13,opt,name=scheduled_call_block_gas_budget,json=scheduledCallBlockGasBudget,proto3" json:"scheduled_call_block_gas_budget,omitempty" yaml:"scheduled_call_block_gas_budget"`
+	// max_due_scheduled_calls is the max number of the due scheduled calls visited in a block,
+	// the rest of them are visited in the next blocks.
+	MaxDueScheduledCalls uint64 `protobuf:"varint,14,opt,name=max_due_scheduled_calls,json=maxDueScheduledCalls,proto3" json:"max_due_scheduled_calls,omitempty" yaml:"max_due_scheduled_calls"`
+	// aspect_access_gas charges the access of the aspects bound to the called contract as intrinsic
+	// gas of the transactions, like the access of the accounts of an access list.
+	AspectAccessGas bool `protobuf:"varint,15,opt,name=aspect_access_gas,json=aspectAccessGas,proto3" json:"aspect_access_gas,omitempty" yaml:"aspect_access_gas"`
+	// faucet_operator is the address allowed to send the funds of the faucet module account of a
+	// test network, the faucet is disabled if it's empty.
+	FaucetOperator string `protobuf:"bytes,17,opt,name=faucet_operator,json=faucetOperator,proto3" json:"faucet_operator,omitempty" yaml:"faucet_operator"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +151,83 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetPauseAuthority() string {
+	if m != nil {
+		return m.PauseAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetPauseBlocks() uint64 {
+	if m != nil {
+		return m.PauseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetPauseIntervalBlocks() uint64 {
+	if m != nil {
+		return m.PauseIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxDataSize() uint64 {
+	if m != nil {
+		return m.MaxTxDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetCalldataGasPerToken() uint64 {
+	if m != nil {
+		return m.CalldataGasPerToken
+	}
+	return 0
+}
+
+func (m *Params) GetSenderGasQuota() uint64 {
+	if m != nil {
+		return m.SenderGasQuota
+	}
+	return 0
+}
+
+func (m *Params) GetSystemTxBlockGasBudget() uint64 {
+	if m != nil {
+		return m.SystemTxBlockGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetScheduledCallBlockGasBudget() uint64 {
+	if m != nil {
+		return m.ScheduledCallBlockGasBudget
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDueScheduledCalls() uint64 {
+	if m != nil {
+		return m.MaxDueScheduledCalls
+	}
+	return 0
+}
+
+func (m *Params) GetAspectAccessGas() bool {
+	if m != nil {
+		return m.AspectAccessGas
+	}
+	return false
+}
+
+func (m *Params) GetFaucetOperator() string {
+	if m != nil {
+		return m.FaucetOperator
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -675,6 +786,213 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// ScheduledCall defines an EVM call scheduled to be executed at a future height, its fee of
+// gas_limit * gas_price is escrowed by the module until it's executed, cancelled or expired.
+type ScheduledCall struct {
+	// id is the unique identifier of the scheduled call
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the bech32 address of the account the call is sent from
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// to is the hex address of the called contract
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// data is the input data of the call
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// value is the amount of the evm denom transferred by the call, it's taken from the
+	// balance of the sender at the execution
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// gas_limit is the gas limit of the call
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_price is the gas price of the call in the evm denom
+	GasPrice string `protobuf:"bytes,7,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// execute_at is the height the call is executed at or after
+	ExecuteAt int64 `protobuf:"varint,8,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	// expires_at is the height the call is refunded at if it's not executed yet
+	ExpiresAt int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *ScheduledCall) Reset()         { *m = ScheduledCall{} }
+func (m *ScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCall) ProtoMessage()    {}
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95fb7abfbae4d4d, []int{8}
+}
+func (m *ScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCall.Merge(m, src)
+}
+func (m *ScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCall proto.InternalMessageInfo
+
+func (m *ScheduledCall) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ScheduledCall) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *ScheduledCall) GetExecuteAt() int64 {
+	if m != nil {
+		return m.ExecuteAt
+	}
+	return 0
+}
+
+func (m *ScheduledCall) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// GasPolicy defines the spending caps of the ethereum txs sent by an account, they're checked
+// by the ante handler before the txs are accepted, so a compromised key can't drain the
+// account through the gas.
+type GasPolicy struct {
+	// address is the bech32 address of the account the policy applies to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// admin is the bech32 address allowed to update or remove the policy, the account itself
+	// if empty
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// max_gas_price is the max gas price of the txs in the evm denom, the fee cap of the
+	// dynamic fee txs, no limit if empty
+	MaxGasPrice string `protobuf:"bytes,3,opt,name=max_gas_price,json=maxGasPrice,proto3" json:"max_gas_price,omitempty"`
+	// daily_gas_budget is the max total gas limit of the txs in a UTC day, no limit if zero
+	DailyGasBudget uint64 `protobuf:"varint,4,opt,name=daily_gas_budget,json=dailyGasBudget,proto3" json:"daily_gas_budget,omitempty"`
+	// allowed_targets are the hex addresses of the contracts the txs may call, the txs can't
+	// create contracts if set, any target is allowed if empty
+	AllowedTargets []string `protobuf:"bytes,5,rep,name=allowed_targets,json=allowedTargets,proto3" json:"allowed_targets,omitempty"`
+}
+
+func (m *GasPolicy) Reset()         { *m = GasPolicy{} }
+func (m *GasPolicy) String() string { return proto.CompactTextString(m) }
+func (*GasPolicy) ProtoMessage()    {}
+func (*GasPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c95fb7abfbae4d4d, []int{9}
+}
+func (m *GasPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPolicy.Merge(m, src)
+}
+func (m *GasPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPolicy proto.InternalMessageInfo
+
+func (m *GasPolicy) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GasPolicy) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *GasPolicy) GetMaxGasPrice() string {
+	if m != nil {
+		return m.MaxGasPrice
+	}
+	return ""
+}
+
+func (m *GasPolicy) GetDailyGasBudget() uint64 {
+	if m != nil {
+		return m.DailyGasBudget
+	}
+	return 0
+}
+
+func (m *GasPolicy) GetAllowedTargets() []string {
+	if m != nil {
+		return m.AllowedTargets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "artela.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "artela.evm.v1.ChainConfig")
@@ -684,113 +1002,149 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "artela.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "artela.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "artela.evm.v1.TraceConfig")
+	proto.RegisterType((*ScheduledCall)(nil), "artela.evm.v1.ScheduledCall")
+	proto.RegisterType((*GasPolicy)(nil), "artela.evm.v1.GasPolicy")
 }
 
 func init() { proto.RegisterFile("artela/evm/v1/evm.proto", fileDescriptor_c95fb7abfbae4d4d) }
 
 var fileDescriptor_c95fb7abfbae4d4d = []byte{
-	// 1605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0x23, 0xb7,
-	0x19, 0xb6, 0xad, 0xb1, 0x3d, 0xa2, 0xbe, 0xc6, 0xb4, 0xd6, 0xab, 0xec, 0x02, 0x1e, 0x63, 0x0e,
-	0x81, 0x0f, 0x59, 0x2b, 0x76, 0x60, 0x74, 0x91, 0xa2, 0x05, 0xac, 0x5d, 0x27, 0xb1, 0xbb, 0x49,
-	0x16, 0x5c, 0x07, 0x05, 0x72, 0x19, 0x50, 0x33, 0xcc, 0x68, 0xa2, 0x99, 0xa1, 0x40, 0x72, 0xb4,
-	0x52, 0xdb, 0x73, 0x91, 0x63, 0xff, 0x40, 0x8b, 0xfe, 0x9c, 0xa0, 0xa7, 0x1c, 0x8b, 0x1e, 0x06,
-	0x85, 0xf7, 0xe6, 0xa3, 0x7e, 0x41, 0xc1, 0x0f, 0x7d, 0xda, 0x08, 0x6a, 0x9d, 0x34, 0xcf, 0xfb,
-	0xf1, 0x3c, 0xe4, 0xcb, 0x97, 0x22, 0x09, 0x9e, 0x62, 0x26, 0x48, 0x82, 0xdb, 0x64, 0x98, 0xb6,
-	0x87, 0xa7, 0xf2, 0xe7, 0x64, 0xc0, 0xa8, 0xa0, 0xb0, 0xa6, 0x1d, 0x27, 0xd2, 0x32, 0x3c, 0x7d,
-	0xd6, 0x8c, 0x68, 0x44, 0x95, 0xa7, 0x2d, 0xbf, 0x74, 0x90, 0xf7, 0xd7, 0x12, 0xd8, 0x79, 0x8b,
-	0x19, 0x4e, 0x39, 0x3c, 0x05, 0x65, 0x32, 0x4c, 0xfd, 0x90, 0x64, 0x34, 0x6d, 0x6d, 0x1e, 0x6d,
-	0x1e, 0x97, 0x3b, 0xcd, 0x49, 0xe1, 0x3a, 0x63, 0x9c, 0x26, 0x9f, 0x7b, 0x33, 0x97, 0x87, 0x6c,
-	0x32, 0x4c, 0x5f, 0xcb, 0x4f, 0xf8, 0x3b, 0x50, 0x23, 0x19, 0xee, 0x26, 0xc4, 0x0f, 0x18, 0xc1,
-	0x82, 0xb4, 0xb6, 0x8e, 0x36, 0x8f, 0xed, 0x4e, 0x6b, 0x52, 0xb8, 0x4d, 0x93, 0xb6, 0xe8, 0xf6,
-	0x50, 0x55, 0xe3, 0x57, 0x0a, 0xc2, 0xdf, 0x80, 0xca, 0xd4, 0x8f, 0x93, 0xa4, 0x55, 0x52, 0xc9,
-	0x07, 0x93, 0xc2, 0x85, 0xcb, 0xc9, 0x38, 0x49, 0x3c, 0x04, 0x4c, 0x2a, 0x4e, 0x12, 0x78, 0x01,
-	0x00, 0x19, 0x09, 0x86, 0x7d, 0x12, 0x0f, 0x78, 0xcb, 0x3a, 0x2a, 0x1d, 0x97, 0x3a, 0xde, 0x6d,
-	0xe1, 0x96, 0x2f, 0xa5, 0xf5, 0xf2, 0xea, 0x2d, 0x9f, 0x14, 0xee, 0x9e, 0x21, 0x99, 0x05, 0x7a,
-	0xa8, 0xac, 0xc0, 0x65, 0x3c, 0xe0, 0xf0, 0x7b, 0x50, 0x0d, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66,
-	0x3f, 0xc4, 0x51, 0x6b, 0xfb, 0x68, 0xf3, 0xb8, 0x72, 0xf6, 0xec, 0x64, 0xa9, 0x68, 0x27, 0xaf,
-	0x64, 0xc8, 0x2b, 0x15, 0xd1, 0x79, 0xfe, 0x73, 0xe1, 0x6e, 0x4c, 0x0a, 0x77, 0x5f, 0xf3, 0x2e,
-	0x66, 0x7b, 0xa8, 0x12, 0xcc, 0x23, 0xe1, 0x19, 0x78, 0x82, 0x93, 0x84, 0xbe, 0xf7, 0xf3, 0x4c,
-	0x56, 0x99, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf1, 0xd6, 0x8e, 0x9c, 0x21, 0xda, 0x57, 0xce, 0xef,
-	0xe6, 0xbe, 0x9b, 0x11, 0xf7, 0xfe, 0xb1, 0x07, 0x2a, 0x0b, 0x6a, 0x30, 0x05, 0x8d, 0x1e, 0x4d,
-	0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0x41, 0xdf, 0xac, 0xc9, 0xeb, 0xff, 0x14, 0xee, 0xc7,
-	0x51, 0x2c, 0x7a, 0x79, 0xf7, 0x24, 0xa0, 0x69, 0x3b, 0xa0, 0x3c, 0xa5, 0xdc, 0xfc, 0xbc, 0xe0,
-	0x61, 0xbf, 0x2d, 0xc6, 0x03, 0xc2, 0x4f, 0xae, 0x32, 0x31, 0x29, 0xdc, 0x03, 0x3d, 0xd8, 0x15,
-	0x2a, 0x0f, 0xd5, 0x67, 0x96, 0x8e, 0x34, 0xc0, 0x31, 0xa8, 0x87, 0x98, 0xfa, 0x3f, 0x50, 0xd6,
-	0x37, 0x6a, 0x5b, 0x4a, 0xed, 0xdd, 0xff, 0xaf, 0x76, 0x5b, 0xb8, 0xd5, 0xd7, 0x17, 0xdf, 0x7e,
-	0x41, 0x59, 0x5f, 0x71, 0x4e, 0x0a, 0xf7, 0x89, 0x56, 0x5f, 0x66, 0xf6, 0x50, 0x35, 0xc4, 0x74,
-	0x16, 0x06, 0xff, 0x08, 0x9c, 0x59, 0x00, 0xcf, 0x07, 0x03, 0xca, 0x84, 0x69, 0x85, 0x17, 0xb7,
-	0x85, 0x5b, 0x37, 0x94, 0xef, 0xb4, 0x67, 0x52, 0xb8, 0x4f, 0x57, 0x48, 0x4d, 0x8e, 0x87, 0xea,
-	0x86, 0xd6, 0x84, 0x42, 0x0e, 0xaa, 0x24, 0x1e, 0x9c, 0x9e, 0x7f, 0x6a, 0x66, 0x64, 0xa9, 0x19,
-	0xbd, 0x7d, 0xd4, 0x8c, 0x2a, 0x97, 0x57, 0x6f, 0x4f, 0xcf, 0x3f, 0x9d, 0x4e, 0xc8, 0xac, 0xfd,
-	0x22, 0xad, 0x87, 0x2a, 0x1a, 0xea, 0xd9, 0x5c, 0x01, 0x03, 0xfd, 0x1e, 0xe6, 0x3d, 0xd5, 0x56,
-	0xe5, 0xce, 0xf1, 0x6d, 0xe1, 0x02, 0xcd, 0xf4, 0x15, 0xe6, 0xbd, 0xf9, 0xba, 0x74, 0xc7, 0x7f,
-	0xc2, 0x99, 0x88, 0xf3, 0x74, 0xca, 0x05, 0x74, 0xb2, 0x8c, 0x9a, 0x8d, 0xff, 0xdc, 0x8c, 0x7f,
-	0x67, 0xed, 0xf1, 0x9f, 0x3f, 0x34, 0xfe, 0xf3, 0xe5, 0xf1, 0xeb, 0x98, 0x99, 0xe8, 0x4b, 0x23,
-	0xba, 0xbb, 0xb6, 0xe8, 0xcb, 0x87, 0x44, 0x5f, 0x2e, 0x8b, 0xea, 0x18, 0xd9, 0xec, 0x2b, 0x95,
-	0x68, 0xd9, 0xeb, 0x37, 0xfb, 0xbd, 0xa2, 0xd6, 0x67, 0x16, 0x2d, 0xf7, 0x17, 0xd0, 0x0c, 0x68,
-	0xc6, 0x85, 0xb4, 0x65, 0x74, 0x90, 0x10, 0xa3, 0x59, 0x56, 0x9a, 0x57, 0x8f, 0xd2, 0x7c, 0x6e,
-	0xfe, 0x0d, 0x1e, 0xe0, 0xf3, 0xd0, 0xfe, 0xb2, 0x59, 0xab, 0x0f, 0x80, 0x33, 0x20, 0x82, 0x30,
-	0xde, 0xcd, 0x59, 0x64, 0x94, 0x81, 0x52, 0xbe, 0x7c, 0x94, 0xb2, 0xd9, 0x07, 0xab, 0x5c, 0x1e,
-	0x6a, 0xcc, 0x4d, 0x5a, 0xf1, 0x47, 0x50, 0x8f, 0xe5, 0x30, 0xba, 0x79, 0x62, 0xf4, 0x2a, 0x4a,
-	0xef, 0xd5, 0xa3, 0xf4, 0xcc, 0x66, 0x5e, 0x66, 0xf2, 0x50, 0x6d, 0x6a, 0xd0, 0x5a, 0x39, 0x80,
-	0x69, 0x1e, 0x33, 0x3f, 0x4a, 0x70, 0x10, 0x13, 0x66, 0xf4, 0xaa, 0x4a, 0xef, 0xcb, 0x47, 0xe9,
-	0x7d, 0xa4, 0xf5, 0xee, 0xb3, 0x79, 0xc8, 0x91, 0xc6, 0x2f, 0xb5, 0x4d, 0xcb, 0x86, 0xa0, 0xda,
-	0x25, 0x2c, 0x89, 0x33, 0x23, 0x58, 0x53, 0x82, 0x17, 0x8f, 0x12, 0x34, 0x7d, 0xba, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x33, 0x95, 0x84, 0x66, 0x21, 0x9d, 0xaa, 0xec, 0xad, 0xaf, 0xb2, 0xc8, 0xe3,
-	0xa1, 0x8a, 0x86, 0x5a, 0x65, 0x04, 0xf6, 0x31, 0x63, 0xf4, 0xfd, 0x4a, 0x0d, 0xa1, 0x12, 0xfb,
-	0xea, 0x51, 0x62, 0xcf, 0xb4, 0xd8, 0x03, 0x74, 0x1e, 0xda, 0x53, 0xd6, 0xa5, 0x2a, 0xe6, 0x00,
-	0x46, 0x0c, 0x8f, 0x57, 0x84, 0x9b, 0xeb, 0x2f, 0xde, 0x7d, 0x36, 0x0f, 0x39, 0xd2, 0xb8, 0x24,
-	0xfb, 0x67, 0xd0, 0x4c, 0x09, 0x8b, 0x88, 0x9f, 0x11, 0xc1, 0x07, 0x49, 0x2c, 0x8c, 0xf0, 0x93,
-	0xf5, 0xf7, 0xe3, 0x43, 0x7c, 0x1e, 0x82, 0xca, 0xfc, 0x8d, 0xb1, 0xce, 0x36, 0x07, 0xef, 0xe1,
-	0x2c, 0xea, 0xe1, 0xd8, 0xc8, 0x1e, 0xac, 0xbf, 0x39, 0x96, 0x99, 0x3c, 0x54, 0x9b, 0x1a, 0x66,
-	0xfd, 0x13, 0xe0, 0x2c, 0xc8, 0xa7, 0xfd, 0xf3, 0x74, 0xfd, 0xfe, 0x59, 0xe4, 0x91, 0xd7, 0x0f,
-	0x05, 0x95, 0xca, 0xb5, 0x65, 0xd7, 0x9d, 0xc6, 0xb5, 0x65, 0x37, 0x1c, 0xe7, 0xda, 0xb2, 0x1d,
-	0x67, 0xef, 0xda, 0xb2, 0xf7, 0x9d, 0x26, 0xaa, 0x8d, 0x69, 0x42, 0xfd, 0xe1, 0x67, 0x3a, 0x09,
-	0x55, 0xc8, 0x7b, 0xcc, 0xcd, 0x7f, 0x24, 0xaa, 0x07, 0x58, 0xe0, 0x64, 0xcc, 0x4d, 0xa9, 0x90,
-	0xa3, 0x0b, 0xb8, 0x70, 0x6a, 0xb7, 0xc1, 0xf6, 0x3b, 0x21, 0x6f, 0x6d, 0x0e, 0x28, 0xf5, 0xc9,
-	0x58, 0xdf, 0x46, 0x90, 0xfc, 0x84, 0x4d, 0xb0, 0x3d, 0xc4, 0x49, 0xae, 0xaf, 0x7f, 0x65, 0xa4,
-	0x81, 0xf7, 0x35, 0x68, 0xdc, 0x30, 0x9c, 0x71, 0x1c, 0x88, 0x98, 0x66, 0x6f, 0x68, 0xc4, 0x21,
-	0x04, 0x96, 0x3a, 0x15, 0x75, 0xae, 0xfa, 0x86, 0x1f, 0x03, 0x2b, 0xa1, 0x11, 0x6f, 0x6d, 0x1d,
-	0x95, 0x8e, 0x2b, 0x67, 0x70, 0xe5, 0x02, 0xf6, 0x86, 0x46, 0x48, 0xf9, 0xbd, 0x7f, 0x6d, 0x81,
-	0xd2, 0x1b, 0x1a, 0xc1, 0x16, 0xd8, 0xc5, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x66, 0x0a, 0xe1, 0x01,
-	0xd8, 0x11, 0x74, 0x10, 0x07, 0x9a, 0xab, 0x8c, 0x0c, 0x92, 0xaa, 0x21, 0x16, 0x58, 0x5d, 0x2a,
-	0xaa, 0x48, 0x7d, 0xc3, 0x33, 0x50, 0x55, 0xd3, 0xf2, 0xb3, 0x3c, 0xed, 0x12, 0xa6, 0xee, 0x06,
-	0x56, 0xa7, 0x71, 0x57, 0xb8, 0x15, 0x65, 0xff, 0x46, 0x99, 0xd1, 0x22, 0x80, 0x9f, 0x80, 0x5d,
-	0x31, 0x5a, 0x3c, 0xd6, 0xf7, 0xef, 0x0a, 0xb7, 0x21, 0xe6, 0x73, 0x94, 0xa7, 0x36, 0xda, 0x11,
-	0x23, 0x75, 0x7a, 0xb7, 0x81, 0x2d, 0x46, 0x7e, 0x9c, 0x85, 0x64, 0xa4, 0x4e, 0x6e, 0xab, 0xd3,
-	0xbc, 0x2b, 0x5c, 0x67, 0x21, 0xfc, 0x4a, 0xfa, 0xd0, 0xae, 0x18, 0xa9, 0x0f, 0xf8, 0x09, 0x00,
-	0x7a, 0x48, 0x4a, 0x41, 0x9f, 0xbb, 0xb5, 0xbb, 0xc2, 0x2d, 0x2b, 0xab, 0xe2, 0x9e, 0x7f, 0x42,
-	0x0f, 0x6c, 0x6b, 0x6e, 0x5b, 0x71, 0x57, 0xef, 0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b,
-	0x96, 0x8a, 0x91, 0x94, 0x0e, 0x49, 0xa8, 0x8e, 0x36, 0x1b, 0x4d, 0xa1, 0xf7, 0xd3, 0x16, 0xb0,
-	0x6f, 0x46, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x0b, 0xe0, 0x04, 0x34, 0x13, 0x0c, 0x07, 0xc2, 0x5f,
-	0x2a, 0x6d, 0xe7, 0xf9, 0xfc, 0x98, 0x59, 0x8d, 0xf0, 0x50, 0x63, 0x6a, 0xba, 0x30, 0xf5, 0x6f,
-	0x82, 0xed, 0x6e, 0x42, 0x69, 0xaa, 0xda, 0xa0, 0x8a, 0x34, 0x80, 0xdf, 0xaa, 0xaa, 0xa9, 0x25,
-	0x2e, 0xa9, 0x3b, 0xf6, 0xe1, 0xca, 0x12, 0xaf, 0x34, 0x49, 0xe7, 0xc0, 0xdc, 0xb3, 0xeb, 0x5a,
-	0xd8, 0x24, 0x7b, 0xb2, 0xb0, 0xaa, 0x89, 0x1c, 0x50, 0x62, 0x44, 0xa8, 0x15, 0xab, 0x22, 0xf9,
-	0x09, 0x9f, 0x01, 0x9b, 0x91, 0x21, 0x61, 0x82, 0x84, 0x6a, 0x65, 0x6c, 0x34, 0xc3, 0xf0, 0x23,
-	0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0x5e, 0x06, 0xb4, 0x1b, 0x61, 0xfe, 0x1d, 0x27, 0xe1,
-	0xe7, 0xd6, 0x4f, 0xff, 0x74, 0x37, 0x3c, 0x0c, 0x2a, 0x17, 0x41, 0x40, 0x38, 0xbf, 0xc9, 0x07,
-	0x09, 0xf9, 0x95, 0xf6, 0x3a, 0x03, 0x55, 0x2e, 0x28, 0xc3, 0x11, 0xf1, 0xfb, 0x64, 0x6c, 0x9a,
-	0x4c, 0xb7, 0x8c, 0xb1, 0xff, 0x81, 0x8c, 0x39, 0x5a, 0x04, 0x46, 0xe2, 0xef, 0x16, 0xa8, 0xdc,
-	0x30, 0x1c, 0x10, 0x73, 0xb7, 0x97, 0x8d, 0x2a, 0x21, 0x33, 0x12, 0x06, 0x49, 0x6d, 0x11, 0xa7,
-	0x84, 0xe6, 0xc2, 0xec, 0xa4, 0x29, 0x94, 0x19, 0x8c, 0x90, 0x11, 0x09, 0x54, 0x0d, 0x2d, 0x64,
-	0x10, 0x3c, 0x07, 0xb5, 0x30, 0xe6, 0xea, 0x95, 0xc4, 0x05, 0x0e, 0xfa, 0x7a, 0xfa, 0x1d, 0xe7,
-	0xae, 0x70, 0xab, 0xc6, 0xf1, 0x4e, 0xda, 0xd1, 0x12, 0x82, 0xbf, 0x05, 0x8d, 0x79, 0x9a, 0x1a,
-	0xad, 0x7e, 0x9a, 0x74, 0xe0, 0x5d, 0xe1, 0xd6, 0x67, 0xa1, 0xca, 0x83, 0x56, 0xb0, 0x5c, 0xe6,
-	0x90, 0x74, 0xf3, 0x48, 0x75, 0x9e, 0x8d, 0x34, 0x90, 0xd6, 0x24, 0x4e, 0x63, 0xa1, 0x3a, 0x6d,
-	0x1b, 0x69, 0x00, 0x5f, 0x82, 0x32, 0x1d, 0x12, 0xc6, 0xe2, 0x90, 0x70, 0x75, 0xc9, 0xf9, 0xd5,
-	0x27, 0x16, 0x9a, 0x07, 0xcb, 0x99, 0x99, 0xe7, 0x5f, 0x4a, 0x52, 0xca, 0xc6, 0xea, 0xca, 0x62,
-	0x66, 0xa6, 0x1d, 0x5f, 0x2b, 0x3b, 0x5a, 0x42, 0xb0, 0x03, 0xa0, 0x49, 0x63, 0x44, 0xe4, 0x2c,
-	0xf3, 0xd5, 0xce, 0xaf, 0xaa, 0x5c, 0xb5, 0xff, 0xb4, 0x17, 0x29, 0xe7, 0x6b, 0x2c, 0x30, 0xba,
-	0x67, 0x81, 0xbf, 0x07, 0x50, 0x2f, 0x88, 0xff, 0x23, 0xa7, 0xb3, 0x07, 0xa2, 0xbe, 0x51, 0x28,
-	0x7d, 0xed, 0x35, 0x63, 0x76, 0x34, 0xba, 0xe6, 0xd4, 0xcc, 0xe2, 0xda, 0xb2, 0x2d, 0x67, 0xfb,
-	0xda, 0xb2, 0x77, 0x1d, 0x7b, 0x56, 0x3c, 0x33, 0x0b, 0xb4, 0x3f, 0xc5, 0x0b, 0xc3, 0xeb, 0x5c,
-	0xfd, 0x7c, 0x7b, 0xb8, 0xf9, 0xcb, 0xed, 0xe1, 0xe6, 0x7f, 0x6f, 0x0f, 0x37, 0xff, 0xf6, 0xe1,
-	0x70, 0xe3, 0x97, 0x0f, 0x87, 0x1b, 0xff, 0xfe, 0x70, 0xb8, 0xf1, 0x7d, 0x7b, 0xe1, 0x58, 0xd0,
-	0x65, 0x7b, 0x91, 0x11, 0xf1, 0x9e, 0xb2, 0xbe, 0x81, 0xf2, 0xc9, 0x3f, 0x52, 0x6f, 0x7f, 0x75,
-	0x46, 0x74, 0x77, 0xd4, 0xb3, 0xfe, 0xb3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6e, 0xd7,
-	0xa7, 0x16, 0x10, 0x00, 0x00,
+	// 2153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x4f, 0x1c, 0xc9,
+	0x19, 0x36, 0x30, 0xc0, 0x4c, 0xcd, 0x30, 0x33, 0x14, 0x63, 0xdc, 0x8b, 0x77, 0x69, 0xb6, 0xa5,
+	0x6c, 0x50, 0xb4, 0x86, 0xb5, 0x57, 0x28, 0x96, 0xa3, 0x44, 0x62, 0x0c, 0x8b, 0x21, 0xde, 0x35,
+	0x29, 0xd8, 0x44, 0xd9, 0x4b, 0xab, 0xa6, 0xbb, 0x3c, 0xf4, 0xd2, 0xdd, 0x35, 0xa9, 0xaa, 0xc6,
+	0x33, 0x4e, 0x2e, 0xb9, 0xed, 0x31, 0x7f, 0x20, 0x51, 0x7e, 0x43, 0x7e, 0xc5, 0x2a, 0xa7, 0x3d,
+	0x46, 0x39, 0xb4, 0x22, 0x7c, 0x0a, 0xc7, 0xf9, 0x05, 0x51, 0xbd, 0x55, 0xf3, 0x09, 0x71, 0x02,
+	0xa7, 0xee, 0xf7, 0x79, 0xdf, 0x7a, 0x9e, 0xfa, 0x78, 0xeb, 0x13, 0x3d, 0xa0, 0x42, 0xb1, 0x98,
+	0x6e, 0xb3, 0x8b, 0x64, 0xfb, 0xe2, 0xb1, 0xfe, 0x6c, 0x75, 0x04, 0x57, 0x1c, 0x2f, 0x19, 0xc7,
+	0x96, 0x46, 0x2e, 0x1e, 0xaf, 0x35, 0xda, 0xbc, 0xcd, 0xc1, 0xb3, 0xad, 0xff, 0x4c, 0x90, 0xf7,
+	0x47, 0x84, 0x16, 0x8e, 0xa9, 0xa0, 0x89, 0xc4, 0x8f, 0x51, 0x89, 0x5d, 0x24, 0x7e, 0xc8, 0x52,
+	0x9e, 0x38, 0x33, 0x1b, 0x33, 0x9b, 0xa5, 0x66, 0xa3, 0x9f, 0xbb, 0xf5, 0x1e, 0x4d, 0xe2, 0x67,
+	0xde, 0xd0, 0xe5, 0x91, 0x22, 0xbb, 0x48, 0xf6, 0xf4, 0x2f, 0xfe, 0x39, 0x5a, 0x62, 0x29, 0x6d,
+	0xc5, 0xcc, 0x0f, 0x04, 0xa3, 0x8a, 0x39, 0xb3, 0x1b, 0x33, 0x9b, 0xc5, 0xa6, 0xd3, 0xcf, 0xdd,
+	0x86, 0x2d, 0x36, 0xee, 0xf6, 0x48, 0xc5, 0xd8, 0xcf, 0xc1, 0xc4, 0x3f, 0x45, 0xe5, 0x81, 0x9f,
+	0xc6, 0xb1, 0x33, 0x07, 0x85, 0x57, 0xfb, 0xb9, 0x8b, 0x27, 0x0b, 0xd3, 0x38, 0xf6, 0x08, 0xb2,
+	0x45, 0x69, 0x1c, 0xe3, 0x5d, 0x84, 0x58, 0x57, 0x09, 0xea, 0xb3, 0xa8, 0x23, 0x9d, 0xc2, 0xc6,
+	0xdc, 0xe6, 0x5c, 0xd3, 0xbb, 0xcc, 0xdd, 0xd2, 0xbe, 0x46, 0xf7, 0x0f, 0x8f, 0x65, 0x3f, 0x77,
+	0x97, 0x2d, 0xc9, 0x30, 0xd0, 0x23, 0x25, 0x30, 0xf6, 0xa3, 0x8e, 0xc4, 0xdf, 0xa0, 0x4a, 0x70,
+	0x46, 0xa3, 0xd4, 0x0f, 0x78, 0xfa, 0x3a, 0x6a, 0x3b, 0xf3, 0x1b, 0x33, 0x9b, 0xe5, 0x27, 0x6b,
+	0x5b, 0x13, 0x9d, 0xb6, 0xf5, 0x5c, 0x87, 0x3c, 0x87, 0x88, 0xe6, 0xc3, 0xef, 0x73, 0xf7, 0x5e,
+	0x3f, 0x77, 0x57, 0x0c, 0xef, 0x78, 0x69, 0x8f, 0x94, 0x83, 0x51, 0x24, 0x7e, 0x82, 0xee, 0xd3,
+	0x38, 0xe6, 0x6f, 0xfc, 0x2c, 0xd5, 0xbd, 0xcc, 0x02, 0xc5, 0x42, 0x5f, 0x75, 0xa5, 0xb3, 0xa0,
+	0x5b, 0x48, 0x56, 0xc0, 0xf9, 0xf5, 0xc8, 0x77, 0xda, 0x95, 0xf8, 0x39, 0xaa, 0x75, 0x68, 0x26,
+	0x99, 0x4f, 0x33, 0x75, 0xc6, 0x45, 0xa4, 0x7a, 0xce, 0x22, 0x8c, 0xc1, 0x5a, 0x3f, 0x77, 0x57,
+	0x8d, 0xe4, 0x54, 0x80, 0x47, 0xaa, 0x80, 0xec, 0x0e, 0x00, 0xfc, 0x0c, 0x55, 0x4c, 0x4c, 0x2b,
+	0xe6, 0xc1, 0xb9, 0x74, 0x8a, 0x1b, 0x33, 0x9b, 0x85, 0xe6, 0x83, 0x51, 0xa5, 0xc7, 0xbd, 0x1e,
+	0x29, 0x83, 0xd9, 0x04, 0x0b, 0x9f, 0xa2, 0xfb, 0xc6, 0x1b, 0xa5, 0x8a, 0x89, 0x0b, 0x1a, 0x0f,
+	0x48, 0xea, 0x40, 0xb2, 0xd1, 0xcf, 0xdd, 0x0f, 0xc7, 0x49, 0xa6, 0xc2, 0x3c, 0xb2, 0x02, 0xf8,
+	0xa1, 0x85, 0x2d, 0xeb, 0x1e, 0xaa, 0x27, 0xb4, 0xeb, 0xab, 0xae, 0x1f, 0x52, 0x45, 0x7d, 0x19,
+	0xbd, 0x65, 0x4e, 0x09, 0x08, 0x1f, 0xf6, 0x73, 0xf7, 0x81, 0x21, 0x9c, 0x8e, 0xf0, 0xc8, 0x52,
+	0x42, 0xbb, 0xa7, 0xdd, 0x3d, 0xaa, 0xe8, 0x49, 0xf4, 0x96, 0xe1, 0x5f, 0xa3, 0x55, 0x9d, 0x04,
+	0x10, 0xd0, 0xa6, 0xd2, 0xef, 0x30, 0xe1, 0x2b, 0x7e, 0xce, 0x52, 0x07, 0x01, 0xd7, 0xc7, 0xfd,
+	0xdc, 0xfd, 0xc8, 0x0e, 0xcb, 0x8d, 0x71, 0x1e, 0x59, 0x19, 0x38, 0x0e, 0xa8, 0x3c, 0x66, 0xe2,
+	0x54, 0xa3, 0x78, 0x1f, 0xd5, 0x25, 0x4b, 0x43, 0x26, 0x20, 0xfa, 0x77, 0x19, 0x57, 0xd4, 0x29,
+	0x4f, 0xd7, 0x6e, 0x3a, 0xc2, 0x23, 0x55, 0x03, 0x1d, 0x50, 0xf9, 0x2b, 0x0d, 0x60, 0x8a, 0xd6,
+	0x64, 0x4f, 0x2a, 0x96, 0xe8, 0x56, 0x40, 0x77, 0x40, 0x74, 0x2b, 0x0b, 0xdb, 0x4c, 0x39, 0x15,
+	0x20, 0xfc, 0x51, 0x3f, 0x77, 0x3f, 0xb6, 0x84, 0xff, 0x35, 0xd6, 0x23, 0xab, 0xc6, 0x79, 0xda,
+	0x85, 0xfe, 0x3b, 0xa0, 0xb2, 0x09, 0x0e, 0xdc, 0x41, 0xae, 0x0c, 0xce, 0x58, 0x98, 0xc5, 0x2c,
+	0x84, 0x09, 0x71, 0x5d, 0x67, 0x09, 0x74, 0x7e, 0xd2, 0xcf, 0xdd, 0x4f, 0xac, 0xce, 0xfb, 0x0b,
+	0x78, 0xe4, 0xe1, 0x30, 0x42, 0xcf, 0xaa, 0x29, 0xc5, 0xdf, 0xa2, 0x07, 0x7a, 0x5c, 0xc2, 0x8c,
+	0xf9, 0x93, 0x44, 0xd2, 0xa9, 0x82, 0x92, 0xd7, 0xcf, 0xdd, 0xf5, 0xd1, 0x00, 0xde, 0x10, 0xe8,
+	0x91, 0x46, 0x42, 0xbb, 0x7b, 0x19, 0x3b, 0x19, 0xd7, 0x91, 0xf8, 0x05, 0x5a, 0xa6, 0xb2, 0xc3,
+	0x02, 0xe5, 0xd3, 0x20, 0x60, 0x52, 0xea, 0x4a, 0x39, 0x35, 0x98, 0xfd, 0x1f, 0xf6, 0x73, 0xd7,
+	0x31, 0xa4, 0xd7, 0x42, 0x3c, 0x52, 0x33, 0xd8, 0x2e, 0x40, 0x07, 0x14, 0x66, 0xcd, 0x6b, 0x9a,
+	0x05, 0x4c, 0xf9, 0xbc, 0xc3, 0x04, 0x55, 0x5c, 0x38, 0xcb, 0xd3, 0xb3, 0x66, 0x2a, 0xc0, 0x23,
+	0x55, 0x83, 0xbc, 0x1a, 0x00, 0x7f, 0x59, 0x46, 0xe5, 0xb1, 0x89, 0x8e, 0x13, 0x54, 0x3b, 0xe3,
+	0x09, 0x93, 0x8a, 0xd1, 0xd0, 0xf4, 0x9a, 0x5d, 0x0e, 0xf7, 0xfe, 0x99, 0xbb, 0x9f, 0xb4, 0x23,
+	0x75, 0x96, 0xb5, 0xb6, 0x02, 0x9e, 0x6c, 0x07, 0x5c, 0x26, 0x5c, 0xda, 0xcf, 0x23, 0x19, 0x9e,
+	0x6f, 0xab, 0x5e, 0x87, 0xc9, 0xad, 0xc3, 0x54, 0x8d, 0xe4, 0xa7, 0xa8, 0x3c, 0x52, 0x1d, 0x22,
+	0xd0, 0xe3, 0xb8, 0x87, 0xaa, 0x21, 0xe5, 0xfe, 0x6b, 0x2e, 0xce, 0xad, 0xda, 0x2c, 0xa8, 0x9d,
+	0xfc, 0xff, 0x6a, 0x97, 0xb9, 0x5b, 0xd9, 0xdb, 0x7d, 0xf5, 0x05, 0x17, 0xe7, 0xc0, 0xd9, 0xcf,
+	0xdd, 0xfb, 0x46, 0x7d, 0x92, 0xd9, 0x23, 0x95, 0x90, 0xf2, 0x61, 0x18, 0xfe, 0x0d, 0xaa, 0x0f,
+	0x03, 0x64, 0xd6, 0xe9, 0x70, 0xa1, 0xec, 0x2a, 0xfc, 0xe8, 0x32, 0x77, 0xab, 0x96, 0xf2, 0xc4,
+	0x78, 0x46, 0x33, 0x62, 0xba, 0x8c, 0x47, 0xaa, 0x96, 0xd6, 0x86, 0x62, 0x89, 0x2a, 0x2c, 0xea,
+	0x3c, 0xde, 0xf9, 0xcc, 0xb6, 0xa8, 0x00, 0x2d, 0x3a, 0xbe, 0x55, 0x8b, 0xca, 0xfb, 0x87, 0xc7,
+	0x8f, 0x77, 0x3e, 0x1b, 0x34, 0xc8, 0xae, 0x60, 0xe3, 0xb4, 0x1e, 0x29, 0x1b, 0xd3, 0xb4, 0xe6,
+	0x10, 0x59, 0xd3, 0x3f, 0xa3, 0xf2, 0x0c, 0x56, 0xf4, 0x52, 0x73, 0xf3, 0x32, 0x77, 0x91, 0x61,
+	0x7a, 0x41, 0xe5, 0xd9, 0x68, 0x5c, 0x5a, 0xbd, 0xb7, 0x34, 0x55, 0x51, 0x96, 0x0c, 0xb8, 0x90,
+	0x29, 0xac, 0xa3, 0x86, 0xf5, 0xdf, 0xb1, 0xf5, 0x5f, 0xb8, 0x73, 0xfd, 0x77, 0x6e, 0xaa, 0xff,
+	0xce, 0x64, 0xfd, 0x4d, 0xcc, 0x50, 0xf4, 0xa9, 0x15, 0x5d, 0xbc, 0xb3, 0xe8, 0xd3, 0x9b, 0x44,
+	0x9f, 0x4e, 0x8a, 0x9a, 0x18, 0x9d, 0xec, 0x53, 0x3d, 0xe1, 0x14, 0xef, 0x9e, 0xec, 0xd7, 0x3a,
+	0xb5, 0x3a, 0x44, 0x8c, 0xdc, 0x1f, 0x50, 0x23, 0xe0, 0xa9, 0x54, 0x1a, 0x4b, 0x79, 0x27, 0xb6,
+	0x9b, 0x11, 0xec, 0x09, 0xa5, 0xe6, 0xe1, 0xad, 0x34, 0x1f, 0xda, 0x15, 0xff, 0x06, 0x3e, 0xbd,
+	0xde, 0x4f, 0xc0, 0x46, 0xbd, 0x83, 0xea, 0x1d, 0xa6, 0x98, 0x90, 0xad, 0x4c, 0xb4, 0xad, 0x32,
+	0x02, 0xe5, 0xfd, 0x5b, 0x29, 0xdb, 0x79, 0x30, 0xcd, 0xe5, 0x91, 0xda, 0x08, 0x32, 0x8a, 0xdf,
+	0xa2, 0x6a, 0xa4, 0xab, 0xd1, 0xca, 0xec, 0x02, 0x0c, 0xfb, 0x4b, 0xa9, 0xf9, 0xfc, 0x56, 0x7a,
+	0x76, 0x32, 0x4f, 0x32, 0x79, 0x64, 0x69, 0x00, 0x18, 0xad, 0x0c, 0xe1, 0x24, 0x8b, 0x84, 0xdf,
+	0x8e, 0x69, 0x10, 0x31, 0x61, 0xf5, 0x2a, 0xa0, 0x77, 0x70, 0x2b, 0xbd, 0x0f, 0xec, 0xb2, 0x7e,
+	0x8d, 0xcd, 0x23, 0x75, 0x0d, 0x1e, 0x18, 0xcc, 0xc8, 0x86, 0xa8, 0xd2, 0x62, 0x22, 0x8e, 0x52,
+	0x2b, 0xb8, 0x04, 0x82, 0xbb, 0xb7, 0x12, 0xb4, 0x79, 0x3a, 0xce, 0xe3, 0x91, 0xb2, 0x31, 0x87,
+	0x2a, 0x31, 0x4f, 0x43, 0x3e, 0x50, 0x59, 0xbe, 0xbb, 0xca, 0x38, 0x8f, 0x47, 0xca, 0xc6, 0x34,
+	0x2a, 0x5d, 0xb4, 0x42, 0x85, 0xe0, 0x6f, 0xa6, 0xfa, 0x10, 0x83, 0xd8, 0x8b, 0x5b, 0x89, 0xad,
+	0x19, 0xb1, 0x1b, 0xe8, 0x3c, 0xb2, 0x0c, 0xe8, 0x44, 0x2f, 0x66, 0x08, 0xb7, 0x05, 0xed, 0x4d,
+	0x09, 0x37, 0xee, 0x3e, 0x78, 0xd7, 0xd9, 0x3c, 0x52, 0xd7, 0xe0, 0x84, 0xec, 0xef, 0x51, 0x23,
+	0x61, 0xa2, 0xcd, 0xfc, 0x94, 0x29, 0xd9, 0x89, 0x23, 0x65, 0x85, 0xef, 0xdf, 0x7d, 0x3e, 0xde,
+	0xc4, 0xe7, 0x11, 0x0c, 0xf0, 0x57, 0x16, 0x1d, 0x4e, 0x0e, 0x79, 0x46, 0xd3, 0xf6, 0x19, 0x8d,
+	0xac, 0xec, 0xea, 0xdd, 0x27, 0xc7, 0x24, 0x93, 0x47, 0x96, 0x06, 0xc0, 0x30, 0x7f, 0x02, 0x9a,
+	0x06, 0xd9, 0x20, 0x7f, 0x1e, 0xdc, 0x3d, 0x7f, 0xc6, 0x79, 0xf4, 0xc9, 0x1f, 0x4c, 0x50, 0x39,
+	0x2a, 0x14, 0xab, 0xf5, 0xda, 0x51, 0xa1, 0x58, 0xab, 0xd7, 0x8f, 0x0a, 0xc5, 0x7a, 0x7d, 0xf9,
+	0xa8, 0x50, 0x5c, 0xa9, 0x37, 0xc8, 0x52, 0x8f, 0xc7, 0xdc, 0xbf, 0xf8, 0xdc, 0x14, 0x22, 0x65,
+	0xf6, 0x86, 0x4a, 0xbb, 0x46, 0x92, 0x6a, 0x40, 0x15, 0x8d, 0x7b, 0xd2, 0x76, 0x15, 0xa9, 0x9b,
+	0x0e, 0x1c, 0xdb, 0xb5, 0xb7, 0xd1, 0xfc, 0x89, 0xd2, 0x17, 0xa6, 0x3a, 0x9a, 0x3b, 0x67, 0x3d,
+	0x73, 0x1a, 0x21, 0xfa, 0x17, 0x37, 0xd0, 0xfc, 0x05, 0x8d, 0x33, 0x73, 0xf3, 0x2a, 0x11, 0x63,
+	0x78, 0x5f, 0xa2, 0xda, 0xa9, 0xa0, 0xa9, 0xa4, 0x81, 0x8a, 0x78, 0xfa, 0x92, 0xb7, 0x25, 0xc6,
+	0xa8, 0x00, 0xbb, 0xa2, 0x29, 0x0b, 0xff, 0xf8, 0x13, 0x54, 0x88, 0x79, 0x5b, 0x3a, 0xb3, 0x1b,
+	0x73, 0x9b, 0xe5, 0x27, 0x78, 0xea, 0xee, 0xf3, 0x92, 0xb7, 0x09, 0xf8, 0xbd, 0xbf, 0xcf, 0xa2,
+	0xb9, 0x97, 0xbc, 0x8d, 0x1d, 0xb4, 0x48, 0xc3, 0x50, 0x30, 0x29, 0x2d, 0xcd, 0xc0, 0xc4, 0xab,
+	0x68, 0x41, 0xf1, 0x4e, 0x14, 0x18, 0xae, 0x12, 0xb1, 0x96, 0x56, 0xd5, 0x67, 0x6e, 0x38, 0x54,
+	0x54, 0x08, 0xfc, 0xe3, 0x27, 0xa8, 0x62, 0x8e, 0xa2, 0x69, 0x96, 0xb4, 0x98, 0x80, 0xb3, 0x41,
+	0xa1, 0x59, 0xbb, 0xca, 0xdd, 0x32, 0xe0, 0x5f, 0x01, 0x4c, 0xc6, 0x0d, 0xfc, 0x29, 0x5a, 0x54,
+	0xdd, 0xf1, 0x6d, 0x7d, 0xe5, 0x2a, 0x77, 0x6b, 0x6a, 0xd4, 0x46, 0xbd, 0x6b, 0x93, 0x05, 0xd5,
+	0xd5, 0x5f, 0xbc, 0x8d, 0x8a, 0xaa, 0xeb, 0x47, 0x69, 0xc8, 0xba, 0xb0, 0x73, 0x17, 0x9a, 0x8d,
+	0xab, 0xdc, 0xad, 0x8f, 0x85, 0x1f, 0x6a, 0x1f, 0x59, 0x54, 0x5d, 0xf8, 0xc1, 0x9f, 0x22, 0x64,
+	0xaa, 0x04, 0x0a, 0x66, 0xdf, 0x5d, 0xba, 0xca, 0xdd, 0x12, 0xa0, 0xc0, 0x3d, 0xfa, 0xc5, 0x1e,
+	0x9a, 0x37, 0xdc, 0xe6, 0x7a, 0x55, 0xb9, 0xca, 0xdd, 0x62, 0xcc, 0xdb, 0x86, 0xd3, 0xb8, 0x74,
+	0x57, 0x09, 0x96, 0xf0, 0x0b, 0x16, 0xc2, 0xd6, 0x56, 0x24, 0x03, 0xd3, 0xfb, 0x6e, 0x16, 0x15,
+	0x4f, 0xbb, 0x84, 0xc9, 0x2c, 0x56, 0xf8, 0x0b, 0x54, 0x0f, 0x78, 0xaa, 0x04, 0xd5, 0x07, 0xdd,
+	0xf1, 0xae, 0x1d, 0xbf, 0x80, 0x4c, 0x47, 0x78, 0xa4, 0x36, 0x80, 0x76, 0x6d, 0xff, 0x37, 0xd0,
+	0x7c, 0x2b, 0xe6, 0x3c, 0x81, 0x34, 0xa8, 0x10, 0x63, 0xe0, 0x57, 0xd0, 0x6b, 0x30, 0xc4, 0x73,
+	0x70, 0xbd, 0x5d, 0x9f, 0x1a, 0xe2, 0xa9, 0x24, 0x69, 0xae, 0xda, 0x2b, 0x6e, 0xd5, 0x08, 0xdb,
+	0xc2, 0x9e, 0xee, 0x58, 0x48, 0xa2, 0x3a, 0x9a, 0x13, 0x4c, 0xc1, 0x88, 0x55, 0x88, 0xfe, 0xc5,
+	0x6b, 0xa8, 0x28, 0xd8, 0x05, 0x13, 0x8a, 0x85, 0x30, 0x32, 0x45, 0x32, 0xb4, 0xf1, 0x07, 0xa8,
+	0xa8, 0x6f, 0x1b, 0x99, 0x64, 0xa1, 0x19, 0x06, 0xb2, 0xd8, 0xa6, 0xf2, 0x6b, 0xc9, 0xc2, 0x67,
+	0x85, 0xef, 0xfe, 0xea, 0xde, 0xf3, 0x28, 0x2a, 0x9b, 0xa3, 0xfc, 0x69, 0xd6, 0x89, 0xd9, 0x7b,
+	0xd2, 0xeb, 0x09, 0xaa, 0x48, 0xc5, 0x05, 0x6d, 0x33, 0xff, 0x9c, 0xf5, 0x6c, 0x92, 0x99, 0x94,
+	0xb1, 0xf8, 0x2f, 0x59, 0x4f, 0x92, 0x71, 0xc3, 0x4a, 0xfc, 0xb9, 0x80, 0xca, 0xa7, 0x82, 0x06,
+	0xcc, 0x9e, 0xed, 0x75, 0xa2, 0x6a, 0x53, 0x58, 0x09, 0x6b, 0x69, 0x6d, 0x15, 0x25, 0x8c, 0x67,
+	0xca, 0xce, 0xa4, 0x81, 0xa9, 0x4b, 0x08, 0xc6, 0xba, 0x2c, 0x80, 0x3e, 0x2c, 0x10, 0x6b, 0xe1,
+	0x1d, 0xb4, 0x14, 0x46, 0x12, 0x1e, 0x28, 0xa4, 0xa2, 0xc1, 0xb9, 0x69, 0x7e, 0xb3, 0x7e, 0x95,
+	0xbb, 0x15, 0xeb, 0x38, 0xd1, 0x38, 0x99, 0xb0, 0xf0, 0xcf, 0x50, 0x6d, 0x54, 0x0c, 0x6a, 0x6b,
+	0x5e, 0x05, 0x9a, 0xf8, 0x2a, 0x77, 0xab, 0xc3, 0x50, 0xf0, 0x90, 0x29, 0x5b, 0x0f, 0x73, 0xc8,
+	0x5a, 0x59, 0x1b, 0x32, 0xaf, 0x48, 0x8c, 0xa1, 0xd1, 0x38, 0x4a, 0x22, 0x05, 0x99, 0x36, 0x4f,
+	0x8c, 0x81, 0x9f, 0xa2, 0x12, 0xbf, 0x60, 0x42, 0x44, 0x21, 0x93, 0x0e, 0xfa, 0x5f, 0xaf, 0x1b,
+	0x64, 0x14, 0xac, 0x5b, 0x66, 0x5f, 0x5e, 0x12, 0x96, 0x70, 0xd1, 0x73, 0xca, 0xa3, 0x96, 0x19,
+	0xc7, 0x97, 0x80, 0x93, 0x09, 0x0b, 0x37, 0x11, 0xb6, 0xc5, 0x04, 0x53, 0x99, 0x48, 0xe1, 0x3e,
+	0x0f, 0xc7, 0x8f, 0xa2, 0x99, 0x7f, 0xc6, 0x4b, 0xc0, 0xa9, 0xaf, 0xf6, 0xe4, 0x1a, 0x82, 0x7f,
+	0x81, 0xb0, 0x19, 0x10, 0xff, 0x5b, 0xc9, 0x87, 0x6f, 0x33, 0xe6, 0x44, 0x01, 0xfa, 0xc6, 0x6b,
+	0xeb, 0x5c, 0x37, 0xd6, 0x91, 0xe4, 0xb6, 0x15, 0x47, 0x85, 0x62, 0xa1, 0x3e, 0x7f, 0x54, 0x28,
+	0x2e, 0xd6, 0x8b, 0xc3, 0xce, 0xb3, 0xad, 0x20, 0x2b, 0x03, 0x7b, 0xac, 0x7a, 0xde, 0xbf, 0x67,
+	0xd0, 0xd2, 0xc4, 0xed, 0x14, 0x57, 0xd1, 0x6c, 0x14, 0x42, 0x76, 0x14, 0xc8, 0x6c, 0x14, 0xea,
+	0xf1, 0x37, 0xd7, 0x7d, 0x9b, 0x18, 0xd6, 0xd2, 0x71, 0x8a, 0x43, 0x4e, 0x94, 0xc8, 0xac, 0xe2,
+	0xc3, 0xa5, 0xae, 0x30, 0xb6, 0xd4, 0x0d, 0x57, 0xe7, 0xf9, 0xb1, 0xd5, 0x19, 0x3f, 0x44, 0x25,
+	0x3d, 0x2f, 0xcc, 0x98, 0x99, 0x89, 0xa1, 0x27, 0xca, 0x4b, 0x18, 0x36, 0xeb, 0xec, 0x88, 0x28,
+	0x60, 0x66, 0x25, 0x02, 0xe7, 0xb1, 0xb6, 0xf1, 0x47, 0xfa, 0xdd, 0x8b, 0x05, 0x99, 0x62, 0x3e,
+	0x55, 0x90, 0x04, 0x73, 0xa4, 0x64, 0x91, 0x5d, 0x65, 0xdc, 0x9d, 0x48, 0x30, 0xe9, 0x53, 0x93,
+	0x0d, 0xe0, 0x06, 0x64, 0x57, 0x79, 0x7f, 0x9b, 0x41, 0x25, 0xfd, 0xfa, 0xc1, 0xe3, 0x28, 0xe8,
+	0xbd, 0x67, 0xb6, 0x35, 0xd0, 0x3c, 0x0d, 0x93, 0x28, 0x1d, 0xec, 0x29, 0x60, 0x60, 0x0f, 0xe9,
+	0x47, 0x19, 0x7f, 0x54, 0x39, 0xd3, 0xf4, 0x72, 0x42, 0xbb, 0x07, 0x83, 0xfa, 0x6d, 0xea, 0xfb,
+	0x64, 0x14, 0xf7, 0xc6, 0x9f, 0x25, 0x60, 0x79, 0xd7, 0x17, 0xc4, 0x28, 0xee, 0x8d, 0x5e, 0x17,
+	0x7e, 0x8c, 0x6a, 0xf0, 0x0a, 0xa6, 0x1f, 0xc6, 0xa8, 0x68, 0x33, 0x25, 0x9d, 0x79, 0xd8, 0x39,
+	0xaa, 0x16, 0x3e, 0x35, 0x68, 0xf3, 0xf0, 0xfb, 0xcb, 0xf5, 0x99, 0x1f, 0x2e, 0xd7, 0x67, 0xfe,
+	0x75, 0xb9, 0x3e, 0xf3, 0xa7, 0x77, 0xeb, 0xf7, 0x7e, 0x78, 0xb7, 0x7e, 0xef, 0x1f, 0xef, 0xd6,
+	0xef, 0x7d, 0xb3, 0x3d, 0xb6, 0x6f, 0x9b, 0xbc, 0x7e, 0x94, 0x32, 0xf5, 0x86, 0x8b, 0x73, 0x6b,
+	0xea, 0xe7, 0xd0, 0x2e, 0xbc, 0x8b, 0xc2, 0x26, 0xde, 0x5a, 0x80, 0x27, 0xcf, 0xcf, 0xff, 0x33,
+	0x00, 0xd8, 0x1f, 0xc6, 0x54, 0x32, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +1167,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FaucetOperator) > 0 {
+		i -= len(m.FaucetOperator)
+		copy(dAtA[i:], m.FaucetOperator)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.FaucetOperator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.PauseIntervalBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.PauseIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AspectAccessGas {
+		i--
+		if m.AspectAccessGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxDueScheduledCalls != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxDueScheduledCalls))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ScheduledCallBlockGasBudget != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ScheduledCallBlockGasBudget))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SystemTxBlockGasBudget != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.SystemTxBlockGasBudget))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SenderGasQuota != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.SenderGasQuota))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CalldataGasPerToken != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.CalldataGasPerToken))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxTxDataSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxTxDataSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PauseBlocks != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.PauseBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PauseAuthority) > 0 {
+		i -= len(m.PauseAuthority)
+		copy(dAtA[i:], m.PauseAuthority)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.PauseAuthority)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1528,45 +1950,216 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.EnableCreate {
-		n += 2
+	if m.ExecuteAt != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ExecuteAt))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.EnableCall {
-		n += 2
+	if len(m.GasPrice) > 0 {
+		i -= len(m.GasPrice)
+		copy(dAtA[i:], m.GasPrice)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.GasPrice)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovEvm(uint64(e))
-		}
-		n += 1 + sovEvm(uint64(l)) + l
+	if m.GasLimit != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.ChainConfig.Size()
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedTargets) > 0 {
+		for iNdEx := len(m.AllowedTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTargets[iNdEx])
+			copy(dAtA[i:], m.AllowedTargets[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedTargets[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DailyGasBudget != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.DailyGasBudget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MaxGasPrice) > 0 {
+		i -= len(m.MaxGasPrice)
+		copy(dAtA[i:], m.MaxGasPrice)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.MaxGasPrice)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EnableCreate {
+		n += 2
+	}
+	if m.EnableCall {
+		n += 2
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
+	l = m.ChainConfig.Size()
 	n += 1 + l + sovEvm(uint64(l))
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	l = len(m.PauseAuthority)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.PauseBlocks != 0 {
+		n += 1 + sovEvm(uint64(m.PauseBlocks))
+	}
+	if m.MaxTxDataSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxTxDataSize))
+	}
+	if m.CalldataGasPerToken != 0 {
+		n += 1 + sovEvm(uint64(m.CalldataGasPerToken))
+	}
+	if m.SenderGasQuota != 0 {
+		n += 1 + sovEvm(uint64(m.SenderGasQuota))
+	}
+	if m.SystemTxBlockGasBudget != 0 {
+		n += 1 + sovEvm(uint64(m.SystemTxBlockGasBudget))
+	}
+	if m.ScheduledCallBlockGasBudget != 0 {
+		n += 1 + sovEvm(uint64(m.ScheduledCallBlockGasBudget))
+	}
+	if m.MaxDueScheduledCalls != 0 {
+		n += 1 + sovEvm(uint64(m.MaxDueScheduledCalls))
+	}
+	if m.AspectAccessGas {
+		n += 2
+	}
+	if m.PauseIntervalBlocks != 0 {
+		n += 2 + sovEvm(uint64(m.PauseIntervalBlocks))
+	}
+	l = len(m.FaucetOperator)
+	if l > 0 {
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -1827,6 +2420,77 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvm(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovEvm(uint64(m.GasLimit))
+	}
+	l = len(m.GasPrice)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ExecuteAt != 0 {
+		n += 1 + sovEvm(uint64(m.ExecuteAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvm(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *GasPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.MaxGasPrice)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DailyGasBudget != 0 {
+		n += 1 + sovEvm(uint64(m.DailyGasBudget))
+	}
+	if len(m.AllowedTargets) > 0 {
+		for _, s := range m.AllowedTargets {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2063,59 +2727,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvm
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2143,17 +2757,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.HomesteadBlock = &v
-			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PauseAuthority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseBlocks", wireType)
 			}
-			var stringLen uint64
+			m.PauseBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2163,33 +2773,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PauseBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.DAOForkBlock = &v
-			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxDataSize", wireType)
 			}
-			var v int
+			m.MaxTxDataSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2199,17 +2792,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxTxDataSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DAOForkSupport = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalldataGasPerToken", wireType)
 			}
-			var stringLen uint64
+			m.CalldataGasPerToken = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2219,33 +2811,54 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CalldataGasPerToken |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderGasQuota", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
+			m.SenderGasQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderGasQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemTxBlockGasBudget", wireType)
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP150Block = &v
-			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SystemTxBlockGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SystemTxBlockGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallBlockGasBudget", wireType)
 			}
-			var stringLen uint64
+			m.ScheduledCallBlockGasBudget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2255,27 +2868,72 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduledCallBlockGasBudget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDueScheduledCalls", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
+			m.MaxDueScheduledCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDueScheduledCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectAccessGas", wireType)
 			}
-			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AspectAccessGas = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseIntervalBlocks", wireType)
+			}
+			m.PauseIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FaucetOperator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2303,15 +2961,61 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP155Block = &v
-			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FaucetOperator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2340,14 +3044,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.EIP158Block = &v
-			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HomesteadBlock = &v
+			if err := m.HomesteadBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2376,14 +3080,34 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ByzantiumBlock = &v
-			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DAOForkBlock = &v
+			if err := m.DAOForkBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAOForkSupport", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DAOForkSupport = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,14 +3136,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ConstantinopleBlock = &v
-			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP150Block = &v
+			if err := m.EIP150Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP150Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2447,15 +3171,11 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.PetersburgBlock = &v
-			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EIP150Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP155Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2484,14 +3204,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.IstanbulBlock = &v
-			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP155Block = &v
+			if err := m.EIP155Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EIP158Block", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2520,14 +3240,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MuirGlacierBlock = &v
-			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EIP158Block = &v
+			if err := m.EIP158Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2556,14 +3276,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.BerlinBlock = &v
-			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ByzantiumBlock = &v
+			if err := m.ByzantiumBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2592,14 +3312,14 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.LondonBlock = &v
-			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConstantinopleBlock = &v
+			if err := m.ConstantinopleBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2628,8 +3348,188 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ArrowGlacierBlock = &v
-			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PetersburgBlock = &v
+			if err := m.PetersburgBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IstanbulBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.IstanbulBlock = &v
+			if err := m.IstanbulBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MuirGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MuirGlacierBlock = &v
+			if err := m.MuirGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BerlinBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BerlinBlock = &v
+			if err := m.BerlinBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LondonBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.LondonBlock = &v
+			if err := m.LondonBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrowGlacierBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ArrowGlacierBlock = &v
+			if err := m.ArrowGlacierBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3973,6 +4873,491 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyGasBudget", wireType)
+			}
+			m.DailyGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTargets = append(m.AllowedTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,71 @@
+package support
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxGasPolicyTargets is the max number of the allowed targets of a gas policy.
+const MaxGasPolicyTargets = 64
+
+// Validate returns an error if the gas policy is invalid.
+func (p GasPolicy) Validate() error {
+	if _, err := cosmos.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid gas policy address %s: %w", p.Address, err)
+	}
+	if p.Admin != "" {
+		if _, err := cosmos.AccAddressFromBech32(p.Admin); err != nil {
+			return fmt.Errorf("invalid gas policy admin %s: %w", p.Admin, err)
+		}
+	}
+
+	if p.MaxGasPrice != "" {
+		price, ok := sdkmath.NewIntFromString(p.MaxGasPrice)
+		if !ok || price.IsNegative() {
+			return fmt.Errorf("invalid gas policy max gas price %s", p.MaxGasPrice)
+		}
+	}
+
+	if len(p.AllowedTargets) > MaxGasPolicyTargets {
+		return fmt.Errorf("gas policy allowed targets %d exceeds the max %d", len(p.AllowedTargets), MaxGasPolicyTargets)
+	}
+	for _, target := range p.AllowedTargets {
+		if !common.IsHexAddress(target) {
+			return fmt.Errorf("invalid gas policy allowed target %s", target)
+		}
+	}
+	return nil
+}
+
+// HasCaps returns if the gas policy caps any of the gas price, the daily gas or the targets.
+func (p GasPolicy) HasCaps() bool {
+	return p.MaxGasPrice != "" || p.DailyGasBudget > 0 || len(p.AllowedTargets) > 0
+}
+
+// EffectiveAdmin returns the admin of the gas policy, the account itself if not set.
+func (p GasPolicy) EffectiveAdmin() string {
+	if p.Admin != "" {
+		return p.Admin
+	}
+	return p.Address
+}
+
+// IsTargetAllowed returns if the txs may call the target, the contract creations with a
+// nil target are allowed only if the targets are not capped.
+func (p GasPolicy) IsTargetAllowed(to *common.Address) bool {
+	if len(p.AllowedTargets) == 0 {
+		return true
+	}
+	if to == nil {
+		return false
+	}
+	for _, target := range p.AllowedTargets {
+		if common.HexToAddress(target) == *to {
+			return true
+		}
+	}
+	return false
+}
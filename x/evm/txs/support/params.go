@@ -26,6 +26,41 @@ var (
 
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+
+	// DefaultPauseAuthority disables the emergency pause of the EVM (i.e empty)
+	DefaultPauseAuthority = ""
+
+	// DefaultPauseBlocks lets an emergency pause last about an hour with 6s blocks
+	DefaultPauseBlocks uint64 = 600
+
+	// DefaultPauseIntervalBlocks lets the pause authority pause the EVM about once a day with
+	// 6s blocks
+	DefaultPauseIntervalBlocks uint64 = 14_400
+
+	// DefaultMaxTxDataSize disables the limit of the txs data size (i.e 0)
+	DefaultMaxTxDataSize uint64 = 0
+
+	// DefaultCalldataGasPerToken keeps the standard pricing of the txs data (i.e 0)
+	DefaultCalldataGasPerToken uint64 = 0
+
+	// DefaultSenderGasQuota disables the per-sender quota of the block gas (i.e 0)
+	DefaultSenderGasQuota uint64 = 0
+
+	// DefaultSystemTxBlockGasBudget lets the system txs use 10M gas per block
+	DefaultSystemTxBlockGasBudget uint64 = 10_000_000
+
+	// DefaultScheduledCallBlockGasBudget lets the scheduled calls use 10M gas per block
+	DefaultScheduledCallBlockGasBudget uint64 = 10_000_000
+
+	// DefaultMaxDueScheduledCalls visits 256 due scheduled calls per block
+	DefaultMaxDueScheduledCalls uint64 = 256
+
+	// DefaultAspectAccessGas doesn't charge the access of the bound aspects, it's enabled by
+	// governance, so the gas of the txs doesn't change in the middle of a running chain
+	DefaultAspectAccessGas = false
+
+	// DefaultFaucetOperator disables the faucet of the test networks (i.e empty)
+	DefaultFaucetOperator = ""
 )
 
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
@@ -35,14 +70,41 @@ var (
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
 var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
 
+// MaxPauseBlocks is the max number of blocks an emergency pause of the EVM can last at once,
+// about a week with 6s blocks. Governance extends a longer pause with another proposal.
+const MaxPauseBlocks uint64 = 100_800
+
+// MaxCalldataGasPerToken is the max gas per token the txs data can be repriced to, it keeps
+// the intrinsic gas of the txs data far from overflowing.
+const MaxCalldataGasPerToken uint64 = 1 << 10
+
+// SenderGasQuotaBase is the basis points of the whole block gas limit, the sender gas quota
+// can't exceed it.
+const SenderGasQuotaBase uint64 = 10000
+
+// MaxDueScheduledCallsLimit is the max number of the due scheduled calls that can be visited
+// in a block, it bounds the work of the begin blocker.
+const MaxDueScheduledCallsLimit uint64 = 4096
+
 // Parameter keys
 var (
-	ParamStoreKeyEVMDenom            = []byte("EVMDenom")
-	ParamStoreKeyEnableCreate        = []byte("EnableCreate")
-	ParamStoreKeyEnableCall          = []byte("EnableCall")
-	ParamStoreKeyExtraEIPs           = []byte("EnableExtraEIPs")
-	ParamStoreKeyChainConfig         = []byte("ChainConfig")
-	ParamStoreKeyAllowUnprotectedTxs = []byte("AllowUnprotectedTxs")
+	ParamStoreKeyEVMDenom                    = []byte("EVMDenom")
+	ParamStoreKeyEnableCreate                = []byte("EnableCreate")
+	ParamStoreKeyEnableCall                  = []byte("EnableCall")
+	ParamStoreKeyExtraEIPs                   = []byte("EnableExtraEIPs")
+	ParamStoreKeyChainConfig                 = []byte("ChainConfig")
+	ParamStoreKeyAllowUnprotectedTxs         = []byte("AllowUnprotectedTxs")
+	ParamStoreKeyPauseAuthority              = []byte("PauseAuthority")
+	ParamStoreKeyPauseBlocks                 = []byte("PauseBlocks")
+	ParamStoreKeyPauseIntervalBlocks         = []byte("PauseIntervalBlocks")
+	ParamStoreKeyMaxTxDataSize               = []byte("MaxTxDataSize")
+	ParamStoreKeyCalldataGasPerToken         = []byte("CalldataGasPerToken")
+	ParamStoreKeySenderGasQuota              = []byte("SenderGasQuota")
+	ParamStoreKeySystemTxBlockGasBudget      = []byte("SystemTxBlockGasBudget")
+	ParamStoreKeyScheduledCallBlockGasBudget = []byte("ScheduledCallBlockGasBudget")
+	ParamStoreKeyMaxDueScheduledCalls        = []byte("MaxDueScheduledCalls")
+	ParamStoreKeyAspectAccessGas             = []byte("AspectAccessGas")
+	ParamStoreKeyFaucetOperator              = []byte("FaucetOperator")
 )
 
 // NewParams creates a new Params instance
@@ -61,12 +123,23 @@ func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bo
 // ExtraEIPs is empty to prevent overriding the latest hard fork instruction set
 func DefaultParams() Params {
 	return Params{
-		EvmDenom:            DefaultEVMDenom,
-		EnableCreate:        DefaultEnableCreate,
-		EnableCall:          DefaultEnableCall,
-		ChainConfig:         DefaultChainConfig(),
-		ExtraEIPs:           nil,
-		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		EvmDenom:                    DefaultEVMDenom,
+		EnableCreate:                DefaultEnableCreate,
+		EnableCall:                  DefaultEnableCall,
+		ChainConfig:                 DefaultChainConfig(),
+		ExtraEIPs:                   nil,
+		AllowUnprotectedTxs:         DefaultAllowUnprotectedTxs,
+		PauseAuthority:              DefaultPauseAuthority,
+		PauseBlocks:                 DefaultPauseBlocks,
+		PauseIntervalBlocks:         DefaultPauseIntervalBlocks,
+		MaxTxDataSize:               DefaultMaxTxDataSize,
+		CalldataGasPerToken:         DefaultCalldataGasPerToken,
+		SenderGasQuota:              DefaultSenderGasQuota,
+		SystemTxBlockGasBudget:      DefaultSystemTxBlockGasBudget,
+		ScheduledCallBlockGasBudget: DefaultScheduledCallBlockGasBudget,
+		MaxDueScheduledCalls:        DefaultMaxDueScheduledCalls,
+		AspectAccessGas:             DefaultAspectAccessGas,
+		FaucetOperator:              DefaultFaucetOperator,
 	}
 }
 
@@ -92,6 +165,54 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePauseAuthority(p.PauseAuthority); err != nil {
+		return err
+	}
+
+	if err := validatePauseBlocks(p.PauseBlocks); err != nil {
+		return err
+	}
+
+	if err := validatePauseIntervalBlocks(p.PauseIntervalBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMaxTxDataSize(p.MaxTxDataSize); err != nil {
+		return err
+	}
+
+	if err := validateCalldataGasPerToken(p.CalldataGasPerToken); err != nil {
+		return err
+	}
+
+	if err := validateSenderGasQuota(p.SenderGasQuota); err != nil {
+		return err
+	}
+
+	if err := validateSystemTxBlockGasBudget(p.SystemTxBlockGasBudget); err != nil {
+		return err
+	}
+
+	if err := validateScheduledCallBlockGasBudget(p.ScheduledCallBlockGasBudget); err != nil {
+		return err
+	}
+
+	if err := validateMaxDueScheduledCalls(p.MaxDueScheduledCalls); err != nil {
+		return err
+	}
+
+	if err := validateFaucetOperator(p.FaucetOperator); err != nil {
+		return err
+	}
+
+	if p.PauseAuthority != "" && p.PauseBlocks == 0 {
+		return fmt.Errorf("pause blocks must be positive with the pause authority %s", p.PauseAuthority)
+	}
+
+	if p.PauseAuthority != "" && p.PauseIntervalBlocks <= p.PauseBlocks {
+		return fmt.Errorf("pause interval blocks %d must exceed the pause blocks %d with the pause authority %s", p.PauseIntervalBlocks, p.PauseBlocks, p.PauseAuthority)
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -124,6 +245,17 @@ func (p *Params) ParamSetPairs() paramsmodule.ParamSetPairs {
 		paramsmodule.NewParamSetPair(ParamStoreKeyExtraEIPs, &p.ExtraEIPs, validateEIPs),
 		paramsmodule.NewParamSetPair(ParamStoreKeyChainConfig, &p.ChainConfig, validateChainConfig),
 		paramsmodule.NewParamSetPair(ParamStoreKeyAllowUnprotectedTxs, &p.AllowUnprotectedTxs, validateBool),
+		paramsmodule.NewParamSetPair(ParamStoreKeyPauseAuthority, &p.PauseAuthority, validatePauseAuthority),
+		paramsmodule.NewParamSetPair(ParamStoreKeyPauseBlocks, &p.PauseBlocks, validatePauseBlocks),
+		paramsmodule.NewParamSetPair(ParamStoreKeyPauseIntervalBlocks, &p.PauseIntervalBlocks, validatePauseIntervalBlocks),
+		paramsmodule.NewParamSetPair(ParamStoreKeyMaxTxDataSize, &p.MaxTxDataSize, validateMaxTxDataSize),
+		paramsmodule.NewParamSetPair(ParamStoreKeyCalldataGasPerToken, &p.CalldataGasPerToken, validateCalldataGasPerToken),
+		paramsmodule.NewParamSetPair(ParamStoreKeySenderGasQuota, &p.SenderGasQuota, validateSenderGasQuota),
+		paramsmodule.NewParamSetPair(ParamStoreKeySystemTxBlockGasBudget, &p.SystemTxBlockGasBudget, validateSystemTxBlockGasBudget),
+		paramsmodule.NewParamSetPair(ParamStoreKeyScheduledCallBlockGasBudget, &p.ScheduledCallBlockGasBudget, validateScheduledCallBlockGasBudget),
+		paramsmodule.NewParamSetPair(ParamStoreKeyMaxDueScheduledCalls, &p.MaxDueScheduledCalls, validateMaxDueScheduledCalls),
+		paramsmodule.NewParamSetPair(ParamStoreKeyAspectAccessGas, &p.AspectAccessGas, validateBool),
+		paramsmodule.NewParamSetPair(ParamStoreKeyFaucetOperator, &p.FaucetOperator, validateFaucetOperator),
 	}
 }
 
@@ -144,6 +276,126 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validatePauseAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter pause authority type: %T", i)
+	}
+
+	if authority == "" {
+		return nil
+	}
+	if _, err := cosmos.AccAddressFromBech32(authority); err != nil {
+		return fmt.Errorf("invalid pause authority %s: %w", authority, err)
+	}
+	return nil
+}
+
+func validateFaucetOperator(i interface{}) error {
+	operator, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter faucet operator type: %T", i)
+	}
+
+	if operator == "" {
+		return nil
+	}
+	if _, err := cosmos.AccAddressFromBech32(operator); err != nil {
+		return fmt.Errorf("invalid faucet operator %s: %w", operator, err)
+	}
+	return nil
+}
+
+func validatePauseBlocks(i interface{}) error {
+	blocks, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter pause blocks type: %T", i)
+	}
+
+	if blocks > MaxPauseBlocks {
+		return fmt.Errorf("pause blocks %d exceeds the max %d", blocks, MaxPauseBlocks)
+	}
+	return nil
+}
+
+func validatePauseIntervalBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter pause interval blocks type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxTxDataSize(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter max tx data size type: %T", i)
+	}
+	return nil
+}
+
+func validateSystemTxBlockGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter system tx block gas budget type: %T", i)
+	}
+	return nil
+}
+
+func validateScheduledCallBlockGasBudget(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter scheduled call block gas budget type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxDueScheduledCalls(i interface{}) error {
+	calls, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter max due scheduled calls type: %T", i)
+	}
+
+	if calls > MaxDueScheduledCallsLimit {
+		return fmt.Errorf("max due scheduled calls %d exceeds the limit %d", calls, MaxDueScheduledCallsLimit)
+	}
+	return nil
+}
+
+func validateCalldataGasPerToken(i interface{}) error {
+	gas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter calldata gas per token type: %T", i)
+	}
+
+	// the repricing only raises the standard price of the txs data
+	if gas != 0 && (gas < params.TxDataZeroGas || gas > MaxCalldataGasPerToken) {
+		return fmt.Errorf("calldata gas per token %d is out of range [%d, %d]", gas, params.TxDataZeroGas, MaxCalldataGasPerToken)
+	}
+	return nil
+}
+
+func validateSenderGasQuota(i interface{}) error {
+	quota, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter sender gas quota type: %T", i)
+	}
+
+	if quota > SenderGasQuotaBase {
+		return fmt.Errorf("sender gas quota %d exceeds the max %d basis points", quota, SenderGasQuotaBase)
+	}
+	return nil
+}
+
+// SenderGasLimit returns the max gas the txs of a single sender can consume in a block with
+// the given gas limit, false if there's no quota.
+func (p Params) SenderGasLimit(blockGasLimit uint64) (uint64, bool) {
+	if p.SenderGasQuota == 0 || blockGasLimit == 0 {
+		return 0, false
+	}
+	// mul in big to not overflow with the large block gas limits
+	limit := new(big.Int).SetUint64(blockGasLimit)
+	limit.Mul(limit, new(big.Int).SetUint64(p.SenderGasQuota))
+	limit.Quo(limit, new(big.Int).SetUint64(SenderGasQuotaBase))
+	return limit.Uint64(), true
+}
+
 func validateEIPs(i interface{}) error {
 	eips, ok := i.([]int64)
 	if !ok {
@@ -0,0 +1,39 @@
+package txs
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+)
+
+// NewSystemTx returns the ethereum txs of a system txs sent from the address. The system txs
+// are not signed, the sender is set as the r value of the signature instead, so the system
+// txs of the different senders with the same nonce and call have different hashes.
+func NewSystemTx(
+	from common.Address,
+	nonce uint64,
+	to *common.Address,
+	value *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	data []byte,
+) *ethereum.Transaction {
+	return ethereum.NewTx(&ethereum.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gasLimit,
+		To:       to,
+		Value:    value,
+		Data:     data,
+		V:        new(big.Int),
+		R:        new(big.Int).SetBytes(from.Bytes()),
+		S:        new(big.Int),
+	})
+}
+
+// SystemTxSender returns the sender of a system txs created by NewSystemTx.
+func SystemTxSender(tx *ethereum.Transaction) common.Address {
+	_, r, _ := tx.RawSignatureValues()
+	return common.BigToAddress(r)
+}
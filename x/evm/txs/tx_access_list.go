@@ -130,10 +130,12 @@ func (tx AccessListTx) Validate() error {
 	}
 
 	// TODO mark
-	if !(chainID.Cmp(big.NewInt(11820)) == 0 || chainID.Cmp(big.NewInt(11823)) == 0 || chainID.Cmp(big.NewInt(11822)) == 0 || chainID.Cmp(big.NewInt(11821)) == 0) {
+	// the chain id is checked against the configured one of the network by the
+	// signer in the ante handler, so forked networks can use their own chain ids.
+	if chainID.Sign() <= 0 {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidChainID,
-			"chain ID must be 11822、11823、11821、11820  on Artela, got %s", chainID,
+			"chain ID must be positive, got %s", chainID,
 		)
 	}
 
@@ -78,6 +78,20 @@ func (m *MsgEthereumTxResponse) Revert() []byte {
 	return common.CopyBytes(m.Ret)
 }
 
+// Failed returns if the execution at the gas cap reverted, no gas is estimated then
+func (m *EstimateGasResponse) Failed() bool {
+	return len(m.VmError) > 0
+}
+
+// Revert returns the concrete revert reason if the execution at the gas cap is aborted
+// by `REVERT` opcode
+func (m *EstimateGasResponse) Revert() []byte {
+	if m.VmError != vm.ErrExecutionReverted.Error() {
+		return nil
+	}
+	return common.CopyBytes(m.Ret)
+}
+
 // ===============================================================
 //          		      TransactionArgs
 // ===============================================================
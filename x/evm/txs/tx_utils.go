@@ -25,6 +25,11 @@ import (
 const (
 	// Amino names
 	updateParamsName = "artela/MsgUpdateParams"
+	pauseEVMName     = "artela/MsgPauseEVM"
+	scheduleCallName = "artela/MsgScheduleCall"
+	cancelCallName   = "artela/MsgCancelScheduledCall"
+	gasPolicyName    = "artela/MsgSetGasPolicy"
+	faucetDripName   = "artela/MsgFaucetDrip"
 )
 
 var (
@@ -60,6 +65,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*cosmos.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgPauseEVM{},
+		&MsgScheduleCall{},
+		&MsgCancelScheduledCall{},
+		&MsgSetGasPolicy{},
+		&MsgFaucetDrip{},
 	)
 	registry.RegisterInterface(
 		"artela.evm.v1.TxData",
@@ -115,6 +125,11 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgPauseEVM{}, pauseEVMName, nil)
+	cdc.RegisterConcrete(&MsgScheduleCall{}, scheduleCallName, nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledCall{}, cancelCallName, nil)
+	cdc.RegisterConcrete(&MsgSetGasPolicy{}, gasPolicyName, nil)
+	cdc.RegisterConcrete(&MsgFaucetDrip{}, faucetDripName, nil)
 }
 
 // DecodeTxResponse decodes an protobuf-encoded byte slice into TxResponse
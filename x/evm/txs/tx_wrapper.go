@@ -1,6 +1,7 @@
 package txs
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"github.com/artela-network/artela/ethereum/utils"
 
 	// rpctypes "github.com/artela-network/artela/ethereum/rpc/types"
+	"github.com/artela-network/artela/x/evm/txs/support"
 	"github.com/artela-network/artela/x/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -25,6 +27,8 @@ import (
 	cmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
@@ -32,6 +36,12 @@ var (
 	_ cosmos.Tx  = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ cosmos.Msg = &MsgUpdateParams{}
+	_ cosmos.Msg = &MsgPauseEVM{}
+	_ cosmos.Msg = &MsgScheduleCall{}
+	_ cosmos.Msg = &MsgCancelScheduledCall{}
+	_ cosmos.Msg = &MsgSetGasPolicy{}
+
+	_ cosmos.Msg = &MsgFaucetDrip{}
 
 	_ codec.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -61,6 +71,166 @@ func (m MsgUpdateParams) GetSignBytes() []byte {
 	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
 
+// ===============================================================
+//          		      MsgPauseEVM
+// ===============================================================
+
+// GetSigners returns the expected signers for a MsgPauseEVM message.
+func (m MsgPauseEVM) GetSigners() []cosmos.AccAddress {
+	// #nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := cosmos.AccAddressFromBech32(m.Authority)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgPauseEVM) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Blocks > support.MaxPauseBlocks {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "pause blocks %d exceeds the max %d", m.Blocks, support.MaxPauseBlocks)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgPauseEVM) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ===============================================================
+//          		      MsgScheduleCall
+// ===============================================================
+
+// GetSigners returns the expected signers for a MsgScheduleCall message.
+func (m MsgScheduleCall) GetSigners() []cosmos.AccAddress {
+	// #nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := cosmos.AccAddressFromBech32(m.Sender)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgScheduleCall) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := artela.ValidateNonZeroAddress(m.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if m.Value != "" {
+		if value, ok := sdkmath.NewIntFromString(m.Value); !ok || value.IsNegative() {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid value %s", m.Value)
+		}
+	}
+
+	if gasPrice, ok := sdkmath.NewIntFromString(m.GasPrice); !ok || gasPrice.IsNegative() {
+		return errorsmod.Wrapf(types.ErrInvalidGasPrice, "invalid gas price %s", m.GasPrice)
+	}
+
+	// the gas limit is checked against the budgets of the params when the call is scheduled
+	if m.GasLimit < params.TxGas {
+		return errorsmod.Wrapf(types.ErrInvalidGasLimit, "gas limit %d, must be at least %d", m.GasLimit, params.TxGas)
+	}
+
+	if m.ExecuteAt <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidScheduledCall, "invalid execute at %d", m.ExecuteAt)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgScheduleCall) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ===============================================================
+//          		  MsgCancelScheduledCall
+// ===============================================================
+
+// GetSigners returns the expected signers for a MsgCancelScheduledCall message.
+func (m MsgCancelScheduledCall) GetSigners() []cosmos.AccAddress {
+	// #nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := cosmos.AccAddressFromBech32(m.Sender)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelScheduledCall) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelScheduledCall) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ===============================================================
+//          		      MsgSetGasPolicy
+// ===============================================================
+
+// GetSigners returns the expected signers for a MsgSetGasPolicy message.
+func (m MsgSetGasPolicy) GetSigners() []cosmos.AccAddress {
+	// #nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := cosmos.AccAddressFromBech32(m.Sender)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetGasPolicy) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := m.Policy.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetGasPolicy) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ===============================================================
+//          		      MsgFaucetDrip
+// ===============================================================
+
+// GetSigners returns the expected signers for a MsgFaucetDrip message.
+func (m MsgFaucetDrip) GetSigners() []cosmos.AccAddress {
+	// #nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := cosmos.AccAddressFromBech32(m.Operator)
+	return []cosmos.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFaucetDrip) ValidateBasic() error {
+	if _, err := cosmos.AccAddressFromBech32(m.Operator); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if _, err := cosmos.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+	amount, err := cosmos.ParseCoinsNormalized(m.Amount)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+	if !amount.IsAllPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid faucet amount %s", m.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFaucetDrip) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
 // ===============================================================
 //          		      MsgEthereumTx
 // ===============================================================
@@ -126,14 +296,61 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 	return msg.FromEthereumTx(tx)
 }
 
+// txMetadataPrefix domain-separates the metadata signatures from the eth tx signatures.
+var txMetadataPrefix = []byte("artela-eth-tx-metadata:")
+
+// TxMetadataHash returns the hash the sender signs to bind the memo and the timeout height of
+// the cosmos tx to the eth tx of txHash. They're not covered by the eth signature, so without
+// the binding anyone relaying the eth tx could rewrap it with a different memo.
+func TxMetadataHash(txHash common.Hash, memo string, timeoutHeight uint64) common.Hash {
+	return crypto.Keccak256Hash(txMetadataPrefix, txHash.Bytes(), binary.BigEndian.AppendUint64(nil, timeoutHeight), []byte(memo))
+}
+
+// SignTxMetadata signs the memo and the timeout height of the cosmos tx wrapping the signed
+// msg with the key of its sender, the signature is carried by the ExtensionOptionsEthereumTx.
+func (msg *MsgEthereumTx) SignTxMetadata(keyringSigner keyring.Signer, memo string, timeoutHeight uint64) ([]byte, error) {
+	from := msg.GetFrom()
+	if from.Empty() {
+		return nil, fmt.Errorf("sender address not defined for message")
+	}
+
+	hash := TxMetadataHash(msg.AsTransaction().Hash(), memo, timeoutHeight)
+	sig, _, err := keyringSigner.SignByAddress(from, hash.Bytes())
+	return sig, err
+}
+
+// VerifyTxMetadata checks that the memo and the timeout height of the cosmos tx wrapping the
+// msg are signed by sender.
+func (msg *MsgEthereumTx) VerifyTxMetadata(sender common.Address, memo string, timeoutHeight uint64, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "invalid tx metadata signature length")
+	}
+
+	hash := TxMetadataHash(msg.AsTransaction().Hash(), memo, timeoutHeight)
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "invalid tx metadata signature")
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != sender {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "tx metadata signed by %s, expected the sender %s", signer, sender)
+	}
+	return nil
+}
+
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.BuildTxWithMetadata(b, evmDenom, nil)
+}
+
+// BuildTxWithMetadata builds the canonical cosmos tx from ethereum msg, metadataSig is the
+// signature of SignTxMetadata over the memo and the timeout height set on the builder.
+func (msg *MsgEthereumTx) BuildTxWithMetadata(b client.TxBuilder, evmDenom string, metadataSig []byte) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codec.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	option, err := codec.NewAnyWithValue(&ExtensionOptionsEthereumTx{MetadataSignature: metadataSig})
 	if err != nil {
 		return nil, err
 	}
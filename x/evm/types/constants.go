@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -10,6 +11,27 @@ const (
 	TypeMsgEthereumTx = "ethereum_tx"
 )
 
+// The limits of the scheduled calls, they're executed in the begin blocker against the
+// scheduled call gas budget of the params, the calls not fitting in the budget of a block are
+// retried in the next blocks until they expire.
+const (
+	// ScheduledCallMaxDelay is the max number of blocks a call can be scheduled ahead
+	ScheduledCallMaxDelay int64 = 1_000_000
+	// ScheduledCallExpiryBlocks is the number of blocks after its height a call is refunded at
+	// if it's not executed yet
+	ScheduledCallExpiryBlocks int64 = 100
+	// MaxScheduledCallsPerSender is the max number of the pending scheduled calls of a sender
+	MaxScheduledCallsPerSender = 16
+)
+
+// FaucetAccountName is the name of the module account funding the faucet of the test networks,
+// it's funded at genesis and its funds are sent only by the faucet operator of the params.
+const FaucetAccountName = "faucet"
+
+// ChainInfoContractAddress is the address of the read-only system contract serving the chain
+// params relevant to the contracts, it's deployed and updated by the begin blocker.
+var ChainInfoContractAddress = common.HexToAddress("0x0000000000000000000000000000000000A27E01")
+
 const (
 	// ModuleName string name of module
 	ModuleName = "evm"
@@ -32,6 +54,16 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixParamsHistory
+	prefixPausedUntil
+	prefixLastAuthorityPause
+	prefixHeaderHash
+	prefixScheduledCall
+	prefixScheduledCallQueue
+	prefixScheduledCallSender
+	prefixScheduledCallSequence
+	prefixGasPolicy
+	prefixGasPolicyUsage
 )
 
 // prefix bytes for the EVM transient store
@@ -40,6 +72,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientSystemGasUsed
+	prefixTransientExecutionDigest
 )
 
 // Evm module events
@@ -60,6 +94,59 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeySystemTx         = "systemTx"
+	// AttributeKeyTxData is the hex encoded raw txs of a system txs, it's not in the block
+	AttributeKeyTxData = "txData"
+
+	// execution digest events, emitted at the end of each block with the digest of the
+	// receipts of the ethereum txs of the block
+	EventTypeExecutionDigest  = "execution_digest"
+	AttributeKeyDigest        = "digest"
+	AttributeKeyDigestTxs     = "txs"
+	AttributeKeyDigestGasUsed = "gasUsed"
+
+	// emergency pause events, emitted when the EVM is paused, extended or resumed
+	EventTypeEVMPause          = "evm_pause"
+	AttributeKeyPauseAuthority = "authority"
+	AttributeKeyPausedUntil    = "pausedUntil"
+
+	// scheduled call events, emitted when a call is scheduled, executed, expired or cancelled
+	EventTypeScheduledCall          = "scheduled_call"
+	AttributeKeyScheduledCallId     = "id"
+	AttributeKeyScheduledCallStatus = "status"
+	AttributeKeyScheduledCallSender = "sender"
+
+	ScheduledCallStatusScheduled = "scheduled"
+	ScheduledCallStatusExecuted  = "executed"
+	ScheduledCallStatusFailed    = "failed"
+	ScheduledCallStatusExpired   = "expired"
+	ScheduledCallStatusCancelled = "cancelled"
+
+	// gas policy events, emitted when the gas policy of an account is set or removed
+	EventTypeGasPolicy         = "gas_policy"
+	AttributeKeyGasPolicyOwner = "address"
+	AttributeKeyGasPolicyAdmin = "admin"
+	AttributeKeyGasPolicyState = "state"
+
+	GasPolicyStateSet     = "set"
+	GasPolicyStateRemoved = "removed"
+
+	// faucet events, emitted when the faucet operator sends funds of the faucet account
+	EventTypeFaucetDrip        = "faucet_drip"
+	AttributeKeyFaucetOperator = "operator"
+	AttributeKeyFaucetAmount   = "amount"
+
+	// aspect lifecycle events, emitted by the aspect native contract
+	EventTypeAspectDeploy  = "aspect_deploy"
+	EventTypeAspectUpgrade = "aspect_upgrade"
+	EventTypeAspectBind    = "aspect_bind"
+	EventTypeAspectUnbind  = "aspect_unbind"
+
+	AttributeKeyAspectId        = "aspectId"
+	AttributeKeyAspectVersion   = "aspectVersion"
+	AttributeKeyAspectAccount   = "account"
+	AttributeKeyAspectPriority  = "priority"
+	AttributeKeyAspectJoinPoint = "joinPoint"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -70,6 +157,26 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+	// KeyPrefixParamsHistory is the prefix of the params keyed by the height they are set at
+	KeyPrefixParamsHistory = []byte{prefixParamsHistory}
+	// KeyPrefixPausedUntil is the key of the height the emergency pause of the EVM expires at
+	KeyPrefixPausedUntil = []byte{prefixPausedUntil}
+	// KeyPrefixLastAuthorityPause is the key of the height the pause authority last paused the EVM at
+	KeyPrefixLastAuthorityPause = []byte{prefixLastAuthorityPause}
+	// KeyPrefixHeaderHash is the prefix of the hashes of the recent blocks keyed by height
+	KeyPrefixHeaderHash = []byte{prefixHeaderHash}
+	// KeyPrefixScheduledCall is the prefix of the pending scheduled calls keyed by id
+	KeyPrefixScheduledCall = []byte{prefixScheduledCall}
+	// KeyPrefixScheduledCallQueue is the prefix of the ids of the scheduled calls keyed by height
+	KeyPrefixScheduledCallQueue = []byte{prefixScheduledCallQueue}
+	// KeyPrefixScheduledCallSender is the prefix of the ids of the scheduled calls keyed by sender
+	KeyPrefixScheduledCallSender = []byte{prefixScheduledCallSender}
+	// KeyPrefixScheduledCallSequence is the key of the id of the next scheduled call
+	KeyPrefixScheduledCallSequence = []byte{prefixScheduledCallSequence}
+	// KeyPrefixGasPolicy is the prefix of the gas policies keyed by account
+	KeyPrefixGasPolicy = []byte{prefixGasPolicy}
+	// KeyPrefixGasPolicyUsage is the prefix of the daily gas spent by the accounts with a gas policy
+	KeyPrefixGasPolicyUsage = []byte{prefixGasPolicyUsage}
 )
 
 // Transient Store key prefixes
@@ -78,6 +185,10 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	// KeyPrefixTransientSystemGasUsed is the gas used by the system txs of the current block
+	KeyPrefixTransientSystemGasUsed = []byte{prefixTransientSystemGasUsed}
+	// KeyPrefixTransientExecutionDigest is the running execution digest of the current block
+	KeyPrefixTransientExecutionDigest = []byte{prefixTransientExecutionDigest}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -85,6 +196,51 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// ParamsHistoryKey returns the key of the params set at the given height.
+func ParamsHistoryKey(height int64) []byte {
+	return append(KeyPrefixParamsHistory, cosmos.Uint64ToBigEndian(uint64(height))...) // #nosec G701
+}
+
+// HeaderHashKey returns the key of the hash of the block at the given height.
+func HeaderHashKey(height int64) []byte {
+	return append(KeyPrefixHeaderHash, cosmos.Uint64ToBigEndian(uint64(height))...) // #nosec G701
+}
+
+// ScheduledCallKey returns the key of the scheduled call with the given id.
+func ScheduledCallKey(id uint64) []byte {
+	return append(KeyPrefixScheduledCall, cosmos.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledCallQueueKey returns the key of the id of a call scheduled at the given height.
+func ScheduledCallQueueKey(height int64, id uint64) []byte {
+	return append(ScheduledCallQueuePrefix(height), cosmos.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledCallQueuePrefix returns the prefix of the ids of the calls scheduled at the given height.
+func ScheduledCallQueuePrefix(height int64) []byte {
+	return append(KeyPrefixScheduledCallQueue, cosmos.Uint64ToBigEndian(uint64(height))...) // #nosec G701
+}
+
+// ScheduledCallSenderPrefix returns the prefix of the ids of the calls scheduled by the sender.
+func ScheduledCallSenderPrefix(sender common.Address) []byte {
+	return append(KeyPrefixScheduledCallSender, sender.Bytes()...)
+}
+
+// ScheduledCallSenderKey returns the key of the id of a call scheduled by the sender.
+func ScheduledCallSenderKey(sender common.Address, id uint64) []byte {
+	return append(ScheduledCallSenderPrefix(sender), cosmos.Uint64ToBigEndian(id)...)
+}
+
+// GasPolicyKey returns the key of the gas policy of the account.
+func GasPolicyKey(address common.Address) []byte {
+	return append(KeyPrefixGasPolicy, address.Bytes()...)
+}
+
+// GasPolicyUsageKey returns the key of the daily gas spent by the account.
+func GasPolicyUsageKey(address common.Address) []byte {
+	return append(KeyPrefixGasPolicyUsage, address.Bytes()...)
+}
+
 // StateKey defines the full key under which an account states is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
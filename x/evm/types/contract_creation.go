@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractCreation is the metadata of a contract recorded when it's created by CREATE
+// or CREATE2, it's stored in the node local contract index db.
+type ContractCreation struct {
+	Address      common.Address `json:"address"`
+	Creator      common.Address `json:"creator"`
+	TxHash       common.Hash    `json:"transactionHash"`
+	BlockNumber  hexutil.Uint64 `json:"blockNumber"`
+	InitCodeHash common.Hash    `json:"initCodeHash"`
+	// Salt is the salt of the contracts created by CREATE2
+	Salt *common.Hash `json:"salt,omitempty"`
+}
+
+// ContractCreationKey returns the key of the creation of a contract in the contract index db.
+func ContractCreationKey(address common.Address) []byte {
+	return address.Bytes()
+}
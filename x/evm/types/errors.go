@@ -3,14 +3,34 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+// The errors of the EVM module are registered in the "evm" codespace, their codes are
+// part of the API and must never be reused or reordered, new errors are appended.
+//
+//	2  ErrInvalidState          10 ErrVMExecution          18 ErrBlockedAddress
+//	3  ErrInvalidChainConfig    11 ErrInvalidRefund        19 ErrSystemTxGasBudget
+//	4  ErrZeroAddress           12 ErrInvalidGasCap        20 ErrGasCapExceeded
+//	5  ErrCreateDisabled        13 ErrInvalidBaseFee       21 ErrEVMPaused
+//	6  ErrCallDisabled          14 ErrGasOverflow          22 ErrTxDataTooLarge
+//	7  ErrInvalidAmount         15 ErrInvalidAccount       23 ErrInvalidScheduledCall
+//	8  ErrInvalidGasPrice       16 ErrInvalidGasLimit      24 ErrGasPolicyViolated
+//	9  ErrInvalidGasFee         17 ErrCallContract
+//
+// The errors wrapped by WrapWithData also carry machine-readable data, e.g. the required
+// and provided fee, which is carried by the gRPC status details of the query errors and the
+// data of the CheckTx responses, and served by the JSON-RPC server as the error data together
+// with the codespace and code.
 const (
 	codeErrInvalidState = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
 	codeErrInvalidChainConfig
@@ -28,6 +48,13 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrCallContract
+	codeErrBlockedAddress
+	codeErrSystemTxGasBudget
+	codeErrGasCapExceeded
+	codeErrEVMPaused
+	codeErrTxDataTooLarge
+	codeErrInvalidScheduledCall
+	codeErrGasPolicyViolated
 )
 
 var (
@@ -76,9 +103,165 @@ var (
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
 
+	// ErrCallContract returns an error if a call to a contract by the chain itself fails
 	ErrCallContract = errorsmod.Register(ModuleName, codeErrCallContract, "call contract error")
+
+	// ErrBlockedAddress returns an error if the EVM transfers funds to a blocked module account
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "transfer to blocked address")
+
+	// ErrSystemTxGasBudget returns an error if a system txs exceeds the system txs gas budget of the block
+	ErrSystemTxGasBudget = errorsmod.Register(ModuleName, codeErrSystemTxGasBudget, "system txs gas budget exceeded")
+
+	// ErrGasCapExceeded returns an error if the gas of a query is higher than the query gas cap
+	ErrGasCapExceeded = errorsmod.Register(ModuleName, codeErrGasCapExceeded, "gas cap exceeded")
+
+	// ErrEVMPaused returns an error if the EVM calls and creates are paused in an emergency
+	ErrEVMPaused = errorsmod.Register(ModuleName, codeErrEVMPaused, "EVM is paused")
+
+	// ErrTxDataTooLarge returns an error if the data of a txs exceeds the max txs data size
+	ErrTxDataTooLarge = errorsmod.Register(ModuleName, codeErrTxDataTooLarge, "txs data too large")
+
+	// ErrInvalidScheduledCall returns an error if a scheduled call is invalid or can't be found
+	ErrInvalidScheduledCall = errorsmod.Register(ModuleName, codeErrInvalidScheduledCall, "invalid scheduled call")
+
+	// ErrGasPolicyViolated returns an error if a txs breaks the gas policy of its sender
+	ErrGasPolicyViolated = errorsmod.Register(ModuleName, codeErrGasPolicyViolated, "gas policy violated")
 )
 
+// The keys of the machine-readable error data.
+const (
+	// ErrorDataRequired is the amount required by the check, e.g. the minimum fee.
+	ErrorDataRequired = "required"
+	// ErrorDataProvided is the amount provided by the tx or the query, e.g. its fee or gas limit.
+	ErrorDataProvided = "provided"
+	// ErrorDataLimit is the upper bound exceeded by the tx or the query, e.g. the block gas limit.
+	ErrorDataLimit = "limit"
+	// ErrorDataAddress is the account the check failed for.
+	ErrorDataAddress = "address"
+	// ErrorDataHeight is the block height the check depends on, e.g. the height the EVM resumes at.
+	ErrorDataHeight = "height"
+)
+
+// ErrorData is the machine-readable data of an error, keyed by the ErrorData* keys.
+type ErrorData map[string]string
+
+// ErrorInfo is the codespace, code and data of an error wrapped by WrapWithData, it's
+// attached to the gRPC statuses as an errdetails.ErrorInfo and JSON encoded in the data of
+// the CheckTx responses.
+type ErrorInfo struct {
+	Codespace string    `json:"codespace"`
+	Code      uint32    `json:"code"`
+	Data      ErrorData `json:"data,omitempty"`
+}
+
+// DataError is a registered error with its machine-readable data attached, it unwraps to
+// the registered error so errors.Is and the ABCI codespace and code are preserved.
+type DataError struct {
+	err  error
+	data ErrorData
+}
+
+// WrapWithData wraps the registered error with the description and attaches the data.
+func WrapWithData(err error, data ErrorData, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &DataError{
+		err:  errorsmod.Wrapf(err, format, args...),
+		data: data,
+	}
+}
+
+// Error returns the message of the wrapped error, the data is not part of it.
+func (e *DataError) Error() string {
+	return e.err.Error()
+}
+
+// Info returns the codespace, code and data of the error.
+func (e *DataError) Info() ErrorInfo {
+	codespace, code, _ := errorsmod.ABCIInfo(e.err, false)
+	return ErrorInfo{
+		Codespace: codespace,
+		Code:      code,
+		Data:      e.data,
+	}
+}
+
+// Data returns the machine-readable data of the error.
+func (e *DataError) Data() ErrorData {
+	return e.data
+}
+
+// Cause returns the wrapped registered error.
+func (e *DataError) Cause() error {
+	return e.err
+}
+
+// Unwrap implements the built-in errors.Unwrap.
+func (e *DataError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns the gRPC status of the wrapped error with the codespace, code and data
+// attached as its details.
+func (e *DataError) GRPCStatus() *grpcstatus.Status {
+	return withErrorInfo(grpcstatus.New(grpcstatus.Code(e.err), e.Error()), e.Info())
+}
+
+// ErrorInfoOf returns the codespace, code and data of the DataError in the chain of the error.
+func ErrorInfoOf(err error) (ErrorInfo, bool) {
+	var dataErr *DataError
+	if !errors.As(err, &dataErr) {
+		return ErrorInfo{}, false
+	}
+	return dataErr.Info(), true
+}
+
+// StatusError returns a gRPC status error with the code and the message of the error, the
+// codespace, code and data of a DataError in its chain are attached as the status details.
+func StatusError(code codes.Code, err error) error {
+	st := grpcstatus.New(code, err.Error())
+	if info, ok := ErrorInfoOf(err); ok {
+		st = withErrorInfo(st, info)
+	}
+	return st.Err()
+}
+
+// StatusErrorInfo returns the codespace, code and data attached to the gRPC status by
+// DataError.GRPCStatus or StatusError.
+func StatusErrorInfo(st *grpcstatus.Status) (ErrorInfo, bool) {
+	for _, detail := range st.Details() {
+		errInfo, ok := detail.(*errdetails.ErrorInfo)
+		if !ok {
+			continue
+		}
+		code, err := strconv.ParseUint(errInfo.Reason, 10, 32)
+		if err != nil {
+			continue
+		}
+		return ErrorInfo{
+			Codespace: errInfo.Domain,
+			Code:      uint32(code),
+			Data:      errInfo.Metadata,
+		}, true
+	}
+	return ErrorInfo{}, false
+}
+
+// withErrorInfo attaches the error info to the status as an errdetails.ErrorInfo, whose
+// domain is the codespace and reason is the code of the error.
+func withErrorInfo(st *grpcstatus.Status, info ErrorInfo) *grpcstatus.Status {
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   strconv.FormatUint(uint64(info.Code), 10),
+		Domain:   info.Codespace,
+		Metadata: info.Data,
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
 // with the return reason.
 func NewExecErrorWithReason(revertReason []byte) *RevertError {
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// executionDigestSize is the size of an encoded ExecutionDigest
+const executionDigestSize = common.HashLength + 16
+
+// ExecutionDigest is the running digest of the receipts of the ethereum txs of a block, in
+// the execution order. It's emitted at the end of the block, so the validators diverging in
+// the execution can be spotted at the block where it happens.
+type ExecutionDigest struct {
+	Digest  common.Hash
+	Txs     uint64
+	GasUsed uint64
+}
+
+// AddReceipt folds the receipt into the digest, the new digest is
+// keccak256(digest || tx hash || gas used || consensus encoding of the receipt), the
+// encoding covers the status, the cumulative gas used, the bloom and the logs.
+func (d *ExecutionDigest) AddReceipt(receipt *ethereum.Receipt) error {
+	bz, err := receipt.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	var gasUsed [8]byte
+	binary.BigEndian.PutUint64(gasUsed[:], receipt.GasUsed)
+	d.Digest = crypto.Keccak256Hash(d.Digest.Bytes(), receipt.TxHash.Bytes(), gasUsed[:], bz)
+	d.Txs++
+	d.GasUsed += receipt.GasUsed
+	return nil
+}
+
+// Marshal encodes the digest in a fixed size binary format.
+func (d ExecutionDigest) Marshal() []byte {
+	bz := make([]byte, executionDigestSize)
+	copy(bz, d.Digest.Bytes())
+	binary.BigEndian.PutUint64(bz[common.HashLength:], d.Txs)
+	binary.BigEndian.PutUint64(bz[common.HashLength+8:], d.GasUsed)
+	return bz
+}
+
+// UnmarshalExecutionDigest decodes the digest encoded by ExecutionDigest.Marshal.
+func UnmarshalExecutionDigest(bz []byte) (ExecutionDigest, error) {
+	if len(bz) != executionDigestSize {
+		return ExecutionDigest{}, fmt.Errorf("invalid execution digest size %d, expect %d", len(bz), executionDigestSize)
+	}
+	return ExecutionDigest{
+		Digest:  common.BytesToHash(bz[:common.HashLength]),
+		Txs:     binary.BigEndian.Uint64(bz[common.HashLength:]),
+		GasUsed: binary.BigEndian.Uint64(bz[common.HashLength+8:]),
+	}, nil
+}
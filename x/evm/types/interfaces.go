@@ -30,6 +30,7 @@ type BankKeeper interface {
 	authmodule.BankKeeper
 	GetBalance(ctx cosmos.Context, addr cosmos.AccAddress, denom string) cosmos.Coin
 	SendCoinsFromModuleToAccount(ctx cosmos.Context, senderModule string, recipientAddr cosmos.AccAddress, amt cosmos.Coins) error
+	SendCoinsFromModuleToModule(ctx cosmos.Context, senderModule, recipientModule string, amt cosmos.Coins) error
 	MintCoins(ctx cosmos.Context, moduleName string, amt cosmos.Coins) error
 	BurnCoins(ctx cosmos.Context, moduleName string, amt cosmos.Coins) error
 }
@@ -97,12 +97,15 @@ func (k Keeper) GetParams(ctx cosmos.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.ParamsKey)
 	if len(bz) == 0 {
-		var p types.Params
-		k.ss.GetParamSetIfExists(ctx, &p)
-		return p
+		k.ss.GetParamSetIfExists(ctx, &params)
+	} else {
+		k.cdc.MustUnmarshal(bz, &params)
 	}
 
-	k.cdc.MustUnmarshal(bz, &params)
+	// the params stored before the min priority fee was introduced don't set it
+	if params.MinPriorityFee.IsNil() {
+		params.MinPriorityFee = types.DefaultMinPriorityFee
+	}
 	return params
 }
 
@@ -43,6 +43,9 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// min_priority_fee defines the minimum priority fee per gas of the eth transactions,
+	// the tip paid on top of the base fee, 0 disables the check
+	MinPriorityFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_priority_fee,json=minPriorityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_priority_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -113,32 +116,33 @@ func init() {
 func init() { proto.RegisterFile("artela/fee/v1/fee.proto", fileDescriptor_5b545c073c30863c) }
 
 var fileDescriptor_5b545c073c30863c = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x5f, 0xcb, 0xd3, 0x30,
-	0x14, 0xc6, 0x1b, 0xf7, 0xbe, 0x7b, 0xfb, 0x66, 0x16, 0x46, 0x98, 0x58, 0x14, 0xba, 0xa2, 0x20,
-	0xbd, 0x59, 0xcb, 0xd8, 0xb5, 0x37, 0x73, 0xa8, 0x13, 0x84, 0xd1, 0x4b, 0x11, 0x42, 0xda, 0x9d,
-	0xb5, 0x61, 0x6d, 0x52, 0x9a, 0x6c, 0xba, 0x6f, 0xe1, 0xc7, 0xda, 0xe5, 0xae, 0x44, 0xbc, 0x18,
-	0xb2, 0x7d, 0x11, 0x69, 0xbb, 0x7f, 0xb7, 0x7a, 0x75, 0x92, 0xf3, 0x3b, 0x79, 0xce, 0x49, 0xf2,
-	0xe0, 0xe7, 0xac, 0xd4, 0x90, 0xb1, 0x60, 0x01, 0x10, 0xac, 0x87, 0x55, 0xf0, 0x8b, 0x52, 0x6a,
-	0x49, 0xac, 0x06, 0xf8, 0x55, 0x66, 0x3d, 0x7c, 0xd1, 0x4b, 0x64, 0x22, 0x6b, 0x12, 0x54, 0xab,
-	0xa6, 0xe8, 0xd5, 0xcf, 0x16, 0x6e, 0xcf, 0x58, 0xc9, 0x72, 0x45, 0x1c, 0xdc, 0x11, 0x92, 0x46,
-	0x4c, 0x01, 0x5d, 0x00, 0xd8, 0xc8, 0x45, 0x9e, 0x19, 0x3e, 0x0a, 0x39, 0x66, 0x0a, 0xde, 0x03,
-	0x90, 0xb7, 0xf8, 0xe5, 0x19, 0xd2, 0x38, 0x65, 0x22, 0x01, 0x3a, 0x07, 0x21, 0x73, 0x2e, 0x98,
-	0x96, 0xa5, 0xfd, 0xc4, 0x45, 0x9e, 0x15, 0xda, 0x51, 0x53, 0xfd, 0xae, 0x2e, 0x98, 0x5c, 0x39,
-	0x19, 0xe1, 0x67, 0x90, 0x31, 0xa5, 0x79, 0xcc, 0xf5, 0x86, 0xe6, 0xab, 0x4c, 0xf3, 0x22, 0xe3,
-	0x50, 0xda, 0xad, 0xfa, 0x60, 0xef, 0x0a, 0x3f, 0x5f, 0x18, 0x79, 0x8d, 0x2d, 0x10, 0x2c, 0xca,
-	0x80, 0xa6, 0xc0, 0x93, 0x54, 0xdb, 0xf7, 0x2e, 0xf2, 0x5a, 0xe1, 0xd3, 0x26, 0xf9, 0xb1, 0xce,
-	0x91, 0x29, 0x36, 0x2f, 0x53, 0xb7, 0x5d, 0xe4, 0x3d, 0x8e, 0xfd, 0xed, 0xbe, 0x6f, 0xfc, 0xde,
-	0xf7, 0xdf, 0x24, 0x5c, 0xa7, 0xab, 0xc8, 0x8f, 0x65, 0x1e, 0xc4, 0x52, 0xe5, 0x52, 0x9d, 0xc2,
-	0x40, 0xcd, 0x97, 0x81, 0xde, 0x14, 0xa0, 0xfc, 0xa9, 0xd0, 0xe1, 0xc3, 0x69, 0x6a, 0x12, 0x62,
-	0x2b, 0xe7, 0x82, 0x26, 0x4c, 0xd1, 0xa2, 0xe4, 0x31, 0xd8, 0x0f, 0xff, 0xac, 0x37, 0x81, 0x38,
-	0xec, 0xe4, 0x5c, 0x7c, 0x60, 0x6a, 0x56, 0x49, 0x90, 0xaf, 0x98, 0x9c, 0x35, 0x6f, 0x6e, 0x6d,
-	0xfe, 0x97, 0x70, 0xb7, 0x11, 0xbe, 0xbe, 0xd0, 0xa7, 0x3b, 0xf3, 0xae, 0x7b, 0x1f, 0x76, 0xb9,
-	0xe0, 0x9a, 0xb3, 0xec, 0xf2, 0x7d, 0xe3, 0xe9, 0xf6, 0xe0, 0xa0, 0xdd, 0xc1, 0x41, 0x7f, 0x0e,
-	0x0e, 0xfa, 0x71, 0x74, 0x8c, 0xdd, 0xd1, 0x31, 0x7e, 0x1d, 0x1d, 0xe3, 0x4b, 0x70, 0xd3, 0xab,
-	0xb1, 0xc8, 0x40, 0x80, 0xfe, 0x26, 0xcb, 0xe5, 0x69, 0x5b, 0xd9, 0xe8, 0x7b, 0xed, 0xa7, 0xba,
-	0x71, 0xd4, 0xae, 0xad, 0x32, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x34, 0x50, 0xe9, 0x1f, 0x6a,
-	0x02, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x6a, 0xdc, 0x30,
+	0x10, 0xb5, 0x9a, 0xcd, 0xc6, 0xab, 0xd4, 0x65, 0x11, 0x29, 0x35, 0x2d, 0x38, 0xa6, 0x85, 0xe2,
+	0x4b, 0x6c, 0x42, 0xce, 0xbd, 0x6c, 0x43, 0xda, 0x2d, 0x14, 0x16, 0x9f, 0x4a, 0x29, 0x08, 0xd9,
+	0x99, 0xd8, 0x22, 0x96, 0x64, 0x24, 0x25, 0x6d, 0xfe, 0xa2, 0x9f, 0xd2, 0xcf, 0xc8, 0x31, 0xc7,
+	0xd2, 0x43, 0x28, 0xbb, 0x3f, 0x52, 0x64, 0x3b, 0x9b, 0xbd, 0x76, 0x4f, 0x23, 0xbd, 0x37, 0x7a,
+	0x33, 0x7a, 0x3c, 0xfc, 0x82, 0x69, 0x0b, 0x0d, 0xcb, 0x2e, 0x00, 0xb2, 0xeb, 0x63, 0x57, 0xd2,
+	0x56, 0x2b, 0xab, 0x48, 0xd0, 0x13, 0xa9, 0x43, 0xae, 0x8f, 0x5f, 0x1e, 0x54, 0xaa, 0x52, 0x1d,
+	0x93, 0xb9, 0x53, 0xdf, 0xf4, 0xfa, 0xd7, 0x08, 0x8f, 0x17, 0x4c, 0x33, 0x61, 0x48, 0x84, 0xf7,
+	0xa5, 0xa2, 0x05, 0x33, 0x40, 0x2f, 0x00, 0x42, 0x14, 0xa3, 0xc4, 0xcf, 0x27, 0x52, 0xcd, 0x98,
+	0x81, 0x33, 0x00, 0xf2, 0x0e, 0xbf, 0x7a, 0x20, 0x69, 0x59, 0x33, 0x59, 0x01, 0x3d, 0x07, 0xa9,
+	0x04, 0x97, 0xcc, 0x2a, 0x1d, 0x3e, 0x89, 0x51, 0x12, 0xe4, 0x61, 0xd1, 0x77, 0xbf, 0xef, 0x1a,
+	0x4e, 0x1f, 0x79, 0x72, 0x82, 0x9f, 0x43, 0xc3, 0x8c, 0xe5, 0x25, 0xb7, 0x37, 0x54, 0x5c, 0x35,
+	0x96, 0xb7, 0x0d, 0x07, 0x1d, 0xee, 0x74, 0x0f, 0x0f, 0x1e, 0xc9, 0xcf, 0x6b, 0x8e, 0xbc, 0xc1,
+	0x01, 0x48, 0x56, 0x34, 0x40, 0x6b, 0xe0, 0x55, 0x6d, 0xc3, 0xdd, 0x18, 0x25, 0x3b, 0xf9, 0xd3,
+	0x1e, 0xfc, 0xd8, 0x61, 0x64, 0x8e, 0xfd, 0xf5, 0xd6, 0xe3, 0x18, 0x25, 0x93, 0x59, 0x7a, 0x7b,
+	0x7f, 0xe8, 0xfd, 0xb9, 0x3f, 0x7c, 0x5b, 0x71, 0x5b, 0x5f, 0x15, 0x69, 0xa9, 0x44, 0x56, 0x2a,
+	0x23, 0x94, 0x19, 0xca, 0x91, 0x39, 0xbf, 0xcc, 0xec, 0x4d, 0x0b, 0x26, 0x9d, 0x4b, 0x9b, 0xef,
+	0x0d, 0x5b, 0x93, 0x1c, 0x07, 0x82, 0x4b, 0x5a, 0x31, 0x43, 0x5b, 0xcd, 0x4b, 0x08, 0xf7, 0xfe,
+	0x5b, 0xef, 0x14, 0xca, 0x7c, 0x5f, 0x70, 0xf9, 0x81, 0x99, 0x85, 0x93, 0x20, 0xdf, 0x30, 0x79,
+	0xd0, 0xdc, 0xf8, 0xb5, 0xbf, 0x95, 0xf0, 0xb4, 0x17, 0xde, 0x70, 0xe8, 0x0b, 0x76, 0x98, 0xdb,
+	0x56, 0x69, 0x67, 0xac, 0x33, 0x61, 0xb2, 0x95, 0x09, 0xcf, 0x04, 0x97, 0x8b, 0x41, 0xe6, 0x0c,
+	0xe0, 0xd3, 0xc8, 0x1f, 0x4d, 0x77, 0xf3, 0x29, 0x97, 0xdc, 0x72, 0xd6, 0xac, 0x83, 0x31, 0x9b,
+	0xdf, 0x2e, 0x23, 0x74, 0xb7, 0x8c, 0xd0, 0xdf, 0x65, 0x84, 0x7e, 0xae, 0x22, 0xef, 0x6e, 0x15,
+	0x79, 0xbf, 0x57, 0x91, 0xf7, 0x35, 0xdb, 0x98, 0xd4, 0x87, 0xef, 0x48, 0x82, 0xfd, 0xae, 0xf4,
+	0xe5, 0x70, 0x75, 0x01, 0xfd, 0xd1, 0x25, 0xb5, 0x1b, 0x5b, 0x8c, 0xbb, 0x10, 0x9e, 0xfc, 0x1b,
+	0x00, 0x8a, 0x27, 0x51, 0xfc, 0xc4, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -161,6 +165,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinPriorityFee.Size()
+		i -= size
+		if _, err := m.MinPriorityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -254,6 +268,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFee(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFee(uint64(l))
+	l = m.MinPriorityFee.Size()
+	n += 1 + l + sovFee(uint64(l))
 	return n
 }
 
@@ -471,6 +487,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,8 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultMinPriorityFee is 0 (i.e disabled)
+	DefaultMinPriorityFee = sdkmath.ZeroInt()
 )
 
 // Parameter keys
@@ -39,6 +42,7 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyMinPriorityFee           = []byte("MinPriorityFee")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -56,6 +60,7 @@ func (p *Params) ParamSetPairs() paramsmodule.ParamSetPairs {
 		paramsmodule.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramsmodule.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramsmodule.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramsmodule.NewParamSetPair(ParamStoreKeyMinPriorityFee, &p.MinPriorityFee, validateMinPriorityFee),
 	}
 }
 
@@ -77,6 +82,7 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		MinPriorityFee:           DefaultMinPriorityFee,
 	}
 }
 
@@ -90,6 +96,7 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		MinPriorityFee:           DefaultMinPriorityFee,
 	}
 }
 
@@ -111,6 +118,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinPriorityFee(p.MinPriorityFee); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -126,6 +137,32 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// SuggestGasTipCap returns the maximum base fee delta of the next block as the suggested
+// priority fee, a tx paying it is included even if the base fee rises at the full rate.
+func (p Params) SuggestGasTipCap(baseFee *big.Int) *big.Int {
+	if baseFee == nil || p.BaseFeeChangeDenominator == 0 {
+		// london hardfork not enabled or feemarket not enabled
+		return big.NewInt(0)
+	}
+	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
+	// ```
+	// GasTarget = GasLimit / ElasticityMultiplier
+	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
+	// ```
+	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
+	// ```
+	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
+	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
+	// ```
+	maxDelta := new(big.Int).Mul(baseFee, big.NewInt(int64(p.ElasticityMultiplier)-1))
+	maxDelta.Quo(maxDelta, big.NewInt(int64(p.BaseFeeChangeDenominator)))
+	if maxDelta.Sign() < 0 {
+		// impossible if the parameter validation passed.
+		return big.NewInt(0)
+	}
+	return maxDelta
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(cosmos.Dec)
 
@@ -144,6 +181,21 @@ func validateMinGasPrice(i interface{}) error {
 	return nil
 }
 
+// validateMinPriorityFee validates the min priority fee, it's not set in the params
+// stored before it was introduced, which disables the check.
+func validateMinPriorityFee(i interface{}) error {
+	value, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !value.IsNil() && value.IsNegative() {
+		return fmt.Errorf("min priority fee cannot be negative: %s", value)
+	}
+
+	return nil
+}
+
 func validateBaseFeeChangeDenominator(i interface{}) error {
 	value, ok := i.(uint32)
 	if !ok {